@@ -0,0 +1,84 @@
+package account
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"slices"
+	"strings"
+	"time"
+)
+
+// API keys look like isk_<prefix>_<secret>. Only a hash of the whole key is
+// kept, isk_<prefix> is kept as is so people can tell their keys apart.
+const (
+	APIKeyPrefix       = "isk_"
+	apiKeyPrefixLength = 8
+	// apiKeyTouchInterval is how often last use is written down, a kiosk
+	// makes a request on every scan.
+	apiKeyTouchInterval = time.Minute
+)
+
+// Scopes are <domain>:<access>. A key with write access to a domain may read
+// it too.
+const (
+	ScopeRead  = "read"
+	ScopeWrite = "write"
+)
+
+var scopeDomains = []string{"account", "education", "inventory", "lend"}
+
+// APIKey lets a SERVICE account, like the front desk scanner, call the
+// gateway within Scopes.
+type APIKey struct {
+	ID         string     `json:"id"`
+	AccountID  string     `json:"accountId"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Hash       string     `json:"-"` // SHA-256 of the key, never leaves the service
+	Scopes     []string   `json:"scopes"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"` // to the minute
+	RevokedAt  *time.Time `json:"revokedAt,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
+// HasScope reports whether scopes give access to domain.
+func HasScope(scopes []string, domain, access string) bool {
+	if slices.Contains(scopes, domain+":"+ScopeWrite) {
+		return true
+	}
+	return access == ScopeRead && slices.Contains(scopes, domain+":"+ScopeRead)
+}
+
+// newAPIKey returns a key to show once, and its prefix.
+func newAPIKey() (string, string, error) {
+	p := make([]byte, apiKeyPrefixLength/2)
+	if _, err := rand.Read(p); err != nil {
+		return "", "", err
+	}
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	prefix := APIKeyPrefix + hex.EncodeToString(p)
+	return prefix + "_" + base64.RawURLEncoding.EncodeToString(b), prefix, nil
+}
+
+// normaliseScopes sorts scopes and drops duplicates, failing for scopes
+// that don't exist.
+func normaliseScopes(scopes []string) ([]string, error) {
+	res := []string{}
+	for _, s := range scopes {
+		s = strings.ToLower(strings.TrimSpace(s))
+		domain, access, _ := strings.Cut(s, ":")
+		if !slices.Contains(scopeDomains, domain) || (access != ScopeRead && access != ScopeWrite) {
+			return nil, ErrInvalidScope
+		}
+		res = append(res, s)
+	}
+	if len(res) == 0 {
+		return nil, ErrInvalidScope
+	}
+	slices.Sort(res)
+	return slices.Compact(res), nil
+}
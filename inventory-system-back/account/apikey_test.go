@@ -0,0 +1,67 @@
+package account
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestHasScope(t *testing.T) {
+	tests := []struct {
+		name   string
+		scopes []string
+		domain string
+		access string
+		want   bool
+	}{
+		{"read with read", []string{"inventory:read"}, "inventory", ScopeRead, true},
+		{"write with read", []string{"inventory:read"}, "inventory", ScopeWrite, false},
+		{"read with write", []string{"inventory:write"}, "inventory", ScopeRead, true},
+		{"write with write", []string{"inventory:write"}, "inventory", ScopeWrite, true},
+		{"other domain", []string{"lend:write"}, "inventory", ScopeRead, false},
+		{"unknown domain", []string{"inventory:write", "lend:write"}, "payroll", ScopeRead, false},
+		{"no domain", []string{"inventory:write"}, "", ScopeRead, false},
+		{"no scopes", nil, "inventory", ScopeRead, false},
+		{"unknown access", []string{"inventory:read"}, "inventory", "admin", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HasScope(tt.scopes, tt.domain, tt.access); got != tt.want {
+				t.Errorf("HasScope(%v, %q, %q) = %v, want %v", tt.scopes, tt.domain, tt.access, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormaliseScopes(t *testing.T) {
+	tests := []struct {
+		name    string
+		scopes  []string
+		want    []string
+		wantErr bool
+	}{
+		{"sorted", []string{"lend:write", "inventory:read"}, []string{"inventory:read", "lend:write"}, false},
+		{"duplicates", []string{"inventory:read", "inventory:read"}, []string{"inventory:read"}, false},
+		{"case and spaces", []string{" Inventory:READ "}, []string{"inventory:read"}, false},
+		{"read and write", []string{"inventory:write", "inventory:read"}, []string{"inventory:read", "inventory:write"}, false},
+		{"unknown domain", []string{"payroll:read"}, nil, true},
+		{"unknown access", []string{"inventory:admin"}, nil, true},
+		{"no access", []string{"inventory"}, nil, true},
+		{"one bad scope", []string{"inventory:read", "payroll:read"}, nil, true},
+		{"empty", nil, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normaliseScopes(tt.scopes)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidScope) {
+					t.Errorf("normaliseScopes(%v) = %v, %v, want ErrInvalidScope", tt.scopes, got, err)
+				}
+				return
+			}
+			if err != nil || !slices.Equal(got, tt.want) {
+				t.Errorf("normaliseScopes(%v) = %v, %v, want %v", tt.scopes, got, err, tt.want)
+			}
+		})
+	}
+}
@@ -1 +1,368 @@
 package account
+
+import (
+	"context"
+
+	"github.com/jochem11/inventory-system-back/account/pb"
+	"github.com/jochem11/inventory-system-back/internal/logging"
+	"github.com/jochem11/inventory-system-back/internal/metrics"
+	"github.com/jochem11/inventory-system-back/internal/resilience"
+	"github.com/jochem11/inventory-system-back/internal/serve"
+	"github.com/jochem11/inventory-system-back/internal/tracing"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+type Client struct {
+	conn    *grpc.ClientConn
+	service pb.AccountServiceClient
+}
+
+// NewClient connects lazily, so the service doesn't have to be up yet. Calls
+// wait for it to come up within their deadline, read calls are retried and
+// policy's circuit breaker fails them fast while it stays down.
+func NewClient(url string, creds credentials.TransportCredentials, policy resilience.Config) (*Client, error) {
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		tracing.DialOption(),
+		grpc.WithChainUnaryInterceptor(logging.UnaryClientInterceptor(), metrics.GRPCClient.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(logging.StreamClientInterceptor(), metrics.GRPCClient.StreamClientInterceptor()),
+	}
+	conn, err := grpc.NewClient(url, append(opts, policy.DialOptions(pb.AccountService_ServiceDesc)...)...)
+	if err != nil {
+		return nil, err
+	}
+	c := pb.NewAccountServiceClient(conn)
+	return &Client{conn, c}, nil
+}
+
+func (c *Client) Close() {
+	c.conn.Close()
+}
+
+// Ready reports whether the service and its database are serving.
+func (c *Client) Ready(ctx context.Context) error {
+	return serve.CheckHealth(ctx, c.conn)
+}
+
+// --- Accounts ---
+
+func (c *Client) PostAccount(ctx context.Context, firstName, insertion, lastName, email, cardNumber, role, language string, classID *string) (*Account, error) {
+	r, err := c.service.PostAccount(ctx, &pb.PostAccountRequest{
+		FirstName:  firstName,
+		Insertion:  insertion,
+		LastName:   lastName,
+		Email:      email,
+		CardNumber: cardNumber,
+		Role:       role,
+		ClassId:    classID,
+		Language:   language,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return accountFromProto(r.Account), nil
+}
+
+func (c *Client) GetAccount(ctx context.Context, id string) (*Account, error) {
+	r, err := c.service.GetAccount(ctx, &pb.GetAccountRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	return accountFromProto(r.Account), nil
+}
+
+func (c *Client) GetAccountByEmail(ctx context.Context, email string) (*Account, error) {
+	r, err := c.service.GetAccountByEmail(ctx, &pb.GetAccountByEmailRequest{Email: email})
+	if err != nil {
+		return nil, err
+	}
+	return accountFromProto(r.Account), nil
+}
+
+func (c *Client) GetAccounts(ctx context.Context, skip, take uint64, classID, state *string) ([]*Account, error) {
+	r, err := c.service.GetAccounts(ctx, &pb.GetAccountsRequest{Skip: skip, Take: take, ClassId: classID, State: state})
+	if err != nil {
+		return nil, err
+	}
+	return accountsFromProto(r.Accounts), nil
+}
+
+func (c *Client) GetAccountsByIDs(ctx context.Context, ids []string) ([]*Account, error) {
+	r, err := c.service.GetAccountsByIDs(ctx, &pb.GetAccountsByIDsRequest{Ids: ids})
+	if err != nil {
+		return nil, err
+	}
+	return accountsFromProto(r.Accounts), nil
+}
+
+func (c *Client) UpdateAccount(ctx context.Context, id string, firstName, insertion, lastName, email, cardNumber, role, language, classID *string, clearClass bool) (*Account, error) {
+	r, err := c.service.UpdateAccount(ctx, &pb.UpdateAccountRequest{
+		Id:         id,
+		FirstName:  firstName,
+		Insertion:  insertion,
+		LastName:   lastName,
+		Email:      email,
+		CardNumber: cardNumber,
+		Role:       role,
+		ClassId:    classID,
+		ClearClass: clearClass,
+		Language:   language,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return accountFromProto(r.Account), nil
+}
+
+// --- Lifecycle ---
+
+func (c *Client) SetAccountState(ctx context.Context, id, state string) (*Account, error) {
+	r, err := c.service.SetAccountState(ctx, &pb.SetAccountStateRequest{Id: id, State: state})
+	if err != nil {
+		return nil, err
+	}
+	return accountFromProto(r.Account), nil
+}
+
+func (c *Client) AnonymiseAccount(ctx context.Context, id string) (*Account, error) {
+	r, err := c.service.AnonymiseAccount(ctx, &pb.AnonymiseAccountRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	return accountFromProto(r.Account), nil
+}
+
+func (c *Client) SetClassAccountState(ctx context.Context, classID, state string) (*BatchResult, error) {
+	r, err := c.service.SetClassAccountState(ctx, &pb.SetClassAccountStateRequest{ClassId: classID, State: state})
+	if err != nil {
+		return nil, err
+	}
+	return batchFromProto(r), nil
+}
+
+func (c *Client) AnonymiseClass(ctx context.Context, classID string) (*BatchResult, error) {
+	r, err := c.service.AnonymiseClass(ctx, &pb.AnonymiseClassRequest{ClassId: classID})
+	if err != nil {
+		return nil, err
+	}
+	return batchFromProto(r), nil
+}
+
+// --- Passwords ---
+
+func (c *Client) SetPassword(ctx context.Context, id, password string) error {
+	_, err := c.service.SetPassword(ctx, &pb.SetPasswordRequest{Id: id, Password: password})
+	return err
+}
+
+// Authenticate also returns the second step the login still needs, one of
+// the SecondFactor constants.
+func (c *Client) Authenticate(ctx context.Context, email, password string) (*Account, string, error) {
+	r, err := c.service.Authenticate(ctx, &pb.AuthenticateRequest{Email: email, Password: password})
+	if err != nil {
+		return nil, "", err
+	}
+	return accountFromProto(r.Account), r.SecondFactor, nil
+}
+
+func (c *Client) AuthenticateExternal(ctx context.Context, email, firstName, lastName, role string, link bool) (*Account, string, error) {
+	r, err := c.service.AuthenticateExternal(ctx, &pb.AuthenticateExternalRequest{
+		Email:     email,
+		FirstName: firstName,
+		LastName:  lastName,
+		Role:      role,
+		Link:      link,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return accountFromProto(r.Account), r.SecondFactor, nil
+}
+
+// --- Email ---
+
+func (c *Client) SendVerification(ctx context.Context, id string) error {
+	_, err := c.service.SendVerification(ctx, &pb.SendVerificationRequest{Id: id})
+	return err
+}
+
+func (c *Client) VerifyEmail(ctx context.Context, token string, password *string) (*Account, error) {
+	r, err := c.service.VerifyEmail(ctx, &pb.VerifyEmailRequest{Token: token, Password: password})
+	if err != nil {
+		return nil, err
+	}
+	return accountFromProto(r.Account), nil
+}
+
+func (c *Client) RequestPasswordReset(ctx context.Context, email string) error {
+	_, err := c.service.RequestPasswordReset(ctx, &pb.RequestPasswordResetRequest{Email: email})
+	return err
+}
+
+func (c *Client) ResetPassword(ctx context.Context, token, password string) error {
+	_, err := c.service.ResetPassword(ctx, &pb.ResetPasswordRequest{Token: token, Password: password})
+	return err
+}
+
+// --- Two-factor ---
+
+func (c *Client) BeginTOTP(ctx context.Context, id string) (*TOTPSetup, error) {
+	r, err := c.service.BeginTOTP(ctx, &pb.BeginTOTPRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	return &TOTPSetup{Secret: r.Secret, URI: r.Uri}, nil
+}
+
+func (c *Client) EnableTOTP(ctx context.Context, id, code string) ([]string, error) {
+	r, err := c.service.EnableTOTP(ctx, &pb.EnableTOTPRequest{Id: id, Code: code})
+	if err != nil {
+		return nil, err
+	}
+	return r.RecoveryCodes, nil
+}
+
+func (c *Client) VerifyTOTP(ctx context.Context, id, code string) (*Account, error) {
+	r, err := c.service.VerifyTOTP(ctx, &pb.VerifyTOTPRequest{Id: id, Code: code})
+	if err != nil {
+		return nil, err
+	}
+	return accountFromProto(r.Account), nil
+}
+
+func (c *Client) DisableTOTP(ctx context.Context, id, code string) (*Account, error) {
+	r, err := c.service.DisableTOTP(ctx, &pb.DisableTOTPRequest{Id: id, Code: code})
+	if err != nil {
+		return nil, err
+	}
+	return accountFromProto(r.Account), nil
+}
+
+func (c *Client) ResetTOTP(ctx context.Context, id string) (*Account, error) {
+	r, err := c.service.ResetTOTP(ctx, &pb.ResetTOTPRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	return accountFromProto(r.Account), nil
+}
+
+// --- Calendar feeds ---
+
+func (c *Client) ResetCalendarFeeds(ctx context.Context, id string) (*Account, error) {
+	r, err := c.service.ResetCalendarFeeds(ctx, &pb.ResetCalendarFeedsRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	return accountFromProto(r.Account), nil
+}
+
+// --- API keys ---
+
+// CreateAPIKey returns the key itself too, it can't be looked up later.
+func (c *Client) CreateAPIKey(ctx context.Context, accountID, name string, scopes []string) (*APIKey, string, error) {
+	r, err := c.service.CreateAPIKey(ctx, &pb.CreateAPIKeyRequest{AccountId: accountID, Name: name, Scopes: scopes})
+	if err != nil {
+		return nil, "", err
+	}
+	return apiKeyFromProto(r.ApiKey), r.Key, nil
+}
+
+func (c *Client) GetAPIKeys(ctx context.Context, accountID string) ([]*APIKey, error) {
+	r, err := c.service.GetAPIKeys(ctx, &pb.GetAPIKeysRequest{AccountId: accountID})
+	if err != nil {
+		return nil, err
+	}
+	keys := []*APIKey{}
+	for _, k := range r.ApiKeys {
+		keys = append(keys, apiKeyFromProto(k))
+	}
+	return keys, nil
+}
+
+func (c *Client) RevokeAPIKey(ctx context.Context, id string) (*APIKey, error) {
+	r, err := c.service.RevokeAPIKey(ctx, &pb.RevokeAPIKeyRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	return apiKeyFromProto(r.ApiKey), nil
+}
+
+func (c *Client) AuthenticateAPIKey(ctx context.Context, key string) (*Account, *APIKey, error) {
+	r, err := c.service.AuthenticateAPIKey(ctx, &pb.AuthenticateAPIKeyRequest{Key: key})
+	if err != nil {
+		return nil, nil, err
+	}
+	return accountFromProto(r.Account), apiKeyFromProto(r.ApiKey), nil
+}
+
+// --- Conversions ---
+
+func accountFromProto(a *pb.Account) *Account {
+	account := &Account{
+		ID:                a.Id,
+		FirstName:         a.FirstName,
+		Insertion:         a.Insertion,
+		LastName:          a.LastName,
+		Email:             a.Email,
+		CardNumber:        a.CardNumber,
+		Role:              a.Role,
+		State:             a.State,
+		ClassID:           a.ClassId,
+		Language:          a.Language,
+		RecoveryCodesLeft: int(a.RecoveryCodesLeft),
+		SessionVersion:    int(a.SessionVersion),
+		CalendarVersion:   int(a.CalendarVersion),
+		CreatedAt:         a.CreatedAt.AsTime(),
+		UpdatedAt:         a.UpdatedAt.AsTime(),
+	}
+	if a.EmailVerifiedAt != nil {
+		t := a.EmailVerifiedAt.AsTime()
+		account.EmailVerifiedAt = &t
+	}
+	if a.TotpEnabledAt != nil {
+		t := a.TotpEnabledAt.AsTime()
+		account.TOTPEnabledAt = &t
+	}
+	if a.AnonymisedAt != nil {
+		t := a.AnonymisedAt.AsTime()
+		account.AnonymisedAt = &t
+	}
+	return account
+}
+
+func accountsFromProto(res []*pb.Account) []*Account {
+	accounts := []*Account{}
+	for _, a := range res {
+		accounts = append(accounts, accountFromProto(a))
+	}
+	return accounts
+}
+
+func apiKeyFromProto(k *pb.APIKey) *APIKey {
+	key := &APIKey{
+		ID:        k.Id,
+		AccountID: k.AccountId,
+		Name:      k.Name,
+		Prefix:    k.Prefix,
+		Scopes:    k.Scopes,
+		CreatedAt: k.CreatedAt.AsTime(),
+	}
+	if k.LastUsedAt != nil {
+		t := k.LastUsedAt.AsTime()
+		key.LastUsedAt = &t
+	}
+	if k.RevokedAt != nil {
+		t := k.RevokedAt.AsTime()
+		key.RevokedAt = &t
+	}
+	return key
+}
+
+func batchFromProto(r *pb.BatchResponse) *BatchResult {
+	skipped := []*BatchSkip{}
+	for _, s := range r.Skipped {
+		skipped = append(skipped, &BatchSkip{AccountID: s.AccountId, Reason: s.Reason})
+	}
+	return &BatchResult{Changed: accountsFromProto(r.Changed), Skipped: skipped}
+}
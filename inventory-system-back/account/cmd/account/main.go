@@ -1,6 +1,261 @@
 package main
 
-type Repository interface {
-	Close()
-	PutAccount()
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jochem11/inventory-system-back/account"
+	"github.com/jochem11/inventory-system-back/internal/config"
+	"github.com/jochem11/inventory-system-back/internal/grpctls"
+	"github.com/jochem11/inventory-system-back/internal/logging"
+	"github.com/jochem11/inventory-system-back/internal/mail"
+	"github.com/jochem11/inventory-system-back/internal/metrics"
+	"github.com/jochem11/inventory-system-back/internal/serve"
+	"github.com/jochem11/inventory-system-back/internal/tracing"
+	"github.com/tinrab/retry"
+	"google.golang.org/grpc"
+	"io"
+	"log"
+	"log/slog"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+type Config struct {
+	Port         int           `envconfig:"PORT" default:"8080"`
+	MetricsPort  int           `envconfig:"METRICS_PORT" default:"9090"`
+	DatabaseURL  string        `envconfig:"DATABASE_URL" required:"true" secret:"true"`
+	DrainTimeout time.Duration `envconfig:"DRAIN_TIMEOUT" default:"15s"`
+	LogLevel     string        `envconfig:"LOG_LEVEL" default:"info"`
+
+	// RPCTimeout bounds each unary RPC that comes without a shorter deadline.
+	RPCTimeout time.Duration `envconfig:"RPC_TIMEOUT" default:"10s"`
+	Page       config.Paging `envconfig:"PAGE_SIZE"`
+	DB         config.DBPool `envconfig:"DB"`
+
+	// TracingExporter is otlp, stdout or none.
+	TracingExporter string `envconfig:"TRACING_EXPORTER" default:"none"`
+
+	TLS grpctls.Config `envconfig:"TLS"`
+
+	Mail mail.Config `envconfig:"MAIL"`
+	// AppURL is the front end the links in emails open.
+	AppURL         string        `envconfig:"APP_URL" default:"http://localhost:3000"`
+	ResetTokenTTL  time.Duration `envconfig:"RESET_TOKEN_TTL" default:"1h"`
+	VerifyTokenTTL time.Duration `envconfig:"VERIFY_TOKEN_TTL" default:"72h"`
+
+	// TOTPIssuer names the system in authenticator apps. Accounts with one of
+	// TOTPRequiredRoles can't log in fully before setting up TOTP, leave it
+	// empty to keep TOTP optional for everyone.
+	TOTPIssuer        string   `envconfig:"TOTP_ISSUER" default:"Inventory"`
+	TOTPRequiredRoles []string `envconfig:"TOTP_REQUIRED_ROLES" default:"TEACHER,ADMIN"`
+}
+
+func (c Config) Validate() error {
+	var errs []error
+	errs = append(errs,
+		config.Port("PORT", c.Port),
+		config.Port("METRICS_PORT", c.MetricsPort),
+	)
+	if c.Port == c.MetricsPort {
+		errs = append(errs, errors.New("PORT and METRICS_PORT must differ"))
+	}
+	if _, err := logging.ParseLevel(c.LogLevel); err != nil {
+		errs = append(errs, fmt.Errorf("LOG_LEVEL: %w", err))
+	}
+	if c.DrainTimeout <= 0 || c.RPCTimeout <= 0 {
+		errs = append(errs, errors.New("DRAIN_TIMEOUT and RPC_TIMEOUT must be positive"))
+	}
+	if u, err := url.Parse(c.AppURL); err != nil || !u.IsAbs() {
+		errs = append(errs, fmt.Errorf("APP_URL %q must be an absolute URL", c.AppURL))
+	}
+	for _, role := range c.TOTPRequiredRoles {
+		if role != account.RoleStudent && role != account.RoleTeacher && role != account.RoleAdmin {
+			errs = append(errs, fmt.Errorf("TOTP_REQUIRED_ROLES: %q is not a role", role))
+		}
+	}
+	if c.TOTPIssuer == "" || strings.Contains(c.TOTPIssuer, ":") {
+		errs = append(errs, errors.New("TOTP_ISSUER must be set and can't contain a colon"))
+	}
+	if c.ResetTokenTTL <= 0 || c.VerifyTokenTTL <= 0 {
+		errs = append(errs, errors.New("RESET_TOKEN_TTL and VERIFY_TOKEN_TTL must be positive"))
+	}
+	errs = append(errs,
+		tracing.CheckExporter(c.TracingExporter),
+		c.Page.Validate(),
+		c.DB.Validate(),
+		c.TLS.Validate(),
+		c.Mail.Validate(),
+	)
+	return errors.Join(errs...)
+}
+
+func main() {
+	var cfg Config
+	args, err := config.Load(&cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if len(args) > 0 && args[0] == "healthcheck" {
+		creds, err := cfg.TLS.Client("account")
+		if err != nil {
+			log.Fatal(err)
+		}
+		os.Exit(serve.Probe(cfg.Port, grpc.WithTransportCredentials(creds), grpc.WithAuthority("account")))
+	}
+	if len(args) > 0 && args[0] == "set-password" {
+		if len(args) != 2 {
+			log.Fatal("usage: app set-password <email>, with the password on stdin")
+		}
+		if err := setPassword(cfg, args[1], os.Stdin); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if len(args) > 0 && args[0] == "create-admin" {
+		if len(args) != 4 {
+			log.Fatal("usage: app create-admin <email> <first name> <last name>, with the password on stdin")
+		}
+		if err := createAdmin(cfg, args[1], args[2], args[3], os.Stdin); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if err := logging.Setup(cfg.LogLevel); err != nil {
+		log.Fatal(err)
+	}
+	shutdownTracing, err := tracing.Setup(context.Background(), "account", cfg.TracingExporter)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer shutdownTracing(context.Background())
+
+	creds, err := cfg.TLS.Server("account")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ctx, stop := serve.SignalContext()
+	defer stop()
+
+	var r account.Repository
+	retry.ForeverSleep(2*time.Second, func(_ int) (err error) {
+		r, err = account.NewPostgresRepository(cfg.DatabaseURL, cfg.DB)
+		if err != nil {
+			slog.Error("Connecting to database", "err", err)
+		}
+		return
+	})
+	defer r.Close()
+
+	go func() {
+		if err := metrics.Serve(ctx, cfg.MetricsPort, cfg.DrainTimeout); err != nil {
+			slog.Error("Serving metrics", "err", err)
+		}
+	}()
+
+	slog.Info("Listening", "port", cfg.Port, "metrics_port", cfg.MetricsPort)
+	mails, err := newMails(cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	// The lend service keeps no lends yet, so no account is held back by one.
+	s := account.NewAccountService(r, cfg.Page, account.NoLends{}, mails, totpPolicy(cfg))
+	err = account.ListenGRPC(ctx, s, r, serve.GRPCOptions{
+		Port:    cfg.Port,
+		Drain:   cfg.DrainTimeout,
+		Timeout: cfg.RPCTimeout,
+		Creds:   creds,
+	})
+	if err != nil {
+		r.Close()
+		log.Fatal(err)
+	}
+	slog.Info("Stopped")
+}
+
+// setPassword sets the password of the account with email, for an admin
+// locked out of the gateway.
+func setPassword(cfg Config, email string, in io.Reader) error {
+	password, err := readPassword(in)
+	if err != nil {
+		return err
+	}
+	r, err := account.NewPostgresRepository(cfg.DatabaseURL, cfg.DB)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	ctx := context.Background()
+	a, err := r.GetAccountByEmail(ctx, strings.ToLower(strings.TrimSpace(email)))
+	if err != nil {
+		return fmt.Errorf("account %s: %w", email, err)
+	}
+	mails, err := newMails(cfg)
+	if err != nil {
+		return err
+	}
+	return account.NewAccountService(r, cfg.Page, account.NoLends{}, mails, totpPolicy(cfg)).SetPassword(ctx, a.ID, password)
+}
+
+// createAdmin creates an active admin with a password. Only admins can
+// create accounts through the gateway, so this is how the first one comes
+// about.
+func createAdmin(cfg Config, email, firstName, lastName string, in io.Reader) error {
+	password, err := readPassword(in)
+	if err != nil {
+		return err
+	}
+	r, err := account.NewPostgresRepository(cfg.DatabaseURL, cfg.DB)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	mails, err := newMails(cfg)
+	if err != nil {
+		return err
+	}
+	s := account.NewAccountService(r, cfg.Page, account.NoLends{}, mails, totpPolicy(cfg))
+
+	ctx := context.Background()
+	a, err := s.PostAccount(ctx, firstName, "", lastName, email, "", account.RoleAdmin, account.LanguageDutch, nil)
+	if err != nil {
+		return err
+	}
+	if err := s.SetPassword(ctx, a.ID, password); err != nil {
+		return err
+	}
+	_, err = s.SetAccountState(ctx, a.ID, account.StateActive)
+	return err
+}
+
+func readPassword(in io.Reader) (string, error) {
+	password, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+	return strings.TrimRight(password, "\r\n"), nil
+}
+
+func newMails(cfg Config) (account.Mails, error) {
+	mailer, err := cfg.Mail.Mailer()
+	if err != nil {
+		return account.Mails{}, err
+	}
+	return account.Mails{
+		Mailer:    mailer,
+		AppURL:    cfg.AppURL,
+		ResetTTL:  cfg.ResetTokenTTL,
+		VerifyTTL: cfg.VerifyTokenTTL,
+	}, nil
+}
+
+func totpPolicy(cfg Config) account.TOTPPolicy {
+	return account.TOTPPolicy{Issuer: cfg.TOTPIssuer, RequiredRoles: cfg.TOTPRequiredRoles}
 }
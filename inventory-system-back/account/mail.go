@@ -0,0 +1,154 @@
+package account
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"embed"
+	"encoding/base64"
+	"encoding/hex"
+	"net/url"
+	"text/template"
+	"time"
+
+	"github.com/jochem11/inventory-system-back/internal/mail"
+)
+
+const (
+	LanguageDutch   = "nl"
+	LanguageEnglish = "en"
+)
+
+var languages = []string{LanguageDutch, LanguageEnglish}
+
+// Token purposes, each with the template of the email it is sent in and the
+// front end page its link opens.
+const (
+	purposeReset  = "reset"
+	purposeVerify = "verify"
+)
+
+var tokenPages = map[string]string{
+	purposeReset:  "reset-password",
+	purposeVerify: "verify-email",
+}
+
+// dateFormats is how an expiry is written in each language.
+var dateFormats = map[string]string{
+	LanguageDutch:   "2-1-2006 15:04",
+	LanguageEnglish: "2 January 2006 15:04",
+}
+
+//go:embed templates/*.tmpl
+var templateFiles embed.FS
+
+// templates holds a subject and body template per purpose and language,
+// keyed like their files: reset.nl, verify.en.
+var templates = func() map[string]*template.Template {
+	t := map[string]*template.Template{}
+	for purpose := range tokenPages {
+		for _, lang := range languages {
+			name := purpose + "." + lang
+			t[name] = template.Must(template.ParseFS(templateFiles, "templates/"+name+".tmpl"))
+		}
+	}
+	return t
+}()
+
+// Mails configures the emails the service sends. Their links open AppURL,
+// the front end, which hands the token back to the service.
+type Mails struct {
+	Mailer    mail.Mailer
+	AppURL    string
+	ResetTTL  time.Duration
+	VerifyTTL time.Duration
+}
+
+func (m Mails) ttl(purpose string) time.Duration {
+	if purpose == purposeReset {
+		return m.ResetTTL
+	}
+	return m.VerifyTTL
+}
+
+// Token is a single-use token mailed to an account. The token itself only
+// exists in the email, Hash is what gets stored.
+type Token struct {
+	Hash      string
+	AccountID string
+	Purpose   string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+func newToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// mailToken issues a token for purpose and mails a link with it to the
+// account, in its language.
+func (s *accountService) mailToken(ctx context.Context, a *Account, purpose string) error {
+	token, err := newToken()
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	t := &Token{
+		Hash:      hashToken(token),
+		AccountID: a.ID,
+		Purpose:   purpose,
+		ExpiresAt: now.Add(s.mails.ttl(purpose)),
+		CreatedAt: now,
+	}
+
+	link, err := url.Parse(s.mails.AppURL)
+	if err != nil {
+		return err
+	}
+	link = link.JoinPath(tokenPages[purpose])
+	link.RawQuery = url.Values{"token": {token}}.Encode()
+
+	msg, err := render(purpose, a.Language, map[string]any{
+		"Name":      a.FirstName,
+		"Email":     a.Email,
+		"Invited":   a.State == StateInvited && a.Password == "",
+		"Link":      link.String(),
+		"ExpiresAt": t.ExpiresAt.Format(dateFormats[a.Language]),
+	})
+	if err != nil {
+		return err
+	}
+	msg.To = a.Email
+
+	// Store the token first, a mail with a token that doesn't work is worse
+	// than a token nobody got.
+	if err := s.repository.PutToken(ctx, t); err != nil {
+		return err
+	}
+	return s.mails.Mailer.Send(ctx, msg)
+}
+
+func render(purpose, language string, data any) (mail.Message, error) {
+	t, ok := templates[purpose+"."+language]
+	if !ok {
+		t = templates[purpose+"."+LanguageDutch]
+	}
+	var subject, body bytes.Buffer
+	if err := t.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return mail.Message{}, err
+	}
+	if err := t.ExecuteTemplate(&body, "body", data); err != nil {
+		return mail.Message{}, err
+	}
+	return mail.Message{Subject: subject.String(), Body: body.String()}, nil
+}
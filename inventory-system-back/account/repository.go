@@ -1 +1,413 @@
 package account
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/jochem11/inventory-system-back/internal/config"
+	"github.com/jochem11/inventory-system-back/internal/metrics"
+	"github.com/jochem11/inventory-system-back/internal/tracing"
+	"github.com/lib/pq"
+)
+
+type Repository interface {
+	Close()
+	Ping(ctx context.Context) error
+
+	PutAccount(ctx context.Context, a *Account) error
+	GetAccountByID(ctx context.Context, id string) (*Account, error)
+	GetAccountByEmail(ctx context.Context, email string) (*Account, error)
+	ListAccounts(ctx context.Context, skip uint64, take uint64, classID, state *string) ([]*Account, error)
+	ListAccountsByIDs(ctx context.Context, ids []string) ([]*Account, error)
+	ListAccountsInClass(ctx context.Context, classID string) ([]*Account, error)
+	UpdateAccount(ctx context.Context, a *Account) (*Account, error)
+	SetAccountStates(ctx context.Context, ids []string, state string, updatedAt time.Time) error
+	AnonymiseAccounts(ctx context.Context, ids []string, at time.Time) error
+	SetPassword(ctx context.Context, id, hash string, updatedAt time.Time) error
+
+	PutToken(ctx context.Context, t *Token) error
+	GetToken(ctx context.Context, hash, purpose string, now time.Time) (*Token, error)
+	VerifyEmail(ctx context.Context, tokenHash, id, state, passwordHash string, at time.Time) error
+	ResetPassword(ctx context.Context, tokenHash, id, passwordHash string, at time.Time) error
+	LinkExternal(ctx context.Context, id, state string, at time.Time) error
+
+	SetTOTPSecret(ctx context.Context, id, secret string, at time.Time) error
+	EnableTOTP(ctx context.Context, id string, step int64, recoveryCodes []string, at time.Time) error
+	UseTOTPStep(ctx context.Context, id string, step int64) error
+	UseRecoveryCode(ctx context.Context, id, hash string) error
+	ClearTOTP(ctx context.Context, id string, at time.Time) error
+
+	BumpCalendarVersion(ctx context.Context, id string, at time.Time) error
+
+	PutAPIKey(ctx context.Context, k *APIKey) error
+	GetAPIKey(ctx context.Context, id string) (*APIKey, error)
+	GetAPIKeyByHash(ctx context.Context, hash string) (*APIKey, error)
+	ListAPIKeys(ctx context.Context, accountID string) ([]*APIKey, error)
+	RevokeAPIKey(ctx context.Context, id string, at time.Time) error
+	TouchAPIKey(ctx context.Context, id string, at time.Time) error
+}
+
+type postgresRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresRepository(url string, pool config.DBPool) (Repository, error) {
+	db, err := tracing.OpenPostgres(url)
+	if err != nil {
+		return nil, err
+	}
+	pool.Apply(db)
+	err = db.Ping()
+	if err != nil {
+		return nil, err
+	}
+	if err := metrics.RegisterDB(db, "account"); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &postgresRepository{db}, nil
+}
+
+func (r postgresRepository) Close() {
+	r.db.Close()
+}
+
+func (r postgresRepository) Ping(ctx context.Context) error {
+	return r.db.PingContext(ctx)
+}
+
+// --- Accounts ---
+
+const accountColumns = `a.id, a.first_name, a.insertion, a.last_name, a.email, a.password, a.card_number, a.role, a.state, a.class_id, a.language, a.email_verified_at,
+    a.totp_secret, a.totp_enabled_at, a.totp_last_step, cardinality(a.totp_recovery_codes), a.session_version, a.calendar_version, a.external_linked_at, a.anonymised_at, a.created_at, a.updated_at`
+
+func scanAccount(row interface{ Scan(...any) error }) (*Account, error) {
+	a := &Account{}
+	if err := row.Scan(&a.ID, &a.FirstName, &a.Insertion, &a.LastName, &a.Email, &a.Password, &a.CardNumber, &a.Role, &a.State, &a.ClassID, &a.Language, &a.EmailVerifiedAt,
+		&a.TOTPSecret, &a.TOTPEnabledAt, &a.TOTPLastStep, &a.RecoveryCodesLeft, &a.SessionVersion, &a.CalendarVersion, &a.ExternalLinkedAt, &a.AnonymisedAt, &a.CreatedAt, &a.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (r *postgresRepository) queryAccounts(ctx context.Context, query string, args ...any) ([]*Account, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	accounts := []*Account{}
+	for rows.Next() {
+		a, err := scanAccount(rows)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, a)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+func (r *postgresRepository) PutAccount(ctx context.Context, a *Account) error {
+	_, err := r.db.ExecContext(ctx, `
+        INSERT INTO accounts(id, first_name, insertion, last_name, email, password, card_number, role, state, class_id, language, email_verified_at, external_linked_at, created_at, updated_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)`,
+		a.ID, a.FirstName, a.Insertion, a.LastName, a.Email, a.Password, a.CardNumber, a.Role, a.State, a.ClassID, a.Language, a.EmailVerifiedAt, a.ExternalLinkedAt, a.CreatedAt, a.UpdatedAt)
+	return uniqueError(err)
+}
+
+func (r *postgresRepository) GetAccountByID(ctx context.Context, id string) (*Account, error) {
+	row := r.db.QueryRowContext(ctx, "SELECT "+accountColumns+" FROM accounts a WHERE a.id = $1", id)
+	return scanAccount(row)
+}
+
+func (r *postgresRepository) GetAccountByEmail(ctx context.Context, email string) (*Account, error) {
+	row := r.db.QueryRowContext(ctx, "SELECT "+accountColumns+" FROM accounts a WHERE a.email = $1 AND a.email <> ''", email)
+	return scanAccount(row)
+}
+
+func (r *postgresRepository) ListAccounts(ctx context.Context, skip uint64, take uint64, classID, state *string) ([]*Account, error) {
+	return r.queryAccounts(ctx, `
+        SELECT `+accountColumns+`
+        FROM accounts a
+        WHERE ($3::text IS NULL OR a.class_id = $3)
+          AND ($4::text IS NULL OR a.state = $4)
+        ORDER BY a.id DESC
+        OFFSET $1 LIMIT $2`, skip, take, classID, state)
+}
+
+func (r *postgresRepository) ListAccountsByIDs(ctx context.Context, ids []string) ([]*Account, error) {
+	return r.queryAccounts(ctx, "SELECT "+accountColumns+" FROM accounts a WHERE a.id = ANY($1)", pq.Array(ids))
+}
+
+func (r *postgresRepository) ListAccountsInClass(ctx context.Context, classID string) ([]*Account, error) {
+	return r.queryAccounts(ctx, `
+        SELECT `+accountColumns+`
+        FROM accounts a
+        WHERE a.class_id = $1
+        ORDER BY a.last_name, a.first_name`, classID)
+}
+
+func (r *postgresRepository) UpdateAccount(ctx context.Context, a *Account) (*Account, error) {
+	_, err := r.db.ExecContext(ctx, `
+        UPDATE accounts
+        SET first_name = $1, insertion = $2, last_name = $3, email = $4, card_number = $5, role = $6, class_id = $7, language = $8,
+            email_verified_at = $9, external_linked_at = $10, updated_at = $11
+        WHERE id = $12`,
+		a.FirstName, a.Insertion, a.LastName, a.Email, a.CardNumber, a.Role, a.ClassID, a.Language, a.EmailVerifiedAt, a.ExternalLinkedAt, a.UpdatedAt, a.ID)
+	if err != nil {
+		return nil, uniqueError(err)
+	}
+
+	return r.GetAccountByID(ctx, a.ID)
+}
+
+func (r *postgresRepository) SetAccountStates(ctx context.Context, ids []string, state string, updatedAt time.Time) error {
+	_, err := r.db.ExecContext(ctx, `
+        UPDATE accounts
+        SET state = $2, updated_at = $3
+        WHERE id = ANY($1)`, pq.Array(ids), state, updatedAt)
+	return err
+}
+
+// AnonymiseAccounts blanks everything that identifies a person and leaves
+// the accounts deleted. Their outstanding tokens and API keys go too.
+func (r *postgresRepository) AnonymiseAccounts(ctx context.Context, ids []string, at time.Time) error {
+	_, err := r.db.ExecContext(ctx, `
+        WITH tokens AS (DELETE FROM account_tokens WHERE account_id = ANY($1)),
+            keys AS (DELETE FROM api_keys WHERE account_id = ANY($1))
+        UPDATE accounts
+        SET first_name = '', insertion = '', last_name = '', email = '', password = '', card_number = '', email_verified_at = NULL,
+            external_linked_at = NULL, totp_secret = '', totp_enabled_at = NULL, totp_recovery_codes = '{}',
+            state = $2, anonymised_at = $3, updated_at = $3
+        WHERE id = ANY($1)`, pq.Array(ids), StateDeleted, at)
+	return err
+}
+
+// SetPassword also ends the sessions of the account, as do the other
+// statements that change a password.
+func (r *postgresRepository) SetPassword(ctx context.Context, id, hash string, updatedAt time.Time) error {
+	_, err := r.db.ExecContext(ctx, `
+        UPDATE accounts SET password = $2, session_version = session_version + 1, updated_at = $3
+        WHERE id = $1`, id, hash, updatedAt)
+	return err
+}
+
+// --- Tokens ---
+
+// PutToken stores t and uses up the account's earlier tokens for the same
+// purpose, so only the latest link works.
+func (r *postgresRepository) PutToken(ctx context.Context, t *Token) error {
+	_, err := r.db.ExecContext(ctx, `
+        WITH earlier AS (
+            UPDATE account_tokens SET used_at = $5
+            WHERE account_id = $2 AND purpose = $3 AND used_at IS NULL
+        )
+        INSERT INTO account_tokens(hash, account_id, purpose, expires_at, created_at)
+        VALUES ($1, $2, $3, $4, $5)`,
+		t.Hash, t.AccountID, t.Purpose, t.ExpiresAt, t.CreatedAt)
+	return err
+}
+
+// GetToken returns the token with hash if it is unused and not expired at
+// now, sql.ErrNoRows otherwise.
+func (r *postgresRepository) GetToken(ctx context.Context, hash, purpose string, now time.Time) (*Token, error) {
+	t := &Token{}
+	err := r.db.QueryRowContext(ctx, `
+        SELECT hash, account_id, purpose, expires_at, created_at
+        FROM account_tokens
+        WHERE hash = $1 AND purpose = $2 AND used_at IS NULL AND expires_at > $3`, hash, purpose, now).
+		Scan(&t.Hash, &t.AccountID, &t.Purpose, &t.ExpiresAt, &t.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// VerifyEmail uses up a verification token and marks the email verified,
+// moving the account to state. An empty passwordHash keeps the password.
+func (r *postgresRepository) VerifyEmail(ctx context.Context, tokenHash, id, state, passwordHash string, at time.Time) error {
+	return r.useToken(ctx, tokenHash, id, at, `
+        UPDATE accounts
+        SET email_verified_at = $2, state = $3, password = COALESCE(NULLIF($4, ''), password),
+            session_version = session_version + CASE WHEN $4 = '' THEN 0 ELSE 1 END, updated_at = $2
+        WHERE id = $1`, id, at, state, passwordHash)
+}
+
+// ResetPassword uses up a reset token and sets the new password.
+func (r *postgresRepository) ResetPassword(ctx context.Context, tokenHash, id, passwordHash string, at time.Time) error {
+	return r.useToken(ctx, tokenHash, id, at, `
+        UPDATE accounts SET password = $2, session_version = session_version + 1, updated_at = $3
+        WHERE id = $1`, id, passwordHash, at)
+}
+
+// LinkExternal marks the account linked to single sign-on and its email
+// verified without a token, moving it to state.
+func (r *postgresRepository) LinkExternal(ctx context.Context, id, state string, at time.Time) error {
+	return execOne(r.db.ExecContext(ctx, `
+        UPDATE accounts
+        SET email_verified_at = COALESCE(email_verified_at, $2), external_linked_at = $2, state = $3, updated_at = $2
+        WHERE id = $1`, id, at, state))
+}
+
+// useToken marks a token of the account used and runs update in the same
+// transaction. A token used in the meantime fails with sql.ErrNoRows, so it
+// only ever works once.
+func (r *postgresRepository) useToken(ctx context.Context, tokenHash, id string, at time.Time, update string, args ...any) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = execOne(tx.ExecContext(ctx, `
+        UPDATE account_tokens SET used_at = $3
+        WHERE hash = $1 AND account_id = $2 AND used_at IS NULL AND expires_at > $3`, tokenHash, id, at))
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, update, args...); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// --- Two-factor authentication ---
+
+// SetTOTPSecret stores a secret that is still being set up. It fails with
+// sql.ErrNoRows once TOTP is on.
+func (r *postgresRepository) SetTOTPSecret(ctx context.Context, id, secret string, at time.Time) error {
+	return execOne(r.db.ExecContext(ctx, `
+        UPDATE accounts SET totp_secret = $2, updated_at = $3
+        WHERE id = $1 AND totp_enabled_at IS NULL`, id, secret, at))
+}
+
+// EnableTOTP turns TOTP on with the secret being set up, step being the
+// step of the code that confirmed it.
+func (r *postgresRepository) EnableTOTP(ctx context.Context, id string, step int64, recoveryCodes []string, at time.Time) error {
+	return execOne(r.db.ExecContext(ctx, `
+        UPDATE accounts
+        SET totp_enabled_at = $4, totp_last_step = $2, totp_recovery_codes = $3, updated_at = $4
+        WHERE id = $1 AND totp_enabled_at IS NULL AND totp_secret <> ''`, id, step, pq.Array(recoveryCodes), at))
+}
+
+// UseTOTPStep records that a code of step was used. It fails with
+// sql.ErrNoRows for a step at or before the last one, so a code can't be
+// replayed.
+func (r *postgresRepository) UseTOTPStep(ctx context.Context, id string, step int64) error {
+	return execOne(r.db.ExecContext(ctx, `
+        UPDATE accounts SET totp_last_step = $2
+        WHERE id = $1 AND totp_enabled_at IS NOT NULL AND totp_last_step < $2`, id, step))
+}
+
+// UseRecoveryCode removes the recovery code with hash, failing with
+// sql.ErrNoRows if the account doesn't have it (anymore).
+func (r *postgresRepository) UseRecoveryCode(ctx context.Context, id, hash string) error {
+	return execOne(r.db.ExecContext(ctx, `
+        UPDATE accounts SET totp_recovery_codes = array_remove(totp_recovery_codes, $2)
+        WHERE id = $1 AND totp_enabled_at IS NOT NULL AND $2 = ANY(totp_recovery_codes)`, id, hash))
+}
+
+func (r *postgresRepository) ClearTOTP(ctx context.Context, id string, at time.Time) error {
+	return execOne(r.db.ExecContext(ctx, `
+        UPDATE accounts
+        SET totp_secret = '', totp_enabled_at = NULL, totp_last_step = 0, totp_recovery_codes = '{}', updated_at = $2
+        WHERE id = $1`, id, at))
+}
+
+// --- Calendar feeds ---
+
+func (r *postgresRepository) BumpCalendarVersion(ctx context.Context, id string, at time.Time) error {
+	return execOne(r.db.ExecContext(ctx, `
+        UPDATE accounts SET calendar_version = calendar_version + 1, updated_at = $2
+        WHERE id = $1`, id, at))
+}
+
+// --- API keys ---
+
+const apiKeyColumns = "id, account_id, name, prefix, hash, scopes, last_used_at, revoked_at, created_at"
+
+func scanAPIKey(row interface{ Scan(...any) error }) (*APIKey, error) {
+	k := &APIKey{}
+	if err := row.Scan(&k.ID, &k.AccountID, &k.Name, &k.Prefix, &k.Hash, pq.Array(&k.Scopes), &k.LastUsedAt, &k.RevokedAt, &k.CreatedAt); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+func (r *postgresRepository) PutAPIKey(ctx context.Context, k *APIKey) error {
+	_, err := r.db.ExecContext(ctx, `
+        INSERT INTO api_keys(id, account_id, name, prefix, hash, scopes, created_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		k.ID, k.AccountID, k.Name, k.Prefix, k.Hash, pq.Array(k.Scopes), k.CreatedAt)
+	return err
+}
+
+func (r *postgresRepository) GetAPIKey(ctx context.Context, id string) (*APIKey, error) {
+	return scanAPIKey(r.db.QueryRowContext(ctx, "SELECT "+apiKeyColumns+" FROM api_keys WHERE id = $1", id))
+}
+
+func (r *postgresRepository) GetAPIKeyByHash(ctx context.Context, hash string) (*APIKey, error) {
+	return scanAPIKey(r.db.QueryRowContext(ctx, "SELECT "+apiKeyColumns+" FROM api_keys WHERE hash = $1", hash))
+}
+
+func (r *postgresRepository) ListAPIKeys(ctx context.Context, accountID string) ([]*APIKey, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT "+apiKeyColumns+" FROM api_keys WHERE account_id = $1 ORDER BY created_at DESC", accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	keys := []*APIKey{}
+	for rows.Next() {
+		k, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, k)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// RevokeAPIKey keeps the time a key was first revoked. It fails with
+// sql.ErrNoRows for a key that doesn't exist.
+func (r *postgresRepository) RevokeAPIKey(ctx context.Context, id string, at time.Time) error {
+	return execOne(r.db.ExecContext(ctx, "UPDATE api_keys SET revoked_at = COALESCE(revoked_at, $2) WHERE id = $1", id, at))
+}
+
+func (r *postgresRepository) TouchAPIKey(ctx context.Context, id string, at time.Time) error {
+	_, err := r.db.ExecContext(ctx, "UPDATE api_keys SET last_used_at = $2 WHERE id = $1", id, at)
+	return err
+}
+
+// execOne turns an update that matched no row into sql.ErrNoRows.
+func execOne(res sql.Result, err error) error {
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func uniqueError(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" { // unique_violation
+		return ErrAccountExists
+	}
+	return err
+}
@@ -1 +1,362 @@
 package account
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/jochem11/inventory-system-back/account/pb"
+	"github.com/jochem11/inventory-system-back/internal/logging"
+	"github.com/jochem11/inventory-system-back/internal/metrics"
+	"github.com/jochem11/inventory-system-back/internal/serve"
+	"github.com/jochem11/inventory-system-back/internal/tracing"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type grpcServer struct {
+	pb.UnimplementedAccountServiceServer
+	service Service
+}
+
+// ListenGRPC serves s until ctx is cancelled and then drains in-flight RPCs.
+// The grpc.health.v1 status follows the database connection.
+func ListenGRPC(ctx context.Context, s Service, r Repository, opts serve.GRPCOptions) error {
+	serv := grpc.NewServer(
+		grpc.Creds(opts.Creds),
+		tracing.ServerOption(),
+		grpc.ChainUnaryInterceptor(
+			logging.UnaryServerInterceptor(),
+			metrics.GRPCServer.UnaryServerInterceptor(),
+			serve.TimeoutInterceptor(opts.Timeout),
+		),
+		grpc.ChainStreamInterceptor(logging.StreamServerInterceptor(), metrics.GRPCServer.StreamServerInterceptor()),
+	)
+	pb.RegisterAccountServiceServer(serv, &grpcServer{
+		service: s,
+	})
+	metrics.InitGRPCServer(serv)
+	serve.RegisterHealth(ctx, serv, pb.AccountService_ServiceDesc.ServiceName, r.Ping)
+	return serve.GRPC(ctx, serv, opts.Port, opts.Drain)
+}
+
+// --- Account Methods ---
+
+func (s *grpcServer) PostAccount(ctx context.Context, req *pb.PostAccountRequest) (*pb.PostAccountResponse, error) {
+	a, err := s.service.PostAccount(ctx, req.FirstName, req.Insertion, req.LastName, req.Email, req.CardNumber, req.Role, req.Language, req.ClassId)
+	if err != nil {
+		return nil, accountError(err)
+	}
+	return &pb.PostAccountResponse{Account: accountToProto(a)}, nil
+}
+
+func (s *grpcServer) GetAccount(ctx context.Context, req *pb.GetAccountRequest) (*pb.GetAccountResponse, error) {
+	a, err := s.service.GetAccount(ctx, req.Id)
+	if err != nil {
+		return nil, accountError(err)
+	}
+	return &pb.GetAccountResponse{Account: accountToProto(a)}, nil
+}
+
+func (s *grpcServer) GetAccountByEmail(ctx context.Context, req *pb.GetAccountByEmailRequest) (*pb.GetAccountResponse, error) {
+	a, err := s.service.GetAccountByEmail(ctx, req.Email)
+	if err != nil {
+		return nil, accountError(err)
+	}
+	return &pb.GetAccountResponse{Account: accountToProto(a)}, nil
+}
+
+func (s *grpcServer) GetAccounts(ctx context.Context, req *pb.GetAccountsRequest) (*pb.GetAccountsResponse, error) {
+	res, err := s.service.GetAccounts(ctx, &req.Skip, &req.Take, req.ClassId, req.State)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetAccountsResponse{Accounts: accountsToProto(res)}, nil
+}
+
+func (s *grpcServer) GetAccountsByIDs(ctx context.Context, req *pb.GetAccountsByIDsRequest) (*pb.GetAccountsResponse, error) {
+	res, err := s.service.GetAccountsByIDs(ctx, req.Ids)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetAccountsResponse{Accounts: accountsToProto(res)}, nil
+}
+
+func (s *grpcServer) UpdateAccount(ctx context.Context, req *pb.UpdateAccountRequest) (*pb.UpdateAccountResponse, error) {
+	a, err := s.service.UpdateAccount(ctx, req.Id, req.FirstName, req.Insertion, req.LastName, req.Email, req.CardNumber, req.Role, req.Language, req.ClassId, req.ClearClass)
+	if err != nil {
+		return nil, accountError(err)
+	}
+	return &pb.UpdateAccountResponse{Account: accountToProto(a)}, nil
+}
+
+// --- Lifecycle Methods ---
+
+func (s *grpcServer) SetAccountState(ctx context.Context, req *pb.SetAccountStateRequest) (*pb.SetAccountStateResponse, error) {
+	a, err := s.service.SetAccountState(ctx, req.Id, req.State)
+	if err != nil {
+		return nil, accountError(err)
+	}
+	return &pb.SetAccountStateResponse{Account: accountToProto(a)}, nil
+}
+
+func (s *grpcServer) AnonymiseAccount(ctx context.Context, req *pb.AnonymiseAccountRequest) (*pb.AnonymiseAccountResponse, error) {
+	a, err := s.service.AnonymiseAccount(ctx, req.Id)
+	if err != nil {
+		return nil, accountError(err)
+	}
+	return &pb.AnonymiseAccountResponse{Account: accountToProto(a)}, nil
+}
+
+func (s *grpcServer) SetClassAccountState(ctx context.Context, req *pb.SetClassAccountStateRequest) (*pb.BatchResponse, error) {
+	res, err := s.service.SetClassAccountState(ctx, req.ClassId, req.State)
+	if err != nil {
+		return nil, accountError(err)
+	}
+	return batchToProto(res), nil
+}
+
+func (s *grpcServer) AnonymiseClass(ctx context.Context, req *pb.AnonymiseClassRequest) (*pb.BatchResponse, error) {
+	res, err := s.service.AnonymiseClass(ctx, req.ClassId)
+	if err != nil {
+		return nil, accountError(err)
+	}
+	return batchToProto(res), nil
+}
+
+// --- Password Methods ---
+
+func (s *grpcServer) SetPassword(ctx context.Context, req *pb.SetPasswordRequest) (*pb.SetPasswordResponse, error) {
+	if err := s.service.SetPassword(ctx, req.Id, req.Password); err != nil {
+		return nil, accountError(err)
+	}
+	return &pb.SetPasswordResponse{}, nil
+}
+
+func (s *grpcServer) Authenticate(ctx context.Context, req *pb.AuthenticateRequest) (*pb.AuthenticateResponse, error) {
+	a, secondFactor, err := s.service.Authenticate(ctx, req.Email, req.Password)
+	if err != nil {
+		return nil, accountError(err)
+	}
+	return &pb.AuthenticateResponse{Account: accountToProto(a), SecondFactor: secondFactor}, nil
+}
+
+func (s *grpcServer) AuthenticateExternal(ctx context.Context, req *pb.AuthenticateExternalRequest) (*pb.AuthenticateResponse, error) {
+	a, secondFactor, err := s.service.AuthenticateExternal(ctx, req.Email, req.FirstName, req.LastName, req.Role, req.Link)
+	if err != nil {
+		return nil, accountError(err)
+	}
+	return &pb.AuthenticateResponse{Account: accountToProto(a), SecondFactor: secondFactor}, nil
+}
+
+// --- Email Methods ---
+
+func (s *grpcServer) SendVerification(ctx context.Context, req *pb.SendVerificationRequest) (*pb.SendVerificationResponse, error) {
+	if err := s.service.SendVerification(ctx, req.Id); err != nil {
+		return nil, accountError(err)
+	}
+	return &pb.SendVerificationResponse{}, nil
+}
+
+func (s *grpcServer) VerifyEmail(ctx context.Context, req *pb.VerifyEmailRequest) (*pb.VerifyEmailResponse, error) {
+	a, err := s.service.VerifyEmail(ctx, req.Token, req.Password)
+	if err != nil {
+		return nil, accountError(err)
+	}
+	return &pb.VerifyEmailResponse{Account: accountToProto(a)}, nil
+}
+
+func (s *grpcServer) RequestPasswordReset(ctx context.Context, req *pb.RequestPasswordResetRequest) (*pb.RequestPasswordResetResponse, error) {
+	if err := s.service.RequestPasswordReset(ctx, req.Email); err != nil {
+		return nil, accountError(err)
+	}
+	return &pb.RequestPasswordResetResponse{}, nil
+}
+
+func (s *grpcServer) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*pb.ResetPasswordResponse, error) {
+	if err := s.service.ResetPassword(ctx, req.Token, req.Password); err != nil {
+		return nil, accountError(err)
+	}
+	return &pb.ResetPasswordResponse{}, nil
+}
+
+// --- Two-factor Methods ---
+
+func (s *grpcServer) BeginTOTP(ctx context.Context, req *pb.BeginTOTPRequest) (*pb.BeginTOTPResponse, error) {
+	setup, err := s.service.BeginTOTP(ctx, req.Id)
+	if err != nil {
+		return nil, accountError(err)
+	}
+	return &pb.BeginTOTPResponse{Secret: setup.Secret, Uri: setup.URI}, nil
+}
+
+func (s *grpcServer) EnableTOTP(ctx context.Context, req *pb.EnableTOTPRequest) (*pb.EnableTOTPResponse, error) {
+	codes, err := s.service.EnableTOTP(ctx, req.Id, req.Code)
+	if err != nil {
+		return nil, accountError(err)
+	}
+	return &pb.EnableTOTPResponse{RecoveryCodes: codes}, nil
+}
+
+func (s *grpcServer) VerifyTOTP(ctx context.Context, req *pb.VerifyTOTPRequest) (*pb.TOTPResponse, error) {
+	a, err := s.service.VerifyTOTP(ctx, req.Id, req.Code)
+	if err != nil {
+		return nil, accountError(err)
+	}
+	return &pb.TOTPResponse{Account: accountToProto(a)}, nil
+}
+
+func (s *grpcServer) DisableTOTP(ctx context.Context, req *pb.DisableTOTPRequest) (*pb.TOTPResponse, error) {
+	a, err := s.service.DisableTOTP(ctx, req.Id, req.Code)
+	if err != nil {
+		return nil, accountError(err)
+	}
+	return &pb.TOTPResponse{Account: accountToProto(a)}, nil
+}
+
+func (s *grpcServer) ResetTOTP(ctx context.Context, req *pb.ResetTOTPRequest) (*pb.TOTPResponse, error) {
+	a, err := s.service.ResetTOTP(ctx, req.Id)
+	if err != nil {
+		return nil, accountError(err)
+	}
+	return &pb.TOTPResponse{Account: accountToProto(a)}, nil
+}
+
+// --- Calendar Methods ---
+
+func (s *grpcServer) ResetCalendarFeeds(ctx context.Context, req *pb.ResetCalendarFeedsRequest) (*pb.ResetCalendarFeedsResponse, error) {
+	a, err := s.service.ResetCalendarFeeds(ctx, req.Id)
+	if err != nil {
+		return nil, accountError(err)
+	}
+	return &pb.ResetCalendarFeedsResponse{Account: accountToProto(a)}, nil
+}
+
+// --- API Key Methods ---
+
+func (s *grpcServer) CreateAPIKey(ctx context.Context, req *pb.CreateAPIKeyRequest) (*pb.CreateAPIKeyResponse, error) {
+	k, key, err := s.service.CreateAPIKey(ctx, req.AccountId, req.Name, req.Scopes)
+	if err != nil {
+		return nil, accountError(err)
+	}
+	return &pb.CreateAPIKeyResponse{Key: key, ApiKey: apiKeyToProto(k)}, nil
+}
+
+func (s *grpcServer) GetAPIKeys(ctx context.Context, req *pb.GetAPIKeysRequest) (*pb.GetAPIKeysResponse, error) {
+	res, err := s.service.GetAPIKeys(ctx, req.AccountId)
+	if err != nil {
+		return nil, accountError(err)
+	}
+	keys := []*pb.APIKey{}
+	for _, k := range res {
+		keys = append(keys, apiKeyToProto(k))
+	}
+	return &pb.GetAPIKeysResponse{ApiKeys: keys}, nil
+}
+
+func (s *grpcServer) RevokeAPIKey(ctx context.Context, req *pb.RevokeAPIKeyRequest) (*pb.RevokeAPIKeyResponse, error) {
+	k, err := s.service.RevokeAPIKey(ctx, req.Id)
+	if err != nil {
+		return nil, accountError(err)
+	}
+	return &pb.RevokeAPIKeyResponse{ApiKey: apiKeyToProto(k)}, nil
+}
+
+func (s *grpcServer) AuthenticateAPIKey(ctx context.Context, req *pb.AuthenticateAPIKeyRequest) (*pb.AuthenticateAPIKeyResponse, error) {
+	a, k, err := s.service.AuthenticateAPIKey(ctx, req.Key)
+	if err != nil {
+		return nil, accountError(err)
+	}
+	return &pb.AuthenticateAPIKeyResponse{Account: accountToProto(a), ApiKey: apiKeyToProto(k)}, nil
+}
+
+// accountError gives the validation and lifecycle errors a status code the
+// gateway can tell apart from a failure.
+func accountError(err error) error {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return status.Error(codes.NotFound, "account not found")
+	case errors.Is(err, ErrAPIKeyNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, ErrInvalidAccount), errors.Is(err, ErrInvalidRole), errors.Is(err, ErrInvalidState),
+		errors.Is(err, ErrWeakPassword), errors.Is(err, ErrInvalidLanguage), errors.Is(err, ErrInvalidToken),
+		errors.Is(err, ErrPasswordRequired), errors.Is(err, ErrInvalidService), errors.Is(err, ErrInvalidAPIKey),
+		errors.Is(err, ErrInvalidScope):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ErrAccountExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, ErrBadCredentials), errors.Is(err, ErrBadCode), errors.Is(err, ErrBadAPIKey):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, ErrInvalidTransition), errors.Is(err, ErrNotAStudent), errors.Is(err, ErrOpenLends),
+		errors.Is(err, ErrNotClosed), errors.Is(err, ErrAnonymised), errors.Is(err, ErrEmailVerified),
+		errors.Is(err, ErrTOTPEnabled), errors.Is(err, ErrTOTPNotStarted), errors.Is(err, ErrTOTPOff), errors.Is(err, ErrTOTPRequired),
+		errors.Is(err, ErrServiceRole), errors.Is(err, ErrServiceAccount), errors.Is(err, ErrNotAService),
+		errors.Is(err, ErrNotLinked):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return err
+}
+
+// --- Conversions ---
+
+func accountToProto(a *Account) *pb.Account {
+	return &pb.Account{
+		Id:                a.ID,
+		FirstName:         a.FirstName,
+		Insertion:         a.Insertion,
+		LastName:          a.LastName,
+		Email:             a.Email,
+		CardNumber:        a.CardNumber,
+		Role:              a.Role,
+		State:             a.State,
+		ClassId:           a.ClassID,
+		AnonymisedAt:      timeToProto(a.AnonymisedAt),
+		CreatedAt:         timestamppb.New(a.CreatedAt),
+		UpdatedAt:         timestamppb.New(a.UpdatedAt),
+		Language:          a.Language,
+		EmailVerifiedAt:   timeToProto(a.EmailVerifiedAt),
+		TotpEnabledAt:     timeToProto(a.TOTPEnabledAt),
+		RecoveryCodesLeft: int32(a.RecoveryCodesLeft),
+		SessionVersion:    int32(a.SessionVersion),
+		CalendarVersion:   int32(a.CalendarVersion),
+	}
+}
+
+func accountsToProto(res []*Account) []*pb.Account {
+	accounts := []*pb.Account{}
+	for _, a := range res {
+		accounts = append(accounts, accountToProto(a))
+	}
+	return accounts
+}
+
+func apiKeyToProto(k *APIKey) *pb.APIKey {
+	return &pb.APIKey{
+		Id:         k.ID,
+		AccountId:  k.AccountID,
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		Scopes:     k.Scopes,
+		LastUsedAt: timeToProto(k.LastUsedAt),
+		RevokedAt:  timeToProto(k.RevokedAt),
+		CreatedAt:  timestamppb.New(k.CreatedAt),
+	}
+}
+
+func batchToProto(b *BatchResult) *pb.BatchResponse {
+	skipped := []*pb.BatchSkip{}
+	for _, s := range b.Skipped {
+		skipped = append(skipped, &pb.BatchSkip{AccountId: s.AccountID, Reason: s.Reason})
+	}
+	return &pb.BatchResponse{Changed: accountsToProto(b.Changed), Skipped: skipped}
+}
+
+func timeToProto(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
@@ -1,11 +1,953 @@
 package account
 
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"net/mail"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jochem11/inventory-system-back/internal/config"
+	"github.com/segmentio/ksuid"
+)
+
+const (
+	RoleStudent = "STUDENT"
+	RoleTeacher = "TEACHER"
+	RoleAdmin   = "ADMIN"
+	// RoleService is for kiosks and integrations, which call the gateway
+	// with API keys instead of logging in.
+	RoleService = "SERVICE"
+)
+
+const (
+	StateInvited   = "INVITED"
+	StateActive    = "ACTIVE"
+	StateSuspended = "SUSPENDED"
+	StateGraduated = "GRADUATED"
+	StateDeleted   = "DELETED"
+)
+
+var (
+	ErrInvalidAccount    = errors.New("first name, last name and a valid email are required")
+	ErrInvalidRole       = errors.New("invalid account role")
+	ErrInvalidState      = errors.New("invalid account state")
+	ErrInvalidTransition = errors.New("invalid account state transition")
+	ErrNotAStudent       = errors.New("only student accounts can graduate")
+	ErrOpenLends         = errors.New("account still has items on loan")
+	ErrNotClosed         = errors.New("only graduated or deleted accounts can be anonymised")
+	ErrAnonymised        = errors.New("account has been anonymised")
+	ErrAccountExists     = errors.New("email or card number already in use")
+	ErrWeakPassword      = errors.New("password must be at least 10 characters")
+	ErrBadCredentials    = errors.New("wrong email or password")
+	ErrInvalidLanguage   = errors.New("invalid account language")
+	ErrInvalidToken      = errors.New("invalid or expired token")
+	ErrPasswordRequired  = errors.New("choose a password to accept the invitation")
+	ErrEmailVerified     = errors.New("email is already verified")
+	ErrTOTPEnabled       = errors.New("two-factor authentication is already on")
+	ErrTOTPNotStarted    = errors.New("start setting up two-factor authentication first")
+	ErrTOTPOff           = errors.New("two-factor authentication is off")
+	ErrTOTPRequired      = errors.New("two-factor authentication is required for this role")
+	ErrBadCode           = errors.New("wrong or already used code")
+	ErrInvalidService    = errors.New("service accounts need a name and no email or card number")
+	ErrServiceRole       = errors.New("the SERVICE role can't be given to or taken from an existing account")
+	ErrServiceAccount    = errors.New("service accounts only use API keys")
+	ErrNotLinked         = errors.New("the account has to be logged in to with its password")
+	ErrNotAService       = errors.New("API keys are only for service accounts")
+	ErrInvalidAPIKey     = errors.New("API keys need a name")
+	ErrInvalidScope      = errors.New("scopes look like inventory:read or lend:write")
+	ErrBadAPIKey         = errors.New("invalid or revoked API key")
+	ErrAPIKeyNotFound    = errors.New("API key not found")
+)
+
+// stateTransitions lists the states an account may move to from its current
+// state. Deleted is final, it only waits to be anonymised.
+var stateTransitions = map[string][]string{
+	StateInvited:   {StateActive, StateDeleted},
+	StateActive:    {StateSuspended, StateGraduated, StateDeleted},
+	StateSuspended: {StateActive, StateGraduated, StateDeleted},
+	StateGraduated: {StateActive, StateDeleted},
+}
+
+var roles = []string{RoleStudent, RoleTeacher, RoleAdmin, RoleService}
+
+// Lends tells the account service which accounts still have items out, so it
+// can keep them from leaving. OpenLends leaves out accounts without any.
+type Lends interface {
+	OpenLends(ctx context.Context, accountIDs []string) (map[string]int, error)
+}
+
+// NoLends stands in for the lend service, which keeps no lends yet.
+type NoLends struct{}
+
+func (NoLends) OpenLends(context.Context, []string) (map[string]int, error) {
+	return nil, nil
+}
+
+type Service interface {
+	PostAccount(ctx context.Context, firstName, insertion, lastName, email, cardNumber, role, language string, classID *string) (*Account, error)
+	GetAccount(ctx context.Context, id string) (*Account, error)
+	GetAccountByEmail(ctx context.Context, email string) (*Account, error)
+	GetAccounts(ctx context.Context, skip *uint64, take *uint64, classID, state *string) ([]*Account, error)
+	GetAccountsByIDs(ctx context.Context, ids []string) ([]*Account, error)
+	UpdateAccount(ctx context.Context, id string, firstName, insertion, lastName, email, cardNumber, role, language, classID *string, clearClass bool) (*Account, error)
+
+	SetAccountState(ctx context.Context, id, state string) (*Account, error)
+	AnonymiseAccount(ctx context.Context, id string) (*Account, error)
+	SetClassAccountState(ctx context.Context, classID, state string) (*BatchResult, error)
+	AnonymiseClass(ctx context.Context, classID string) (*BatchResult, error)
+
+	SetPassword(ctx context.Context, id, password string) error
+	Authenticate(ctx context.Context, email, password string) (*Account, string, error)
+	AuthenticateExternal(ctx context.Context, email, firstName, lastName, role string, link bool) (*Account, string, error)
+
+	SendVerification(ctx context.Context, id string) error
+	VerifyEmail(ctx context.Context, token string, password *string) (*Account, error)
+	RequestPasswordReset(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, token, password string) error
+
+	BeginTOTP(ctx context.Context, id string) (*TOTPSetup, error)
+	EnableTOTP(ctx context.Context, id, code string) ([]string, error)
+	VerifyTOTP(ctx context.Context, id, code string) (*Account, error)
+	DisableTOTP(ctx context.Context, id, code string) (*Account, error)
+	ResetTOTP(ctx context.Context, id string) (*Account, error)
+
+	ResetCalendarFeeds(ctx context.Context, id string) (*Account, error)
+
+	CreateAPIKey(ctx context.Context, accountID, name string, scopes []string) (*APIKey, string, error)
+	GetAPIKeys(ctx context.Context, accountID string) ([]*APIKey, error)
+	RevokeAPIKey(ctx context.Context, id string) (*APIKey, error)
+	AuthenticateAPIKey(ctx context.Context, key string) (*Account, *APIKey, error)
+}
+
+func NewAccountService(r Repository, paging config.Paging, lends Lends, mails Mails, totp TOTPPolicy) Service {
+	return &accountService{r, paging, lends, mails, totp}
+}
+
+// Account is a person who can borrow, or for staff manage, the inventory.
+// Anonymising blanks the personal details but keeps the ID, role, state and
+// class, so lends made by the account still count towards statistics.
 type Account struct {
-	ID         string `json:"id"`
-	FirstName  string `json:"firstName"`
-	Insertion  string `json:"insertion"`
-	LastName   string `json:"lastName"`
-	Email      string `json:"email"`
-	Password   string `json:"password"`
-	CardNumber string `json:"cardNumber"`
+	ID                string     `json:"id"`
+	FirstName         string     `json:"firstName"`
+	Insertion         string     `json:"insertion"`
+	LastName          string     `json:"lastName"`
+	Email             string     `json:"email"`
+	Password          string     `json:"-"` // hash, never leaves the service
+	CardNumber        string     `json:"cardNumber"`
+	Role              string     `json:"role"`
+	State             string     `json:"state"`
+	ClassID           *string    `json:"classId,omitempty"` // optional: education.Class of a student
+	Language          string     `json:"language"`
+	EmailVerifiedAt   *time.Time `json:"emailVerifiedAt,omitempty"` // cleared when the email changes
+	TOTPSecret        string     `json:"-"`                         // never leaves the service, set before TOTPEnabledAt while setting up
+	TOTPEnabledAt     *time.Time `json:"totpEnabledAt,omitempty"`
+	TOTPLastStep      int64      `json:"-"` // the step of the last code used, which can't be used again
+	RecoveryCodesLeft int        `json:"recoveryCodesLeft"`
+	SessionVersion    int        `json:"-"` // goes up when the password changes, ending the sessions before
+	CalendarVersion   int        `json:"-"` // goes up when the calendar feeds are reset, ending the feed URLs before
+	ExternalLinkedAt  *time.Time `json:"-"` // set once a single sign-on login created or took over the account
+	AnonymisedAt      *time.Time `json:"anonymisedAt,omitempty"`
+	CreatedAt         time.Time  `json:"createdAt"`
+	UpdatedAt         time.Time  `json:"updatedAt"`
+}
+
+// BatchResult reports an operation on all accounts of a class. Accounts it
+// had nothing to do for are in neither list.
+type BatchResult struct {
+	Changed []*Account
+	Skipped []*BatchSkip
+}
+
+type BatchSkip struct {
+	AccountID string
+	Reason    string
+}
+
+type accountService struct {
+	repository Repository
+	paging     config.Paging
+	lends      Lends
+	mails      Mails
+	totp       TOTPPolicy
+}
+
+func (s *accountService) defaultSkipTake(skip *uint64, take *uint64) (*uint64, *uint64, error) {
+	if skip == nil {
+		skip = new(uint64)
+	}
+	t, err := s.paging.Take(take)
+	if err != nil {
+		return nil, nil, err
+	}
+	return skip, &t, nil
+}
+
+// PostAccount invites an account and mails it a link to verify its email,
+// which accepts the invitation. The account is created even if the mail
+// can't be sent, SendVerification tries again. Service accounts have no
+// email, they are active straight away.
+func (s *accountService) PostAccount(ctx context.Context, firstName, insertion, lastName, email, cardNumber, role, language string, classID *string) (*Account, error) {
+	if language == "" {
+		language = LanguageDutch
+	}
+	a := &Account{
+		ID:         ksuid.New().String(),
+		FirstName:  strings.TrimSpace(firstName),
+		Insertion:  strings.TrimSpace(insertion),
+		LastName:   strings.TrimSpace(lastName),
+		Email:      normaliseEmail(email),
+		CardNumber: strings.TrimSpace(cardNumber),
+		Role:       role,
+		State:      StateInvited,
+		ClassID:    classID,
+		Language:   language,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+	}
+	if role == RoleService {
+		a.State = StateActive
+	}
+	if err := a.validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.repository.PutAccount(ctx, a); err != nil {
+		return nil, err
+	}
+	if a.Role == RoleService {
+		return a, nil
+	}
+	if err := s.mailToken(ctx, a, purposeVerify); err != nil {
+		slog.ErrorContext(ctx, "Mailing email verification", "account", a.ID, "err", err)
+	}
+	return a, nil
+}
+
+func (s *accountService) GetAccount(ctx context.Context, id string) (*Account, error) {
+	return s.repository.GetAccountByID(ctx, id)
+}
+
+func (s *accountService) GetAccountByEmail(ctx context.Context, email string) (*Account, error) {
+	return s.repository.GetAccountByEmail(ctx, normaliseEmail(email))
+}
+
+func (s *accountService) GetAccounts(ctx context.Context, skip *uint64, take *uint64, classID, state *string) ([]*Account, error) {
+	skip, take, err := s.defaultSkipTake(skip, take)
+	if err != nil {
+		return nil, err
+	}
+	return s.repository.ListAccounts(ctx, *skip, *take, classID, state)
+}
+
+func (s *accountService) GetAccountsByIDs(ctx context.Context, ids []string) ([]*Account, error) {
+	return s.repository.ListAccountsByIDs(ctx, ids)
+}
+
+// UpdateAccount changes the given fields. A new email has to be verified
+// again, the link goes out like it does for a new account.
+func (s *accountService) UpdateAccount(ctx context.Context, id string, firstName, insertion, lastName, email, cardNumber, role, language, classID *string, clearClass bool) (*Account, error) {
+	existing, err := s.repository.GetAccountByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if existing.AnonymisedAt != nil {
+		return nil, ErrAnonymised
+	}
+
+	if firstName != nil {
+		existing.FirstName = strings.TrimSpace(*firstName)
+	}
+	if insertion != nil {
+		existing.Insertion = strings.TrimSpace(*insertion)
+	}
+	if lastName != nil {
+		existing.LastName = strings.TrimSpace(*lastName)
+	}
+	emailChanged := false
+	if email != nil && normaliseEmail(*email) != existing.Email {
+		existing.Email = normaliseEmail(*email)
+		existing.EmailVerifiedAt = nil
+		existing.ExternalLinkedAt = nil
+		emailChanged = true
+	}
+	if cardNumber != nil {
+		existing.CardNumber = strings.TrimSpace(*cardNumber)
+	}
+	if role != nil {
+		if *role != existing.Role && (*role == RoleService || existing.Role == RoleService) {
+			return nil, ErrServiceRole
+		}
+		existing.Role = *role
+	}
+	if language != nil {
+		existing.Language = *language
+	}
+	if clearClass {
+		existing.ClassID = nil
+	} else if classID != nil {
+		existing.ClassID = classID
+	}
+	if err := existing.validate(); err != nil {
+		return nil, err
+	}
+
+	existing.UpdatedAt = time.Now()
+
+	updated, err := s.repository.UpdateAccount(ctx, existing)
+	if err != nil {
+		return nil, err
+	}
+	if emailChanged {
+		if err := s.mailToken(ctx, updated, purposeVerify); err != nil {
+			slog.ErrorContext(ctx, "Mailing email verification", "account", updated.ID, "err", err)
+		}
+	}
+	return updated, nil
+}
+
+func (a *Account) validate() error {
+	if a.Role == RoleService {
+		if a.FirstName == "" || a.Email != "" || a.CardNumber != "" {
+			return ErrInvalidService
+		}
+		if !slices.Contains(languages, a.Language) {
+			return ErrInvalidLanguage
+		}
+		return nil
+	}
+	if a.FirstName == "" || a.LastName == "" {
+		return ErrInvalidAccount
+	}
+	if addr, err := mail.ParseAddress(a.Email); err != nil || addr.Address != a.Email {
+		return ErrInvalidAccount
+	}
+	if !slices.Contains(roles, a.Role) {
+		return ErrInvalidRole
+	}
+	if !slices.Contains(languages, a.Language) {
+		return ErrInvalidLanguage
+	}
+	return nil
+}
+
+func normaliseEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// SetAccountState moves an account to state. Setting the state it is in
+// already changes nothing.
+func (s *accountService) SetAccountState(ctx context.Context, id, state string) (*Account, error) {
+	a, err := s.repository.GetAccountByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.setStates(ctx, []*Account{a}, state)
+	if err != nil {
+		return nil, err
+	}
+	return single(a, res)
+}
+
+// AnonymiseAccount scrubs the personal details of a graduated or deleted
+// account and leaves it deleted. Anonymising it again changes nothing.
+func (s *accountService) AnonymiseAccount(ctx context.Context, id string) (*Account, error) {
+	a, err := s.repository.GetAccountByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.anonymise(ctx, []*Account{a})
+	if err != nil {
+		return nil, err
+	}
+	return single(a, res)
+}
+
+// SetClassAccountState is SetAccountState for every account in a class.
+// Accounts that can't make the move are skipped, the others still move.
+func (s *accountService) SetClassAccountState(ctx context.Context, classID, state string) (*BatchResult, error) {
+	accounts, err := s.repository.ListAccountsInClass(ctx, classID)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.setStates(ctx, accounts, state)
+	if err != nil {
+		return nil, err
+	}
+	return res.report(), nil
+}
+
+// AnonymiseClass is AnonymiseAccount for every account in a class, skipping
+// those still enrolled or with items on loan.
+func (s *accountService) AnonymiseClass(ctx context.Context, classID string) (*BatchResult, error) {
+	accounts, err := s.repository.ListAccountsInClass(ctx, classID)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.anonymise(ctx, accounts)
+	if err != nil {
+		return nil, err
+	}
+	return res.report(), nil
+}
+
+// batch sorts the accounts of an operation into the ones it changed and the
+// ones it had to skip.
+type batch struct {
+	changed []*Account
+	skipped map[string]error
+}
+
+func (b *batch) report() *BatchResult {
+	res := &BatchResult{Changed: b.changed, Skipped: []*BatchSkip{}}
+	for id, err := range b.skipped {
+		res.Skipped = append(res.Skipped, &BatchSkip{AccountID: id, Reason: err.Error()})
+	}
+	slices.SortFunc(res.Skipped, func(a, b *BatchSkip) int { return strings.Compare(a.AccountID, b.AccountID) })
+	return res
+}
+
+// single turns a batch of one back into that account or the reason it was
+// skipped.
+func single(a *Account, b *batch) (*Account, error) {
+	if err := b.skipped[a.ID]; err != nil {
+		return nil, err
+	}
+	if len(b.changed) == 1 {
+		return b.changed[0], nil
+	}
+	return a, nil
+}
+
+func (s *accountService) setStates(ctx context.Context, accounts []*Account, state string) (*batch, error) {
+	if _, ok := stateTransitions[state]; !ok && state != StateDeleted {
+		return nil, ErrInvalidState
+	}
+
+	// Graduating or deleting an account loses track of what it borrowed.
+	checkLends := state == StateGraduated || state == StateDeleted
+	return s.apply(ctx, accounts, checkLends, func(a *Account) (bool, error) {
+		switch {
+		case a.State == state:
+			return false, nil
+		case a.AnonymisedAt != nil:
+			return false, ErrAnonymised
+		case !slices.Contains(stateTransitions[a.State], state):
+			return false, ErrInvalidTransition
+		case state == StateGraduated && a.Role != RoleStudent:
+			return false, ErrNotAStudent
+		}
+		return true, nil
+	}, func(ctx context.Context, ids []string, at time.Time) error {
+		return s.repository.SetAccountStates(ctx, ids, state, at)
+	})
+}
+
+func (s *accountService) anonymise(ctx context.Context, accounts []*Account) (*batch, error) {
+	return s.apply(ctx, accounts, true, func(a *Account) (bool, error) {
+		switch {
+		case a.AnonymisedAt != nil:
+			return false, nil
+		case a.State != StateGraduated && a.State != StateDeleted:
+			return false, ErrNotClosed
+		}
+		return true, nil
+	}, s.repository.AnonymiseAccounts)
+}
+
+// apply runs an operation on the accounts check lets through, in one
+// statement so a class is never left half done. check reports false without
+// an error for accounts there is nothing to do for.
+func (s *accountService) apply(ctx context.Context, accounts []*Account, checkLends bool, check func(*Account) (bool, error), update func(ctx context.Context, ids []string, at time.Time) error) (*batch, error) {
+	b := &batch{changed: []*Account{}, skipped: map[string]error{}}
+	var ids []string
+	for _, a := range accounts {
+		ok, err := check(a)
+		if err != nil {
+			b.skipped[a.ID] = err
+		}
+		if ok {
+			ids = append(ids, a.ID)
+		}
+	}
+
+	if checkLends && len(ids) > 0 {
+		open, err := s.lends.OpenLends(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		ids = slices.DeleteFunc(ids, func(id string) bool {
+			if open[id] > 0 {
+				b.skipped[id] = ErrOpenLends
+				return true
+			}
+			return false
+		})
+	}
+	if len(ids) == 0 {
+		return b, nil
+	}
+
+	if err := update(ctx, ids, time.Now()); err != nil {
+		return nil, err
+	}
+	changed, err := s.repository.ListAccountsByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	b.changed = changed
+	slog.InfoContext(ctx, "Changed accounts", "count", len(ids), "skipped", len(b.skipped))
+	return b, nil
+}
+
+// SetPassword replaces the password of an account. The password is only
+// kept as a hash.
+func (s *accountService) SetPassword(ctx context.Context, id, password string) error {
+	if len(password) < minPasswordLength {
+		return ErrWeakPassword
+	}
+	a, err := s.repository.GetAccountByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	switch {
+	case a.AnonymisedAt != nil:
+		return ErrAnonymised
+	case a.Role == RoleService:
+		return ErrServiceAccount
+	}
+
+	hash, err := hashPassword(password)
+	if err != nil {
+		return err
+	}
+	return s.repository.SetPassword(ctx, id, hash, time.Now())
+}
+
+// Authenticate returns the active account with email and password, and the
+// second step the login still needs. Unknown emails, wrong passwords and
+// accounts that aren't active all fail the same way, so a caller can't tell
+// which accounts exist.
+func (s *accountService) Authenticate(ctx context.Context, email, password string) (*Account, string, error) {
+	a, err := s.repository.GetAccountByEmail(ctx, normaliseEmail(email))
+	if errors.Is(err, sql.ErrNoRows) {
+		// Hash anyway, so an unknown email takes as long as a wrong password.
+		checkPassword(dummyPasswordHash, password)
+		return nil, "", ErrBadCredentials
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	if !checkPassword(a.Password, password) || a.State != StateActive {
+		return nil, "", ErrBadCredentials
+	}
+	return a, s.secondFactor(a), nil
+}
+
+// AuthenticateExternal logs in the account with an email a single sign-on
+// provider vouched for. An unknown email gets an active account with role
+// and the names from the provider, an invited one is activated: the
+// provider did what the verification email would. Later logins leave the
+// names and role alone, they are managed here.
+//
+// The first login through the provider links it to an existing account.
+// Without link, that only works for students without a password: whoever
+// controls the email at the provider shouldn't get a staff account or one
+// someone else set a password for.
+func (s *accountService) AuthenticateExternal(ctx context.Context, email, firstName, lastName, role string, link bool) (*Account, string, error) {
+	now := time.Now()
+	a, err := s.repository.GetAccountByEmail(ctx, normaliseEmail(email))
+	if errors.Is(err, sql.ErrNoRows) {
+		a = &Account{
+			ID:               ksuid.New().String(),
+			FirstName:        strings.TrimSpace(firstName),
+			LastName:         strings.TrimSpace(lastName),
+			Email:            normaliseEmail(email),
+			Role:             role,
+			State:            StateActive,
+			Language:         LanguageDutch,
+			EmailVerifiedAt:  &now,
+			ExternalLinkedAt: &now,
+			CreatedAt:        now,
+			UpdatedAt:        now,
+		}
+		if err := a.validate(); err != nil {
+			return nil, "", err
+		}
+		if err := s.repository.PutAccount(ctx, a); err != nil {
+			return nil, "", err
+		}
+		slog.InfoContext(ctx, "Provisioned account from single sign-on", "account", a.ID, "role", a.Role)
+		return a, s.secondFactor(a), nil
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	if a.ExternalLinkedAt == nil {
+		if !link && (a.Password != "" || a.Role != RoleStudent) {
+			return nil, "", ErrNotLinked
+		}
+		state := a.State
+		if state == StateInvited {
+			state = StateActive
+		}
+		if err := s.repository.LinkExternal(ctx, a.ID, state, now); err != nil {
+			return nil, "", err
+		}
+		if a, err = s.repository.GetAccountByID(ctx, a.ID); err != nil {
+			return nil, "", err
+		}
+		slog.InfoContext(ctx, "Linked account to single sign-on", "account", a.ID)
+	}
+	if a.State != StateActive {
+		return nil, "", ErrBadCredentials
+	}
+	return a, s.secondFactor(a), nil
+}
+
+// secondFactor is the step a login of a still needs after the first.
+func (s *accountService) secondFactor(a *Account) string {
+	switch {
+	case a.TOTPEnabledAt != nil:
+		return SecondFactorTOTP
+	case slices.Contains(s.totp.RequiredRoles, a.Role):
+		return SecondFactorEnrol
+	}
+	return SecondFactorNone
+}
+
+// dummyPasswordHash matches no password, it is only there to be checked.
+var dummyPasswordHash = passwordScheme + "$600000$AAAAAAAAAAAAAAAAAAAAAA$AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"
+
+// SendVerification mails the account a new link to verify its email, which
+// uses up the links sent before.
+func (s *accountService) SendVerification(ctx context.Context, id string) error {
+	a, err := s.repository.GetAccountByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	switch {
+	case a.AnonymisedAt != nil:
+		return ErrAnonymised
+	case a.Role == RoleService:
+		return ErrServiceAccount
+	case a.EmailVerifiedAt != nil:
+		return ErrEmailVerified
+	}
+	return s.mailToken(ctx, a, purposeVerify)
+}
+
+// VerifyEmail uses up a verification token. For an invited account it
+// accepts the invitation: the account becomes active, with password as its
+// password. Other accounts may leave password out.
+func (s *accountService) VerifyEmail(ctx context.Context, token string, password *string) (*Account, error) {
+	t, err := s.repository.GetToken(ctx, hashToken(token), purposeVerify, time.Now())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrInvalidToken
+	}
+	if err != nil {
+		return nil, err
+	}
+	a, err := s.repository.GetAccountByID(ctx, t.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	state, hash := a.State, ""
+	if a.State == StateInvited {
+		state = StateActive
+		if password == nil && a.Password == "" {
+			return nil, ErrPasswordRequired
+		}
+	}
+	if password != nil {
+		if len(*password) < minPasswordLength {
+			return nil, ErrWeakPassword
+		}
+		if hash, err = hashPassword(*password); err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.repository.VerifyEmail(ctx, t.Hash, a.ID, state, hash, time.Now())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrInvalidToken
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.repository.GetAccountByID(ctx, a.ID)
+}
+
+// RequestPasswordReset mails a reset link to the account with email, if
+// there is one that may log in or is still invited. It never says whether
+// there is, so it can't be used to find out which emails have an account.
+func (s *accountService) RequestPasswordReset(ctx context.Context, email string) error {
+	a, err := s.repository.GetAccountByEmail(ctx, normaliseEmail(email))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if a.State != StateActive && a.State != StateInvited {
+		slog.InfoContext(ctx, "Password reset for a closed account", "account", a.ID, "state", a.State)
+		return nil
+	}
+	return s.mailToken(ctx, a, purposeReset)
+}
+
+// ResetPassword uses up a reset token to set a new password.
+func (s *accountService) ResetPassword(ctx context.Context, token, password string) error {
+	if len(password) < minPasswordLength {
+		return ErrWeakPassword
+	}
+	t, err := s.repository.GetToken(ctx, hashToken(token), purposeReset, time.Now())
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrInvalidToken
+	}
+	if err != nil {
+		return err
+	}
+
+	hash, err := hashPassword(password)
+	if err != nil {
+		return err
+	}
+	err = s.repository.ResetPassword(ctx, t.Hash, t.AccountID, hash, time.Now())
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrInvalidToken
+	}
+	return err
+}
+
+// BeginTOTP starts setting up two-factor authentication with a new secret,
+// replacing one that was never confirmed. It is off until EnableTOTP.
+func (s *accountService) BeginTOTP(ctx context.Context, id string) (*TOTPSetup, error) {
+	a, err := s.repository.GetAccountByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case a.AnonymisedAt != nil:
+		return nil, ErrAnonymised
+	case a.Role == RoleService:
+		return nil, ErrServiceAccount
+	case a.TOTPEnabledAt != nil:
+		return nil, ErrTOTPEnabled
+	}
+
+	secret, err := newTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+	err = s.repository.SetTOTPSecret(ctx, id, secret, time.Now())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrTOTPEnabled
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &TOTPSetup{Secret: secret, URI: totpURI(s.totp.Issuer, a.Email, secret)}, nil
+}
+
+// EnableTOTP turns two-factor authentication on once code shows the
+// authenticator app has the secret. It returns the recovery codes, which
+// are only kept as hashes from then on.
+func (s *accountService) EnableTOTP(ctx context.Context, id, code string) ([]string, error) {
+	a, err := s.repository.GetAccountByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case a.TOTPEnabledAt != nil:
+		return nil, ErrTOTPEnabled
+	case a.TOTPSecret == "":
+		return nil, ErrTOTPNotStarted
+	}
+
+	now := time.Now()
+	step, ok := checkTOTP(a.TOTPSecret, code, now, a.TOTPLastStep)
+	if !ok {
+		return nil, ErrBadCode
+	}
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	err = s.repository.EnableTOTP(ctx, id, step, hashes, now)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrTOTPEnabled
+	}
+	if err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// VerifyTOTP checks the second step of a login: a code from the
+// authenticator app, or one of the recovery codes. Either works only once.
+func (s *accountService) VerifyTOTP(ctx context.Context, id, code string) (*Account, error) {
+	a, err := s.repository.GetAccountByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.useCode(ctx, a, code); err != nil {
+		return nil, err
+	}
+	return s.repository.GetAccountByID(ctx, id)
+}
+
+// DisableTOTP turns two-factor authentication off for an account that can
+// prove it has it, unless its role requires it.
+func (s *accountService) DisableTOTP(ctx context.Context, id, code string) (*Account, error) {
+	a, err := s.repository.GetAccountByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if slices.Contains(s.totp.RequiredRoles, a.Role) {
+		return nil, ErrTOTPRequired
+	}
+	if err := s.useCode(ctx, a, code); err != nil {
+		return nil, err
+	}
+	return s.ResetTOTP(ctx, id)
+}
+
+// ResetTOTP turns two-factor authentication off without a code, for when
+// the authenticator app and recovery codes are lost. An account whose role
+// requires it sets it up again at its next login.
+func (s *accountService) ResetTOTP(ctx context.Context, id string) (*Account, error) {
+	if err := s.repository.ClearTOTP(ctx, id, time.Now()); err != nil {
+		return nil, err
+	}
+	return s.repository.GetAccountByID(ctx, id)
+}
+
+// ResetCalendarFeeds ends the calendar feed URLs handed out to an account,
+// for when one leaked. The account gets new ones.
+func (s *accountService) ResetCalendarFeeds(ctx context.Context, id string) (*Account, error) {
+	if err := s.repository.BumpCalendarVersion(ctx, id, time.Now()); err != nil {
+		return nil, err
+	}
+	return s.repository.GetAccountByID(ctx, id)
+}
+
+func (s *accountService) useCode(ctx context.Context, a *Account, code string) error {
+	if a.TOTPEnabledAt == nil {
+		return ErrTOTPOff
+	}
+
+	var err error
+	if step, ok := checkTOTP(a.TOTPSecret, strings.TrimSpace(code), time.Now(), a.TOTPLastStep); ok {
+		err = s.repository.UseTOTPStep(ctx, a.ID, step)
+	} else {
+		err = s.repository.UseRecoveryCode(ctx, a.ID, hashToken(normaliseRecoveryCode(code)))
+		if err == nil {
+			slog.InfoContext(ctx, "Used a recovery code", "account", a.ID, "left", a.RecoveryCodesLeft-1)
+		}
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrBadCode
+	}
+	return err
+}
+
+// CreateAPIKey issues a key for a service account. The key is only returned
+// here, the service keeps a hash of it.
+func (s *accountService) CreateAPIKey(ctx context.Context, accountID, name string, scopes []string) (*APIKey, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, "", ErrInvalidAPIKey
+	}
+	scopes, err := normaliseScopes(scopes)
+	if err != nil {
+		return nil, "", err
+	}
+	a, err := s.repository.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return nil, "", err
+	}
+	switch {
+	case a.Role != RoleService:
+		return nil, "", ErrNotAService
+	case a.AnonymisedAt != nil:
+		return nil, "", ErrAnonymised
+	}
+
+	key, prefix, err := newAPIKey()
+	if err != nil {
+		return nil, "", err
+	}
+	k := &APIKey{
+		ID:        ksuid.New().String(),
+		AccountID: a.ID,
+		Name:      name,
+		Prefix:    prefix,
+		Hash:      hashToken(key),
+		Scopes:    scopes,
+		CreatedAt: time.Now(),
+	}
+	if err := s.repository.PutAPIKey(ctx, k); err != nil {
+		return nil, "", err
+	}
+	slog.InfoContext(ctx, "Created API key", "account", a.ID, "key", k.ID, "scopes", scopes)
+	return k, key, nil
+}
+
+// GetAPIKeys lists the keys of an account, revoked ones too, newest first.
+func (s *accountService) GetAPIKeys(ctx context.Context, accountID string) ([]*APIKey, error) {
+	return s.repository.ListAPIKeys(ctx, accountID)
+}
+
+// RevokeAPIKey stops a key from working for good. Revoking it again changes
+// nothing.
+func (s *accountService) RevokeAPIKey(ctx context.Context, id string) (*APIKey, error) {
+	err := s.repository.RevokeAPIKey(ctx, id, time.Now())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrAPIKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.repository.GetAPIKey(ctx, id)
+}
+
+// AuthenticateAPIKey returns the account of a key that isn't revoked, as
+// long as the account is active.
+func (s *accountService) AuthenticateAPIKey(ctx context.Context, key string) (*Account, *APIKey, error) {
+	if !strings.HasPrefix(key, APIKeyPrefix) {
+		return nil, nil, ErrBadAPIKey
+	}
+	k, err := s.repository.GetAPIKeyByHash(ctx, hashToken(key))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, ErrBadAPIKey
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if k.RevokedAt != nil {
+		return nil, nil, ErrBadAPIKey
+	}
+	a, err := s.repository.GetAccountByID(ctx, k.AccountID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if a.State != StateActive {
+		return nil, nil, ErrBadAPIKey
+	}
+
+	now := time.Now()
+	if k.LastUsedAt == nil || now.Sub(*k.LastUsedAt) >= apiKeyTouchInterval {
+		if err := s.repository.TouchAPIKey(ctx, k.ID, now); err != nil {
+			slog.ErrorContext(ctx, "Recording API key use", "key", k.ID, "err", err)
+		} else {
+			k.LastUsedAt = &now
+		}
+	}
+	return a, k, nil
 }
@@ -0,0 +1,117 @@
+package account
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// TOTP codes follow RFC 6238 with the defaults authenticator apps assume:
+// HMAC-SHA1, 6 digits and a 30 second step. A code is accepted one step
+// early or late to allow for clock drift, and only once.
+const (
+	totpDigits        = 6
+	totpPeriod        = 30
+	totpSkew          = 1
+	totpSecretLength  = 20
+	recoveryCodeCount = 10
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// TOTPPolicy configures two-factor authentication. Accounts with one of
+// RequiredRoles have to set it up before they can log in fully.
+type TOTPPolicy struct {
+	Issuer        string
+	RequiredRoles []string
+}
+
+// Second login steps Authenticate can ask for.
+const (
+	SecondFactorNone  = ""
+	SecondFactorTOTP  = "TOTP"
+	SecondFactorEnrol = "ENROL"
+)
+
+// TOTPSetup is a secret waiting to be confirmed with a code, and the
+// otpauth:// URI authenticator apps read it from, usually as a QR code.
+type TOTPSetup struct {
+	Secret string
+	URI    string
+}
+
+func newTOTPSecret() (string, error) {
+	b := make([]byte, totpSecretLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return totpEncoding.EncodeToString(b), nil
+}
+
+func totpURI(issuer, email, secret string) string {
+	label := url.PathEscape(issuer + ":" + email)
+	q := url.Values{
+		"secret":    {secret},
+		"issuer":    {issuer},
+		"algorithm": {"SHA1"},
+		"digits":    {fmt.Sprint(totpDigits)},
+		"period":    {fmt.Sprint(totpPeriod)},
+	}
+	return "otpauth://totp/" + label + "?" + q.Encode()
+}
+
+func totpCode(key []byte, step int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	n := binary.BigEndian.Uint32(sum[offset:]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", totpDigits, n%1_000_000)
+}
+
+// checkTOTP returns the step code belongs to, if it is valid around now and
+// from a later step than after.
+func checkTOTP(secret, code string, now time.Time, after int64) (int64, bool) {
+	key, err := totpEncoding.DecodeString(secret)
+	if err != nil || len(code) != totpDigits {
+		return 0, false
+	}
+	current := now.Unix() / totpPeriod
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		if step > after && hmac.Equal([]byte(totpCode(key, step)), []byte(code)) {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// newRecoveryCodes returns codes to show once and the hashes to keep.
+func newRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		b := make([]byte, 6)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+		c := strings.ToLower(totpEncoding.EncodeToString(b))[:10]
+		codes[i] = c[:5] + "-" + c[5:]
+		hashes[i] = hashToken(c)
+	}
+	return codes, hashes, nil
+}
+
+// normaliseRecoveryCode accepts a code the way people type it back.
+func normaliseRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
@@ -0,0 +1,180 @@
+package account
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfcKey is the SHA-1 seed of RFC 6238 Appendix B.
+var rfcKey = []byte("12345678901234567890")
+
+func TestTOTPCodeRFC6238(t *testing.T) {
+	// The RFC lists 8 digit codes, ours are the last 6 digits of those.
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "94287082"},
+		{1111111109, "07081804"},
+		{1111111111, "14050471"},
+		{1234567890, "89005924"},
+		{2000000000, "69279037"},
+		{20000000000, "65353130"},
+	}
+	secret := totpEncoding.EncodeToString(rfcKey)
+	for _, tt := range tests {
+		want := tt.want[len(tt.want)-totpDigits:]
+		if got := totpCode(rfcKey, tt.unix/totpPeriod); got != want {
+			t.Errorf("totpCode at %d = %s, want %s", tt.unix, got, want)
+		}
+
+		if step, ok := checkTOTP(secret, want, time.Unix(tt.unix, 0), 0); !ok || step != tt.unix/totpPeriod {
+			t.Errorf("checkTOTP at %d = %d, %v, want %d, true", tt.unix, step, ok, tt.unix/totpPeriod)
+		}
+	}
+}
+
+func TestCheckTOTP(t *testing.T) {
+	secret := totpEncoding.EncodeToString(rfcKey)
+	now := time.Unix(1234567890, 0)
+	current := now.Unix() / totpPeriod
+	tests := []struct {
+		name  string
+		code  string
+		after int64
+		want  bool
+	}{
+		{"current step", totpCode(rfcKey, current), 0, true},
+		{"one step early", totpCode(rfcKey, current-1), 0, true},
+		{"one step late", totpCode(rfcKey, current+1), 0, true},
+		{"two steps early", totpCode(rfcKey, current-2), 0, false},
+		{"two steps late", totpCode(rfcKey, current+2), 0, false},
+		{"replayed", totpCode(rfcKey, current), current, false},
+		{"before a later code", totpCode(rfcKey, current-1), current, false},
+		{"after an earlier code", totpCode(rfcKey, current), current - 1, true},
+		{"too short", totpCode(rfcKey, current)[1:], 0, false},
+		{"wrong", "000000", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, ok := checkTOTP(secret, tt.code, now, tt.after); ok != tt.want {
+				t.Errorf("checkTOTP(%s, after %d) = %v, want %v", tt.code, tt.after, ok, tt.want)
+			}
+		})
+	}
+
+	if _, ok := checkTOTP("not base32!", totpCode(rfcKey, current), now, 0); ok {
+		t.Error("checkTOTP with a broken secret = true, want false")
+	}
+}
+
+func TestVerifyTOTPReplay(t *testing.T) {
+	ctx := context.Background()
+	s, r := newTOTPService(t)
+	key, _ := totpEncoding.DecodeString(r.account.TOTPSecret)
+
+	code := totpCode(key, time.Now().Unix()/totpPeriod+1)
+	if _, err := s.VerifyTOTP(ctx, r.account.ID, code); err != nil {
+		t.Fatalf("VerifyTOTP = %v, want nil", err)
+	}
+	if _, err := s.VerifyTOTP(ctx, r.account.ID, code); !errors.Is(err, ErrBadCode) {
+		t.Errorf("VerifyTOTP with the same code = %v, want ErrBadCode", err)
+	}
+	// An earlier code that is still in the window is used up as well.
+	if _, err := s.VerifyTOTP(ctx, r.account.ID, totpCode(key, time.Now().Unix()/totpPeriod)); !errors.Is(err, ErrBadCode) {
+		t.Errorf("VerifyTOTP with an earlier code = %v, want ErrBadCode", err)
+	}
+}
+
+func TestVerifyTOTPRecoveryCode(t *testing.T) {
+	ctx := context.Background()
+	s, r := newTOTPService(t)
+
+	// Codes are accepted the way people type them back.
+	typed := " " + strings.ToUpper(strings.ReplaceAll(r.codes[0], "-", " ")) + " "
+	a, err := s.VerifyTOTP(ctx, r.account.ID, typed)
+	if err != nil {
+		t.Fatalf("VerifyTOTP with a recovery code = %v, want nil", err)
+	}
+	if a.RecoveryCodesLeft != recoveryCodeCount-1 {
+		t.Errorf("RecoveryCodesLeft = %d, want %d", a.RecoveryCodesLeft, recoveryCodeCount-1)
+	}
+	if _, err := s.VerifyTOTP(ctx, r.account.ID, r.codes[0]); !errors.Is(err, ErrBadCode) {
+		t.Errorf("VerifyTOTP with a used recovery code = %v, want ErrBadCode", err)
+	}
+	if _, err := s.VerifyTOTP(ctx, r.account.ID, r.codes[1]); err != nil {
+		t.Errorf("VerifyTOTP with another recovery code = %v, want nil", err)
+	}
+}
+
+// newTOTPService returns a service with an account that has just turned on
+// two-factor authentication.
+func newTOTPService(t *testing.T) (*accountService, *totpRepository) {
+	t.Helper()
+	secret, err := newTOTPSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &totpRepository{account: &Account{ID: "account", Role: RoleStudent, State: StateActive, TOTPSecret: secret}}
+	s := &accountService{repository: r}
+
+	key, _ := totpEncoding.DecodeString(secret)
+	if r.codes, err = s.EnableTOTP(context.Background(), r.account.ID, totpCode(key, time.Now().Unix()/totpPeriod-1)); err != nil {
+		t.Fatalf("EnableTOTP = %v, want nil", err)
+	}
+	if len(r.codes) != recoveryCodeCount {
+		t.Fatalf("EnableTOTP returned %d recovery codes, want %d", len(r.codes), recoveryCodeCount)
+	}
+	return s, r
+}
+
+// totpRepository keeps a single account in memory, doing what the
+// postgres repository does for the two-factor methods.
+type totpRepository struct {
+	Repository
+	account *Account
+	hashes  []string
+	codes   []string // the recovery codes EnableTOTP returned
+}
+
+func (r *totpRepository) GetAccountByID(ctx context.Context, id string) (*Account, error) {
+	if id != r.account.ID {
+		return nil, sql.ErrNoRows
+	}
+	a := *r.account
+	return &a, nil
+}
+
+func (r *totpRepository) EnableTOTP(ctx context.Context, id string, step int64, recoveryCodes []string, at time.Time) error {
+	if id != r.account.ID || r.account.TOTPEnabledAt != nil || r.account.TOTPSecret == "" {
+		return sql.ErrNoRows
+	}
+	r.account.TOTPEnabledAt = &at
+	r.account.TOTPLastStep = step
+	r.account.RecoveryCodesLeft = len(recoveryCodes)
+	r.hashes = slices.Clone(recoveryCodes)
+	return nil
+}
+
+func (r *totpRepository) UseTOTPStep(ctx context.Context, id string, step int64) error {
+	if id != r.account.ID || r.account.TOTPEnabledAt == nil || r.account.TOTPLastStep >= step {
+		return sql.ErrNoRows
+	}
+	r.account.TOTPLastStep = step
+	return nil
+}
+
+func (r *totpRepository) UseRecoveryCode(ctx context.Context, id, hash string) error {
+	i := slices.Index(r.hashes, hash)
+	if id != r.account.ID || r.account.TOTPEnabledAt == nil || i < 0 {
+		return sql.ErrNoRows
+	}
+	r.hashes = slices.Delete(r.hashes, i, i+1)
+	r.account.RecoveryCodesLeft = len(r.hashes)
+	return nil
+}
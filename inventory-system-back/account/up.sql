@@ -0,0 +1,77 @@
+CREATE TABLE IF NOT EXISTS accounts (
+    id CHAR(27) PRIMARY KEY,
+    first_name VARCHAR(100) NOT NULL,
+    insertion VARCHAR(20) NOT NULL DEFAULT '',
+    last_name VARCHAR(100) NOT NULL,
+    email VARCHAR(254) NOT NULL,
+    password VARCHAR(255) NOT NULL DEFAULT '',
+    card_number VARCHAR(50) NOT NULL DEFAULT '',
+    role VARCHAR(20) NOT NULL,
+    state VARCHAR(20) NOT NULL,
+    -- education.Class the account belongs to, checked by the gateway.
+    class_id CHAR(27),
+    -- nl or en, the language of the emails the account gets.
+    language VARCHAR(5) NOT NULL DEFAULT 'nl',
+    email_verified_at TIMESTAMP WITH TIME ZONE,
+    -- Two-factor authentication. The secret is set while it is being set up,
+    -- it is on from totp_enabled_at. Recovery codes are SHA-256 hashes.
+    totp_secret VARCHAR(64) NOT NULL DEFAULT '',
+    totp_enabled_at TIMESTAMP WITH TIME ZONE,
+    totp_last_step BIGINT NOT NULL DEFAULT 0,
+    totp_recovery_codes TEXT[] NOT NULL DEFAULT '{}',
+    anonymised_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+-- Anonymising blanks the email and card number, so only the ones in use
+-- have to be unique.
+CREATE UNIQUE INDEX IF NOT EXISTS accounts_email_key ON accounts (email) WHERE email <> '';
+CREATE UNIQUE INDEX IF NOT EXISTS accounts_card_number_key ON accounts (card_number) WHERE card_number <> '';
+CREATE INDEX IF NOT EXISTS accounts_class_id_idx ON accounts (class_id);
+
+-- Goes up whenever the password changes, the gateway turns away sessions
+-- signed for an earlier version.
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS session_version INTEGER NOT NULL DEFAULT 0;
+
+-- Goes up when an account resets its calendar feeds, the gateway turns away
+-- feed URLs signed for an earlier version.
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS calendar_version INTEGER NOT NULL DEFAULT 0;
+
+-- Set once a single sign-on login created or took over the account, later
+-- logins through the provider need no permission to link to it. Verified
+-- accounts without a password can only have come from single sign-on.
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS external_linked_at TIMESTAMP WITH TIME ZONE;
+UPDATE accounts SET external_linked_at = email_verified_at
+WHERE external_linked_at IS NULL AND password = '' AND email_verified_at IS NOT NULL AND email <> '';
+
+-- Single-use tokens mailed to an account, for resetting its password or
+-- verifying its email. Only a SHA-256 hash of the token is kept. Issuing a
+-- new one uses up the earlier ones for the same purpose.
+CREATE TABLE IF NOT EXISTS account_tokens (
+    hash CHAR(64) PRIMARY KEY,
+    account_id CHAR(27) NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+    purpose VARCHAR(20) NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    used_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS account_tokens_account_id_idx ON account_tokens (account_id, purpose);
+
+-- API keys of SERVICE accounts. Only a SHA-256 hash of the key is kept, its
+-- prefix (isk_ and 8 hex characters) is kept as is to tell keys apart by.
+-- Scopes are <domain>:read or <domain>:write.
+CREATE TABLE IF NOT EXISTS api_keys (
+    id CHAR(27) PRIMARY KEY,
+    account_id CHAR(27) NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    prefix VARCHAR(20) NOT NULL,
+    hash CHAR(64) NOT NULL UNIQUE,
+    scopes TEXT[] NOT NULL,
+    last_used_at TIMESTAMP WITH TIME ZONE,
+    revoked_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS api_keys_account_id_idx ON api_keys (account_id);
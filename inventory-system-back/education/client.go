@@ -3,47 +3,63 @@ package education
 import (
 	"context"
 	"github.com/jochem11/inventory-system-back/education/pb"
+	"github.com/jochem11/inventory-system-back/internal/logging"
+	"github.com/jochem11/inventory-system-back/internal/metrics"
+	"github.com/jochem11/inventory-system-back/internal/resilience"
+	"github.com/jochem11/inventory-system-back/internal/serve"
+	"github.com/jochem11/inventory-system-back/internal/tracing"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"io"
-	"log"
+	"log/slog"
+	"time"
 )
 
 type Client struct {
 	conn    *grpc.ClientConn
 	service pb.EducationServiceClient
+	policy  resilience.Config
 }
 
-func NewClient(url string) (*Client, error) {
-	conn, err := grpc.Dial(url, grpc.WithInsecure())
+// NewClient connects lazily, so the service doesn't have to be up yet. Calls
+// wait for it to come up within their deadline, read calls are retried and
+// policy's circuit breaker fails them fast while it stays down.
+func NewClient(url string, creds credentials.TransportCredentials, policy resilience.Config) (*Client, error) {
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		tracing.DialOption(),
+		grpc.WithChainUnaryInterceptor(logging.UnaryClientInterceptor(), metrics.GRPCClient.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(logging.StreamClientInterceptor(), metrics.GRPCClient.StreamClientInterceptor()),
+	}
+	conn, err := grpc.NewClient(url, append(opts, policy.DialOptions(pb.EducationService_ServiceDesc)...)...)
 	if err != nil {
 		return nil, err
 	}
 	c := pb.NewEducationServiceClient(conn)
-	return &Client{conn, c}, nil
+	return &Client{conn, c, policy}, nil
 }
 
 func (c *Client) Close() {
 	c.conn.Close()
 }
 
-func (c *Client) PostCourse(ctx context.Context, name string) (*Course, error) {
+// Ready reports whether the service and its database are serving.
+func (c *Client) Ready(ctx context.Context) error {
+	return serve.CheckHealth(ctx, c.conn)
+}
+
+func (c *Client) PostCourse(ctx context.Context, name string, yearID *string) (*Course, error) {
 	r, err := c.service.PostCourse(ctx, &pb.PostCourseRequest{
-		Name: name,
+		Name:   name,
+		YearId: yearID,
 	})
-
 	if err != nil {
 		return nil, err
 	}
-
-	updatedAt := r.Course.UpdatedAt.AsTime()
-	createdAt := r.Course.CreatedAt.AsTime()
-
-	return &Course{
-		ID:        r.Course.Id,
-		Name:      r.Course.Name,
-		UpdatedAt: updatedAt,
-		CreatedAt: createdAt,
-	}, nil
+	return courseFromProto(r.Course), nil
 }
 
 func (c *Client) GetCourse(ctx context.Context, id string) (*Course, error) {
@@ -51,36 +67,42 @@ func (c *Client) GetCourse(ctx context.Context, id string) (*Course, error) {
 	if err != nil {
 		return nil, err
 	}
+	return courseFromProto(r.Course), nil
+}
 
-	updatedAt := r.Course.UpdatedAt.AsTime()
-	createdAt := r.Course.CreatedAt.AsTime()
-
-	return &Course{
-		ID:        r.Course.Id,
-		Name:      r.Course.Name,
-		UpdatedAt: updatedAt,
-		CreatedAt: createdAt,
-	}, nil
+func (c *Client) GetCourseByName(ctx context.Context, name string) (*Course, error) {
+	r, err := c.service.GetCourseByName(ctx, &pb.GetCourseByNameRequest{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	return courseFromProto(r.Course), nil
 }
 
-func (c *Client) GetCourses(ctx context.Context, skip, take uint64) ([]*Course, error) {
-	r, err := c.service.GetCourses(ctx, &pb.GetCoursesRequest{Skip: skip, Take: take})
+// GetCourses lists the courses of yearID, the service picks the current year
+// when it is nil.
+func (c *Client) GetCourses(ctx context.Context, skip, take uint64, yearID *string) ([]*Course, error) {
+	r, err := c.service.GetCourses(ctx, &pb.GetCoursesRequest{Skip: skip, Take: take, YearId: yearID})
 	if err != nil {
 		return nil, err
 	}
 
 	courses := []*Course{}
 	for _, course := range r.Courses {
-		updatedAt := course.UpdatedAt.AsTime()
-		createdAt := course.CreatedAt.AsTime()
-		courses = append(courses, &Course{
-			ID:        course.Id,
-			Name:      course.Name,
-			UpdatedAt: updatedAt,
-			CreatedAt: createdAt,
-		})
+		courses = append(courses, courseFromProto(course))
+	}
+	return courses, nil
+}
+
+func (c *Client) GetCoursesByIDs(ctx context.Context, ids []string) ([]*Course, error) {
+	r, err := c.service.GetCoursesByIDs(ctx, &pb.GetCoursesByIDsRequest{Ids: ids})
+	if err != nil {
+		return nil, err
 	}
 
+	courses := []*Course{}
+	for _, course := range r.Courses {
+		courses = append(courses, courseFromProto(course))
+	}
 	return courses, nil
 }
 
@@ -89,15 +111,7 @@ func (c *Client) UpdateCourse(ctx context.Context, id string, name *string) (*Co
 	if err != nil {
 		return nil, err
 	}
-	updatedAt := r.Course.UpdatedAt.AsTime()
-	createdAt := r.Course.CreatedAt.AsTime()
-
-	return &Course{
-		ID:        r.Course.Id,
-		Name:      r.Course.Name,
-		UpdatedAt: updatedAt,
-		CreatedAt: createdAt,
-	}, nil
+	return courseFromProto(r.Course), nil
 }
 
 func (c *Client) DeleteCourse(ctx context.Context, id string) error {
@@ -105,11 +119,15 @@ func (c *Client) DeleteCourse(ctx context.Context, id string) error {
 	return err
 }
 
+// LiveCourses streams pages of courses until ctx is cancelled. When the
+// stream breaks because the service went away it subscribes again with
+// backoff, the channel only closes on ctx or an error a retry won't fix.
 func (c *Client) LiveCourses(ctx context.Context, skip, take uint64) (<-chan []*Course, error) {
-	stream, err := c.service.LiveCourses(ctx, &pb.GetCoursesRequest{
+	req := &pb.GetCoursesRequest{
 		Skip: skip,
 		Take: take,
-	})
+	}
+	stream, err := c.service.LiveCourses(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -117,31 +135,38 @@ func (c *Client) LiveCourses(ctx context.Context, skip, take uint64) (<-chan []*
 	ch := make(chan []*Course)
 	go func() {
 		defer close(ch)
+		failures := 0
 		for {
-			resp, err := stream.Recv()
-			if err == io.EOF {
+			err := receiveCourses(ctx, stream, ch, &failures)
+			if ctx.Err() != nil {
 				return
 			}
-			if err != nil {
-				// Log the error but don't send it through the channel
-				log.Printf("Error receiving stream: %v", err)
+			if !resubscribable(err) {
+				slog.ErrorContext(ctx, "Error receiving stream", "err", err)
 				return
 			}
 
-			courses := make([]*Course, 0, len(resp.Courses))
-			for _, c := range resp.Courses {
-				courses = append(courses, &Course{
-					ID:        c.Id,
-					Name:      c.Name,
-					UpdatedAt: c.UpdatedAt.AsTime(),
-					CreatedAt: c.CreatedAt.AsTime(),
-				})
-			}
-
-			select {
-			case ch <- courses:
-			case <-ctx.Done():
-				return
+			for {
+				wait := c.policy.Backoff(failures)
+				failures++
+				slog.WarnContext(ctx, "Stream broke, resubscribing", "err", err, "wait", wait.String())
+				select {
+				case <-time.After(wait):
+				case <-ctx.Done():
+					return
+				}
+
+				stream, err = c.service.LiveCourses(ctx, req)
+				if err == nil {
+					break
+				}
+				if ctx.Err() != nil {
+					return
+				}
+				if !resubscribable(err) {
+					slog.ErrorContext(ctx, "Error resubscribing", "err", err)
+					return
+				}
 			}
 		}
 	}()
@@ -149,31 +174,48 @@ func (c *Client) LiveCourses(ctx context.Context, skip, take uint64) (<-chan []*
 	return ch, nil
 }
 
-func (c *Client) PostClass(ctx context.Context, courseId, name string) (*Class, error) {
-	r, err := c.service.PostClass(ctx, &pb.PostClassRequest{CourseId: courseId, Name: name})
-	if err != nil {
-		return nil, err
-	}
+// receiveCourses forwards pages until the stream ends and returns why. A page
+// getting through resets the failure count.
+func receiveCourses(ctx context.Context, stream pb.EducationService_LiveCoursesClient, ch chan<- []*Course, failures *int) error {
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		*failures = 0
 
-	updatedAt := r.Class.UpdatedAt.AsTime()
-	createdAt := r.Class.CreatedAt.AsTime()
+		courses := make([]*Course, 0, len(resp.Courses))
+		for _, c := range resp.Courses {
+			courses = append(courses, courseFromProto(c))
+		}
 
-	courseUpdatedAt := r.Class.Course.UpdatedAt.AsTime()
-	courseCreatedAt := r.Class.Course.CreatedAt.AsTime()
+		select {
+		case ch <- courses:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
 
-	return &Class{
-		ID:       r.Class.Id,
-		Name:     r.Class.Name,
-		CourseID: r.Class.CourseId,
-		Course: &Course{
-			ID:        r.Class.Course.Id,
-			Name:      r.Class.Course.Name,
-			UpdatedAt: courseUpdatedAt,
-			CreatedAt: courseCreatedAt,
-		},
-		UpdatedAt: updatedAt,
-		CreatedAt: createdAt,
-	}, nil
+// resubscribable reports whether a broken stream is worth opening again. The
+// server ending it cleanly counts, it does so when shutting down.
+func resubscribable(err error) bool {
+	if err == io.EOF {
+		return true
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.Aborted, codes.Internal, codes.Unknown, codes.DeadlineExceeded:
+		return true
+	}
+	return false
+}
+
+func (c *Client) PostClass(ctx context.Context, courseId, name string, termID *string) (*Class, error) {
+	r, err := c.service.PostClass(ctx, &pb.PostClassRequest{CourseId: courseId, Name: name, TermId: termID})
+	if err != nil {
+		return nil, err
+	}
+	return classFromProto(r.Class), nil
 }
 
 func (c *Client) GetClass(ctx context.Context, id string) (*Class, error) {
@@ -181,86 +223,417 @@ func (c *Client) GetClass(ctx context.Context, id string) (*Class, error) {
 	if err != nil {
 		return nil, err
 	}
+	return classFromProto(r.Class), nil
+}
 
-	updatedAt := r.Class.UpdatedAt.AsTime()
-	createdAt := r.Class.CreatedAt.AsTime()
-
-	courseUpdatedAt := r.Class.Course.UpdatedAt.AsTime()
-	courseCreatedAt := r.Class.Course.CreatedAt.AsTime()
+func (c *Client) GetClassByName(ctx context.Context, name string) (*Class, error) {
+	r, err := c.service.GetClassByName(ctx, &pb.GetClassByNameRequest{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	return classFromProto(r.Class), nil
+}
 
-	return &Class{
-		ID:       r.Class.Id,
-		Name:     r.Class.Name,
-		CourseID: r.Class.CourseId,
-		Course: &Course{
-			ID:        r.Class.Course.Id,
-			Name:      r.Class.Course.Name,
-			UpdatedAt: courseUpdatedAt,
-			CreatedAt: courseCreatedAt,
-		},
-		UpdatedAt: updatedAt,
-		CreatedAt: createdAt,
-	}, nil
-}
-
-func (c *Client) GetClasses(ctx context.Context, skip, take uint64) ([]*Class, error) {
-	r, err := c.service.GetClasses(ctx, &pb.GetClassesRequest{Skip: skip, Take: take})
+// GetClasses lists the classes of termID, the service picks the current term
+// when it is nil.
+func (c *Client) GetClasses(ctx context.Context, skip, take uint64, termID *string) ([]*Class, error) {
+	r, err := c.service.GetClasses(ctx, &pb.GetClassesRequest{Skip: skip, Take: take, TermId: termID})
 	if err != nil {
 		return nil, err
 	}
 
 	classes := []*Class{}
 	for _, class := range r.Classes {
-		updatedAt := class.UpdatedAt.AsTime()
-		createdAt := class.CreatedAt.AsTime()
-
-		courseUpdatedAt := class.Course.UpdatedAt.AsTime()
-		courseCreatedAt := class.Course.CreatedAt.AsTime()
-		classes = append(classes, &Class{
-			ID:       class.Id,
-			Name:     class.Name,
-			CourseID: class.CourseId,
-			Course: &Course{
-				ID:        class.Course.Id,
-				Name:      class.Course.Name,
-				UpdatedAt: courseUpdatedAt,
-				CreatedAt: courseCreatedAt,
-			},
-			UpdatedAt: updatedAt,
-			CreatedAt: createdAt,
-		})
+		classes = append(classes, classFromProto(class))
 	}
 	return classes, nil
 }
 
-func (c *Client) UpdateClass(ctx context.Context, id string, name, courseId *string) (*Class, error) {
-	r, err := c.service.UpdateClass(ctx, &pb.UpdateClassRequest{Id: id, Name: name, CourseId: courseId})
+func (c *Client) GetClassesByIDs(ctx context.Context, ids []string) ([]*Class, error) {
+	r, err := c.service.GetClassesByIDs(ctx, &pb.GetClassesByIDsRequest{Ids: ids})
 	if err != nil {
 		return nil, err
 	}
 
-	updatedAt := r.Class.UpdatedAt.AsTime()
-	createdAt := r.Class.CreatedAt.AsTime()
-
-	courseUpdatedAt := r.Class.Course.UpdatedAt.AsTime()
-	courseCreatedAt := r.Class.Course.CreatedAt.AsTime()
+	classes := []*Class{}
+	for _, class := range r.Classes {
+		classes = append(classes, classFromProto(class))
+	}
+	return classes, nil
+}
 
-	return &Class{
-		ID:       r.Class.Id,
-		Name:     r.Class.Name,
-		CourseID: r.Class.CourseId,
-		Course: &Course{
-			ID:        r.Class.Course.Id,
-			Name:      r.Class.Course.Name,
-			UpdatedAt: courseUpdatedAt,
-			CreatedAt: courseCreatedAt,
-		},
-		CreatedAt: createdAt,
-		UpdatedAt: updatedAt,
-	}, nil
+func (c *Client) UpdateClass(ctx context.Context, id string, name, courseId, termID *string, studentCount *int) (*Class, error) {
+	req := &pb.UpdateClassRequest{Id: id, Name: name, CourseId: courseId, TermId: termID}
+	if studentCount != nil {
+		n := uint32(*studentCount)
+		req.StudentCount = &n
+	}
+	r, err := c.service.UpdateClass(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return classFromProto(r.Class), nil
 }
 
 func (c *Client) DeleteClass(ctx context.Context, id string) error {
 	_, err := c.service.DeleteClass(ctx, &pb.DeleteClassRequest{Id: id})
 	return err
 }
+
+func (c *Client) PostAcademicYear(ctx context.Context, name string, startsOn, endsOn time.Time) (*AcademicYear, error) {
+	r, err := c.service.PostAcademicYear(ctx, &pb.PostAcademicYearRequest{
+		Name:     name,
+		StartsOn: timestamppb.New(startsOn),
+		EndsOn:   timestamppb.New(endsOn),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return academicYearFromProto(r.Year), nil
+}
+
+func (c *Client) GetAcademicYears(ctx context.Context, skip, take uint64) ([]*AcademicYear, error) {
+	r, err := c.service.GetAcademicYears(ctx, &pb.GetAcademicYearsRequest{Skip: skip, Take: take})
+	if err != nil {
+		return nil, err
+	}
+
+	years := []*AcademicYear{}
+	for _, y := range r.Years {
+		years = append(years, academicYearFromProto(y))
+	}
+	return years, nil
+}
+
+func (c *Client) DeleteAcademicYear(ctx context.Context, id string) error {
+	_, err := c.service.DeleteAcademicYear(ctx, &pb.DeleteAcademicYearRequest{Id: id})
+	return err
+}
+
+func (c *Client) PostTerm(ctx context.Context, yearID, name string, startsOn, endsOn time.Time) (*Term, error) {
+	r, err := c.service.PostTerm(ctx, &pb.PostTermRequest{
+		YearId:   yearID,
+		Name:     name,
+		StartsOn: timestamppb.New(startsOn),
+		EndsOn:   timestamppb.New(endsOn),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return termFromProto(r.Term), nil
+}
+
+func (c *Client) GetTerms(ctx context.Context, yearID string) ([]*Term, error) {
+	r, err := c.service.GetTerms(ctx, &pb.GetTermsRequest{YearId: yearID})
+	if err != nil {
+		return nil, err
+	}
+
+	terms := []*Term{}
+	for _, t := range r.Terms {
+		terms = append(terms, termFromProto(t))
+	}
+	return terms, nil
+}
+
+// GetCurrentTerm returns nil while no term has started yet.
+func (c *Client) GetCurrentTerm(ctx context.Context) (*Term, error) {
+	r, err := c.service.GetCurrentTerm(ctx, &pb.GetCurrentTermRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if r.Term == nil {
+		return nil, nil
+	}
+	return termFromProto(r.Term), nil
+}
+
+func (c *Client) DeleteTerm(ctx context.Context, id string) error {
+	_, err := c.service.DeleteTerm(ctx, &pb.DeleteTermRequest{Id: id})
+	return err
+}
+
+func (c *Client) RolloverYear(ctx context.Context, fromYearID, toYearID string) (*Rollover, error) {
+	r, err := c.service.RolloverYear(ctx, &pb.RolloverYearRequest{FromYearId: fromYearID, ToYearId: toYearID})
+	if err != nil {
+		return nil, err
+	}
+	return &Rollover{Courses: int(r.Courses), Classes: int(r.Classes)}, nil
+}
+
+func (c *Client) PostLessonSlot(ctx context.Context, classID string, weekday time.Weekday, startMinute, endMinute int, roomID *string, teacher string) (*LessonSlot, error) {
+	r, err := c.service.PostLessonSlot(ctx, &pb.PostLessonSlotRequest{
+		ClassId:     classID,
+		Weekday:     uint32(weekday),
+		StartMinute: uint32(startMinute),
+		EndMinute:   uint32(endMinute),
+		RoomId:      roomID,
+		Teacher:     teacher,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return lessonSlotFromProto(r.Slot), nil
+}
+
+// UpdateLessonSlot leaves nil fields alone. clearRoom takes the slot out of
+// its room, roomID is ignored then.
+func (c *Client) UpdateLessonSlot(ctx context.Context, id string, weekday *time.Weekday, startMinute, endMinute *int, roomID *string, clearRoom bool, teacher *string) (*LessonSlot, error) {
+	req := &pb.UpdateLessonSlotRequest{
+		Id:        id,
+		RoomId:    roomID,
+		Teacher:   teacher,
+		ClearRoom: clearRoom,
+	}
+	if weekday != nil {
+		w := uint32(*weekday)
+		req.Weekday = &w
+	}
+	if startMinute != nil {
+		m := uint32(*startMinute)
+		req.StartMinute = &m
+	}
+	if endMinute != nil {
+		m := uint32(*endMinute)
+		req.EndMinute = &m
+	}
+	r, err := c.service.UpdateLessonSlot(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return lessonSlotFromProto(r.Slot), nil
+}
+
+func (c *Client) DeleteLessonSlot(ctx context.Context, id string) error {
+	_, err := c.service.DeleteLessonSlot(ctx, &pb.DeleteLessonSlotRequest{Id: id})
+	return err
+}
+
+func (c *Client) GetLessonSlots(ctx context.Context, classID string) ([]*LessonSlot, error) {
+	r, err := c.service.GetLessonSlots(ctx, &pb.GetLessonSlotsRequest{ClassId: classID})
+	if err != nil {
+		return nil, err
+	}
+
+	slots := []*LessonSlot{}
+	for _, l := range r.Slots {
+		slots = append(slots, lessonSlotFromProto(l))
+	}
+	return slots, nil
+}
+
+func (c *Client) PostLessonException(ctx context.Context, slotID *string, kind string, startsOn, endsOn time.Time, note string) (*LessonException, error) {
+	r, err := c.service.PostLessonException(ctx, &pb.PostLessonExceptionRequest{
+		SlotId:   slotID,
+		Kind:     kind,
+		StartsOn: timestamppb.New(startsOn),
+		EndsOn:   timestamppb.New(endsOn),
+		Note:     note,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return lessonExceptionFromProto(r.Exception), nil
+}
+
+func (c *Client) GetLessonExceptions(ctx context.Context, from, to time.Time) ([]*LessonException, error) {
+	r, err := c.service.GetLessonExceptions(ctx, &pb.GetLessonExceptionsRequest{
+		From: timestamppb.New(from),
+		To:   timestamppb.New(to),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	exceptions := []*LessonException{}
+	for _, e := range r.Exceptions {
+		exceptions = append(exceptions, lessonExceptionFromProto(e))
+	}
+	return exceptions, nil
+}
+
+func (c *Client) DeleteLessonException(ctx context.Context, id string) error {
+	_, err := c.service.DeleteLessonException(ctx, &pb.DeleteLessonExceptionRequest{Id: id})
+	return err
+}
+
+func (c *Client) GetLessons(ctx context.Context, classID string, from, to time.Time) ([]*Lesson, error) {
+	r, err := c.service.GetLessons(ctx, &pb.GetLessonsRequest{
+		ClassId: classID,
+		From:    timestamppb.New(from),
+		To:      timestamppb.New(to),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return lessonsFromProto(r.Lessons), nil
+}
+
+func (c *Client) GetLessonsInRoom(ctx context.Context, roomID string, at time.Time) ([]*Lesson, error) {
+	r, err := c.service.GetLessonsInRoom(ctx, &pb.GetLessonsInRoomRequest{RoomId: roomID, At: timestamppb.New(at)})
+	if err != nil {
+		return nil, err
+	}
+	return lessonsFromProto(r.Lessons), nil
+}
+
+// GetNextLesson returns nil when the class has no lesson coming up.
+func (c *Client) GetNextLesson(ctx context.Context, classID string, after time.Time) (*Lesson, error) {
+	r, err := c.service.GetNextLesson(ctx, &pb.GetNextLessonRequest{ClassId: classID, After: timestamppb.New(after)})
+	if err != nil {
+		return nil, err
+	}
+	if r.Lesson == nil {
+		return nil, nil
+	}
+	return lessonFromProto(r.Lesson), nil
+}
+
+// PostEquipmentRequirement replaces the course's requirement for the same
+// item type.
+func (c *Client) PostEquipmentRequirement(ctx context.Context, courseID, itemType string, quantity, perStudents int) (*EquipmentRequirement, error) {
+	r, err := c.service.PostEquipmentRequirement(ctx, &pb.PostEquipmentRequirementRequest{
+		CourseId:    courseID,
+		ItemType:    itemType,
+		Quantity:    uint32(quantity),
+		PerStudents: uint32(perStudents),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return equipmentRequirementFromProto(r.Requirement), nil
+}
+
+func (c *Client) GetEquipmentRequirements(ctx context.Context, courseID string) ([]*EquipmentRequirement, error) {
+	r, err := c.service.GetEquipmentRequirements(ctx, &pb.GetEquipmentRequirementsRequest{CourseId: courseID})
+	if err != nil {
+		return nil, err
+	}
+
+	requirements := []*EquipmentRequirement{}
+	for _, e := range r.Requirements {
+		requirements = append(requirements, equipmentRequirementFromProto(e))
+	}
+	return requirements, nil
+}
+
+func (c *Client) DeleteEquipmentRequirement(ctx context.Context, id string) error {
+	_, err := c.service.DeleteEquipmentRequirement(ctx, &pb.DeleteEquipmentRequirementRequest{Id: id})
+	return err
+}
+
+func (c *Client) GetEquipmentNeeds(ctx context.Context, classID string) (*EquipmentNeeds, error) {
+	r, err := c.service.GetEquipmentNeeds(ctx, &pb.GetEquipmentNeedsRequest{ClassId: classID})
+	if err != nil {
+		return nil, err
+	}
+
+	needs := &EquipmentNeeds{StudentCount: int(r.StudentCount), Needs: []*EquipmentNeed{}}
+	for _, n := range r.Needs {
+		needs.Needs = append(needs.Needs, &EquipmentNeed{ItemType: n.ItemType, Quantity: int(n.Quantity)})
+	}
+	return needs, nil
+}
+
+func courseFromProto(c *pb.Course) *Course {
+	return &Course{
+		ID:        c.Id,
+		Name:      c.Name,
+		CreatedAt: c.CreatedAt.AsTime(),
+		UpdatedAt: c.UpdatedAt.AsTime(),
+		YearID:    c.YearId,
+	}
+}
+
+func classFromProto(c *pb.Class) *Class {
+	return &Class{
+		ID:           c.Id,
+		Name:         c.Name,
+		CourseID:     c.CourseId,
+		Course:       courseFromProto(c.Course),
+		CreatedAt:    c.CreatedAt.AsTime(),
+		UpdatedAt:    c.UpdatedAt.AsTime(),
+		TermID:       c.TermId,
+		StudentCount: int(c.StudentCount),
+	}
+}
+
+func academicYearFromProto(y *pb.AcademicYear) *AcademicYear {
+	return &AcademicYear{
+		ID:        y.Id,
+		Name:      y.Name,
+		StartsOn:  y.StartsOn.AsTime(),
+		EndsOn:    y.EndsOn.AsTime(),
+		CreatedAt: y.CreatedAt.AsTime(),
+		UpdatedAt: y.UpdatedAt.AsTime(),
+	}
+}
+
+func termFromProto(t *pb.Term) *Term {
+	return &Term{
+		ID:        t.Id,
+		YearID:    t.YearId,
+		Name:      t.Name,
+		StartsOn:  t.StartsOn.AsTime(),
+		EndsOn:    t.EndsOn.AsTime(),
+		CreatedAt: t.CreatedAt.AsTime(),
+		UpdatedAt: t.UpdatedAt.AsTime(),
+	}
+}
+
+func equipmentRequirementFromProto(e *pb.EquipmentRequirement) *EquipmentRequirement {
+	return &EquipmentRequirement{
+		ID:          e.Id,
+		CourseID:    e.CourseId,
+		ItemType:    e.ItemType,
+		Quantity:    int(e.Quantity),
+		PerStudents: int(e.PerStudents),
+		CreatedAt:   e.CreatedAt.AsTime(),
+		UpdatedAt:   e.UpdatedAt.AsTime(),
+	}
+}
+
+func lessonSlotFromProto(l *pb.LessonSlot) *LessonSlot {
+	return &LessonSlot{
+		ID:          l.Id,
+		ClassID:     l.ClassId,
+		Weekday:     time.Weekday(l.Weekday),
+		StartMinute: int(l.StartMinute),
+		EndMinute:   int(l.EndMinute),
+		RoomID:      l.RoomId,
+		Teacher:     l.Teacher,
+		CreatedAt:   l.CreatedAt.AsTime(),
+		UpdatedAt:   l.UpdatedAt.AsTime(),
+	}
+}
+
+func lessonExceptionFromProto(e *pb.LessonException) *LessonException {
+	return &LessonException{
+		ID:        e.Id,
+		SlotID:    e.SlotId,
+		Kind:      e.Kind,
+		StartsOn:  e.StartsOn.AsTime(),
+		EndsOn:    e.EndsOn.AsTime(),
+		Note:      e.Note,
+		CreatedAt: e.CreatedAt.AsTime(),
+	}
+}
+
+func lessonFromProto(l *pb.Lesson) *Lesson {
+	return &Lesson{
+		SlotID:   l.SlotId,
+		ClassID:  l.ClassId,
+		RoomID:   l.RoomId,
+		Teacher:  l.Teacher,
+		StartsAt: l.StartsAt.AsTime(),
+		EndsAt:   l.EndsAt.AsTime(),
+	}
+}
+
+func lessonsFromProto(res []*pb.Lesson) []*Lesson {
+	lessons := []*Lesson{}
+	for _, l := range res {
+		lessons = append(lessons, lessonFromProto(l))
+	}
+	return lessons
+}
@@ -1,35 +1,138 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"github.com/jochem11/inventory-system-back/education"
-	"github.com/kelseyhightower/envconfig"
+	"github.com/jochem11/inventory-system-back/internal/config"
+	"github.com/jochem11/inventory-system-back/internal/grpctls"
+	"github.com/jochem11/inventory-system-back/internal/logging"
+	"github.com/jochem11/inventory-system-back/internal/metrics"
+	"github.com/jochem11/inventory-system-back/internal/serve"
+	"github.com/jochem11/inventory-system-back/internal/tracing"
 	"github.com/tinrab/retry"
+	"google.golang.org/grpc"
 	"log"
+	"log/slog"
+	"os"
 	"time"
+	_ "time/tzdata" // the image has no zoneinfo
 )
 
 type Config struct {
-	DatabaseURL string `envconfig:"DATABASE_URL"`
+	Port         int           `envconfig:"PORT" default:"8080"`
+	MetricsPort  int           `envconfig:"METRICS_PORT" default:"9090"`
+	DatabaseURL  string        `envconfig:"DATABASE_URL" required:"true" secret:"true"`
+	DrainTimeout time.Duration `envconfig:"DRAIN_TIMEOUT" default:"15s"`
+	LogLevel     string        `envconfig:"LOG_LEVEL" default:"info"`
+
+	// RPCTimeout bounds each unary RPC that comes without a shorter deadline.
+	RPCTimeout time.Duration `envconfig:"RPC_TIMEOUT" default:"10s"`
+	// LiveInterval is how often LiveCourses sends a fresh page.
+	LiveInterval time.Duration `envconfig:"LIVE_INTERVAL" default:"5s"`
+	Page         config.Paging `envconfig:"PAGE_SIZE"`
+	DB           config.DBPool `envconfig:"DB"`
+	// TimeZone is the school's, lesson slots keep their wall clock time in it.
+	TimeZone string `envconfig:"TIME_ZONE" default:"UTC"`
+
+	// TracingExporter is otlp, stdout or none.
+	TracingExporter string `envconfig:"TRACING_EXPORTER" default:"none"`
+
+	TLS grpctls.Config `envconfig:"TLS"`
+}
+
+func (c Config) Validate() error {
+	var errs []error
+	errs = append(errs,
+		config.Port("PORT", c.Port),
+		config.Port("METRICS_PORT", c.MetricsPort),
+	)
+	if c.Port == c.MetricsPort {
+		errs = append(errs, errors.New("PORT and METRICS_PORT must differ"))
+	}
+	if _, err := logging.ParseLevel(c.LogLevel); err != nil {
+		errs = append(errs, fmt.Errorf("LOG_LEVEL: %w", err))
+	}
+	if c.DrainTimeout <= 0 || c.RPCTimeout <= 0 || c.LiveInterval <= 0 {
+		errs = append(errs, errors.New("DRAIN_TIMEOUT, RPC_TIMEOUT and LIVE_INTERVAL must be positive"))
+	}
+	if _, err := time.LoadLocation(c.TimeZone); err != nil {
+		errs = append(errs, fmt.Errorf("TIME_ZONE: %w", err))
+	}
+	errs = append(errs,
+		tracing.CheckExporter(c.TracingExporter),
+		c.Page.Validate(),
+		c.DB.Validate(),
+		c.TLS.Validate(),
+	)
+	return errors.Join(errs...)
 }
 
 func main() {
 	var cfg Config
-	err := envconfig.Process("", &cfg)
+	args, err := config.Load(&cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if len(args) > 0 && args[0] == "healthcheck" {
+		creds, err := cfg.TLS.Client("education")
+		if err != nil {
+			log.Fatal(err)
+		}
+		os.Exit(serve.Probe(cfg.Port, grpc.WithTransportCredentials(creds), grpc.WithAuthority("education")))
+	}
+
+	if err := logging.Setup(cfg.LogLevel); err != nil {
+		log.Fatal(err)
+	}
+	shutdownTracing, err := tracing.Setup(context.Background(), "education", cfg.TracingExporter)
 	if err != nil {
 		log.Fatal(err)
 	}
+	defer shutdownTracing(context.Background())
+
+	loc, err := time.LoadLocation(cfg.TimeZone)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	creds, err := cfg.TLS.Server("education")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ctx, stop := serve.SignalContext()
+	defer stop()
 
 	var r education.Repository
 	retry.ForeverSleep(2*time.Second, func(_ int) (err error) {
-		r, err = education.NewPostgresRepository(cfg.DatabaseURL)
+		r, err = education.NewPostgresRepository(cfg.DatabaseURL, cfg.DB)
 		if err != nil {
-			log.Println(err)
+			slog.Error("Connecting to database", "err", err)
 		}
 		return
 	})
 	defer r.Close()
 
-	log.Println("Listening on port 8080...")
-	s := education.NewEducationService(r)
-	log.Fatal(education.ListenGRPC(s, 8080))
+	go func() {
+		if err := metrics.Serve(ctx, cfg.MetricsPort, cfg.DrainTimeout); err != nil {
+			slog.Error("Serving metrics", "err", err)
+		}
+	}()
+
+	slog.Info("Listening", "port", cfg.Port, "metrics_port", cfg.MetricsPort)
+	s := education.NewEducationService(r, cfg.Page, cfg.LiveInterval, loc)
+	err = education.ListenGRPC(ctx, s, r, serve.GRPCOptions{
+		Port:    cfg.Port,
+		Drain:   cfg.DrainTimeout,
+		Timeout: cfg.RPCTimeout,
+		Creds:   creds,
+	})
+	if err != nil {
+		r.Close()
+		log.Fatal(err)
+	}
+	slog.Info("Stopped")
 }
@@ -23,19 +23,196 @@ const (
 )
 
 // Models
+type AcademicYear struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	StartsOn      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=starts_on,json=startsOn,proto3" json:"starts_on,omitempty"`
+	EndsOn        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ends_on,json=endsOn,proto3" json:"ends_on,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcademicYear) Reset() {
+	*x = AcademicYear{}
+	mi := &file_education_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcademicYear) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcademicYear) ProtoMessage() {}
+
+func (x *AcademicYear) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcademicYear.ProtoReflect.Descriptor instead.
+func (*AcademicYear) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AcademicYear) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AcademicYear) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AcademicYear) GetStartsOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsOn
+	}
+	return nil
+}
+
+func (x *AcademicYear) GetEndsOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsOn
+	}
+	return nil
+}
+
+func (x *AcademicYear) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AcademicYear) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type Term struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	YearId        string                 `protobuf:"bytes,2,opt,name=year_id,json=yearId,proto3" json:"year_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	StartsOn      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=starts_on,json=startsOn,proto3" json:"starts_on,omitempty"`
+	EndsOn        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=ends_on,json=endsOn,proto3" json:"ends_on,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Term) Reset() {
+	*x = Term{}
+	mi := &file_education_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Term) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Term) ProtoMessage() {}
+
+func (x *Term) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Term.ProtoReflect.Descriptor instead.
+func (*Term) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Term) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Term) GetYearId() string {
+	if x != nil {
+		return x.YearId
+	}
+	return ""
+}
+
+func (x *Term) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Term) GetStartsOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsOn
+	}
+	return nil
+}
+
+func (x *Term) GetEndsOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsOn
+	}
+	return nil
+}
+
+func (x *Term) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Term) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
 type Course struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	YearId        *string                `protobuf:"bytes,5,opt,name=year_id,json=yearId,proto3,oneof" json:"year_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Course) Reset() {
 	*x = Course{}
-	mi := &file_education_proto_msgTypes[0]
+	mi := &file_education_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -47,7 +224,7 @@ func (x *Course) String() string {
 func (*Course) ProtoMessage() {}
 
 func (x *Course) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[0]
+	mi := &file_education_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -60,7 +237,7 @@ func (x *Course) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Course.ProtoReflect.Descriptor instead.
 func (*Course) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{0}
+	return file_education_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Course) GetId() string {
@@ -91,33 +268,2794 @@ func (x *Course) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Course) GetYearId() string {
+	if x != nil && x.YearId != nil {
+		return *x.YearId
+	}
+	return ""
+}
+
 type Class struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	CourseId      string                 `protobuf:"bytes,5,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
-	Course        *Course                `protobuf:"bytes,6,opt,name=course,proto3,oneof" json:"course,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CourseId  string                 `protobuf:"bytes,5,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Course    *Course                `protobuf:"bytes,6,opt,name=course,proto3,oneof" json:"course,omitempty"`
+	TermId    *string                `protobuf:"bytes,7,opt,name=term_id,json=termId,proto3,oneof" json:"term_id,omitempty"`
+	// student_count stands in for enrolment until students are kept.
+	StudentCount  uint32 `protobuf:"varint,8,opt,name=student_count,json=studentCount,proto3" json:"student_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Class) Reset() {
 	*x = Class{}
-	mi := &file_education_proto_msgTypes[1]
+	mi := &file_education_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Class) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Class) ProtoMessage() {}
+
+func (x *Class) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Class.ProtoReflect.Descriptor instead.
+func (*Class) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Class) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Class) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Class) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Class) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Class) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *Class) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+func (x *Class) GetTermId() string {
+	if x != nil && x.TermId != nil {
+		return *x.TermId
+	}
+	return ""
+}
+
+func (x *Class) GetStudentCount() uint32 {
+	if x != nil {
+		return x.StudentCount
+	}
+	return 0
+}
+
+// EquipmentRequirement asks for quantity items of item_type for every
+// per_students students of a class, or quantity in total when per_students
+// is 0.
+type EquipmentRequirement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CourseId      string                 `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	ItemType      string                 `protobuf:"bytes,3,opt,name=item_type,json=itemType,proto3" json:"item_type,omitempty"`
+	Quantity      uint32                 `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	PerStudents   uint32                 `protobuf:"varint,5,opt,name=per_students,json=perStudents,proto3" json:"per_students,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EquipmentRequirement) Reset() {
+	*x = EquipmentRequirement{}
+	mi := &file_education_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EquipmentRequirement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EquipmentRequirement) ProtoMessage() {}
+
+func (x *EquipmentRequirement) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EquipmentRequirement.ProtoReflect.Descriptor instead.
+func (*EquipmentRequirement) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EquipmentRequirement) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EquipmentRequirement) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *EquipmentRequirement) GetItemType() string {
+	if x != nil {
+		return x.ItemType
+	}
+	return ""
+}
+
+func (x *EquipmentRequirement) GetQuantity() uint32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *EquipmentRequirement) GetPerStudents() uint32 {
+	if x != nil {
+		return x.PerStudents
+	}
+	return 0
+}
+
+func (x *EquipmentRequirement) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *EquipmentRequirement) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type EquipmentNeed struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemType      string                 `protobuf:"bytes,1,opt,name=item_type,json=itemType,proto3" json:"item_type,omitempty"`
+	Quantity      uint32                 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EquipmentNeed) Reset() {
+	*x = EquipmentNeed{}
+	mi := &file_education_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EquipmentNeed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EquipmentNeed) ProtoMessage() {}
+
+func (x *EquipmentNeed) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EquipmentNeed.ProtoReflect.Descriptor instead.
+func (*EquipmentNeed) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EquipmentNeed) GetItemType() string {
+	if x != nil {
+		return x.ItemType
+	}
+	return ""
+}
+
+func (x *EquipmentNeed) GetQuantity() uint32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// LessonSlot recurs every week on weekday, 0 being Sunday as in Go's
+// time.Weekday. Times are minutes after midnight in the school's time zone.
+type LessonSlot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClassId       string                 `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Weekday       uint32                 `protobuf:"varint,3,opt,name=weekday,proto3" json:"weekday,omitempty"`
+	StartMinute   uint32                 `protobuf:"varint,4,opt,name=start_minute,json=startMinute,proto3" json:"start_minute,omitempty"`
+	EndMinute     uint32                 `protobuf:"varint,5,opt,name=end_minute,json=endMinute,proto3" json:"end_minute,omitempty"`
+	RoomId        *string                `protobuf:"bytes,6,opt,name=room_id,json=roomId,proto3,oneof" json:"room_id,omitempty"`
+	Teacher       string                 `protobuf:"bytes,7,opt,name=teacher,proto3" json:"teacher,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LessonSlot) Reset() {
+	*x = LessonSlot{}
+	mi := &file_education_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LessonSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LessonSlot) ProtoMessage() {}
+
+func (x *LessonSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LessonSlot.ProtoReflect.Descriptor instead.
+func (*LessonSlot) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LessonSlot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LessonSlot) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *LessonSlot) GetWeekday() uint32 {
+	if x != nil {
+		return x.Weekday
+	}
+	return 0
+}
+
+func (x *LessonSlot) GetStartMinute() uint32 {
+	if x != nil {
+		return x.StartMinute
+	}
+	return 0
+}
+
+func (x *LessonSlot) GetEndMinute() uint32 {
+	if x != nil {
+		return x.EndMinute
+	}
+	return 0
+}
+
+func (x *LessonSlot) GetRoomId() string {
+	if x != nil && x.RoomId != nil {
+		return *x.RoomId
+	}
+	return ""
+}
+
+func (x *LessonSlot) GetTeacher() string {
+	if x != nil {
+		return x.Teacher
+	}
+	return ""
+}
+
+func (x *LessonSlot) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *LessonSlot) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// LessonException cancels lessons from starts_on through ends_on: those of
+// one slot, or all of them when slot_id is unset.
+type LessonException struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SlotId        *string                `protobuf:"bytes,2,opt,name=slot_id,json=slotId,proto3,oneof" json:"slot_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	StartsOn      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=starts_on,json=startsOn,proto3" json:"starts_on,omitempty"`
+	EndsOn        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=ends_on,json=endsOn,proto3" json:"ends_on,omitempty"`
+	Note          string                 `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LessonException) Reset() {
+	*x = LessonException{}
+	mi := &file_education_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LessonException) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LessonException) ProtoMessage() {}
+
+func (x *LessonException) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LessonException.ProtoReflect.Descriptor instead.
+func (*LessonException) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LessonException) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LessonException) GetSlotId() string {
+	if x != nil && x.SlotId != nil {
+		return *x.SlotId
+	}
+	return ""
+}
+
+func (x *LessonException) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *LessonException) GetStartsOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsOn
+	}
+	return nil
+}
+
+func (x *LessonException) GetEndsOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsOn
+	}
+	return nil
+}
+
+func (x *LessonException) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *LessonException) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Lesson is one occurrence of a slot.
+type Lesson struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SlotId        string                 `protobuf:"bytes,1,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	ClassId       string                 `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	RoomId        *string                `protobuf:"bytes,3,opt,name=room_id,json=roomId,proto3,oneof" json:"room_id,omitempty"`
+	Teacher       string                 `protobuf:"bytes,4,opt,name=teacher,proto3" json:"teacher,omitempty"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Lesson) Reset() {
+	*x = Lesson{}
+	mi := &file_education_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Lesson) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lesson) ProtoMessage() {}
+
+func (x *Lesson) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lesson.ProtoReflect.Descriptor instead.
+func (*Lesson) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Lesson) GetSlotId() string {
+	if x != nil {
+		return x.SlotId
+	}
+	return ""
+}
+
+func (x *Lesson) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *Lesson) GetRoomId() string {
+	if x != nil && x.RoomId != nil {
+		return *x.RoomId
+	}
+	return ""
+}
+
+func (x *Lesson) GetTeacher() string {
+	if x != nil {
+		return x.Teacher
+	}
+	return ""
+}
+
+func (x *Lesson) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *Lesson) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+// Requests
+type PostCourseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	YearId        *string                `protobuf:"bytes,2,opt,name=year_id,json=yearId,proto3,oneof" json:"year_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostCourseRequest) Reset() {
+	*x = PostCourseRequest{}
+	mi := &file_education_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostCourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostCourseRequest) ProtoMessage() {}
+
+func (x *PostCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostCourseRequest.ProtoReflect.Descriptor instead.
+func (*PostCourseRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PostCourseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PostCourseRequest) GetYearId() string {
+	if x != nil && x.YearId != nil {
+		return *x.YearId
+	}
+	return ""
+}
+
+type GetCourseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseRequest) Reset() {
+	*x = GetCourseRequest{}
+	mi := &file_education_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseRequest) ProtoMessage() {}
+
+func (x *GetCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseRequest.ProtoReflect.Descriptor instead.
+func (*GetCourseRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetCourseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetCourseByNameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseByNameRequest) Reset() {
+	*x = GetCourseByNameRequest{}
+	mi := &file_education_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseByNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseByNameRequest) ProtoMessage() {}
+
+func (x *GetCourseByNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseByNameRequest.ProtoReflect.Descriptor instead.
+func (*GetCourseByNameRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetCourseByNameRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetCoursesByIDsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCoursesByIDsRequest) Reset() {
+	*x = GetCoursesByIDsRequest{}
+	mi := &file_education_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoursesByIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoursesByIDsRequest) ProtoMessage() {}
+
+func (x *GetCoursesByIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoursesByIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetCoursesByIDsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetCoursesByIDsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type GetCoursesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skip          uint64                 `protobuf:"varint,1,opt,name=skip,proto3" json:"skip,omitempty"`
+	Take          uint64                 `protobuf:"varint,2,opt,name=take,proto3" json:"take,omitempty"`
+	YearId        *string                `protobuf:"bytes,3,opt,name=year_id,json=yearId,proto3,oneof" json:"year_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCoursesRequest) Reset() {
+	*x = GetCoursesRequest{}
+	mi := &file_education_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoursesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoursesRequest) ProtoMessage() {}
+
+func (x *GetCoursesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoursesRequest.ProtoReflect.Descriptor instead.
+func (*GetCoursesRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetCoursesRequest) GetSkip() uint64 {
+	if x != nil {
+		return x.Skip
+	}
+	return 0
+}
+
+func (x *GetCoursesRequest) GetTake() uint64 {
+	if x != nil {
+		return x.Take
+	}
+	return 0
+}
+
+func (x *GetCoursesRequest) GetYearId() string {
+	if x != nil && x.YearId != nil {
+		return *x.YearId
+	}
+	return ""
+}
+
+type UpdateCourseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCourseRequest) Reset() {
+	*x = UpdateCourseRequest{}
+	mi := &file_education_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCourseRequest) ProtoMessage() {}
+
+func (x *UpdateCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCourseRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCourseRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateCourseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateCourseRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type DeleteCourseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCourseRequest) Reset() {
+	*x = DeleteCourseRequest{}
+	mi := &file_education_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCourseRequest) ProtoMessage() {}
+
+func (x *DeleteCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCourseRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCourseRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteCourseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PostClassRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CourseId      string                 `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	TermId        *string                `protobuf:"bytes,3,opt,name=term_id,json=termId,proto3,oneof" json:"term_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostClassRequest) Reset() {
+	*x = PostClassRequest{}
+	mi := &file_education_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostClassRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostClassRequest) ProtoMessage() {}
+
+func (x *PostClassRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostClassRequest.ProtoReflect.Descriptor instead.
+func (*PostClassRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PostClassRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PostClassRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *PostClassRequest) GetTermId() string {
+	if x != nil && x.TermId != nil {
+		return *x.TermId
+	}
+	return ""
+}
+
+type GetClassRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClassRequest) Reset() {
+	*x = GetClassRequest{}
+	mi := &file_education_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClassRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClassRequest) ProtoMessage() {}
+
+func (x *GetClassRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClassRequest.ProtoReflect.Descriptor instead.
+func (*GetClassRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetClassRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetClassByNameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClassByNameRequest) Reset() {
+	*x = GetClassByNameRequest{}
+	mi := &file_education_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClassByNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClassByNameRequest) ProtoMessage() {}
+
+func (x *GetClassByNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClassByNameRequest.ProtoReflect.Descriptor instead.
+func (*GetClassByNameRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetClassByNameRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetClassesByIDsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClassesByIDsRequest) Reset() {
+	*x = GetClassesByIDsRequest{}
+	mi := &file_education_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClassesByIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClassesByIDsRequest) ProtoMessage() {}
+
+func (x *GetClassesByIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClassesByIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetClassesByIDsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetClassesByIDsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type GetClassesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skip          uint64                 `protobuf:"varint,1,opt,name=skip,proto3" json:"skip,omitempty"`
+	Take          uint64                 `protobuf:"varint,2,opt,name=take,proto3" json:"take,omitempty"`
+	TermId        *string                `protobuf:"bytes,3,opt,name=term_id,json=termId,proto3,oneof" json:"term_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClassesRequest) Reset() {
+	*x = GetClassesRequest{}
+	mi := &file_education_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClassesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClassesRequest) ProtoMessage() {}
+
+func (x *GetClassesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClassesRequest.ProtoReflect.Descriptor instead.
+func (*GetClassesRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetClassesRequest) GetSkip() uint64 {
+	if x != nil {
+		return x.Skip
+	}
+	return 0
+}
+
+func (x *GetClassesRequest) GetTake() uint64 {
+	if x != nil {
+		return x.Take
+	}
+	return 0
+}
+
+func (x *GetClassesRequest) GetTermId() string {
+	if x != nil && x.TermId != nil {
+		return *x.TermId
+	}
+	return ""
+}
+
+type UpdateClassRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	CourseId      *string                `protobuf:"bytes,3,opt,name=course_id,json=courseId,proto3,oneof" json:"course_id,omitempty"`
+	TermId        *string                `protobuf:"bytes,4,opt,name=term_id,json=termId,proto3,oneof" json:"term_id,omitempty"`
+	StudentCount  *uint32                `protobuf:"varint,5,opt,name=student_count,json=studentCount,proto3,oneof" json:"student_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateClassRequest) Reset() {
+	*x = UpdateClassRequest{}
+	mi := &file_education_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateClassRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateClassRequest) ProtoMessage() {}
+
+func (x *UpdateClassRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateClassRequest.ProtoReflect.Descriptor instead.
+func (*UpdateClassRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateClassRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateClassRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateClassRequest) GetCourseId() string {
+	if x != nil && x.CourseId != nil {
+		return *x.CourseId
+	}
+	return ""
+}
+
+func (x *UpdateClassRequest) GetTermId() string {
+	if x != nil && x.TermId != nil {
+		return *x.TermId
+	}
+	return ""
+}
+
+func (x *UpdateClassRequest) GetStudentCount() uint32 {
+	if x != nil && x.StudentCount != nil {
+		return *x.StudentCount
+	}
+	return 0
+}
+
+type DeleteClassRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteClassRequest) Reset() {
+	*x = DeleteClassRequest{}
+	mi := &file_education_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteClassRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteClassRequest) ProtoMessage() {}
+
+func (x *DeleteClassRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteClassRequest.ProtoReflect.Descriptor instead.
+func (*DeleteClassRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeleteClassRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PostAcademicYearRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	StartsOn      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=starts_on,json=startsOn,proto3" json:"starts_on,omitempty"`
+	EndsOn        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=ends_on,json=endsOn,proto3" json:"ends_on,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostAcademicYearRequest) Reset() {
+	*x = PostAcademicYearRequest{}
+	mi := &file_education_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostAcademicYearRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostAcademicYearRequest) ProtoMessage() {}
+
+func (x *PostAcademicYearRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostAcademicYearRequest.ProtoReflect.Descriptor instead.
+func (*PostAcademicYearRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PostAcademicYearRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PostAcademicYearRequest) GetStartsOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsOn
+	}
+	return nil
+}
+
+func (x *PostAcademicYearRequest) GetEndsOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsOn
+	}
+	return nil
+}
+
+type GetAcademicYearsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skip          uint64                 `protobuf:"varint,1,opt,name=skip,proto3" json:"skip,omitempty"`
+	Take          uint64                 `protobuf:"varint,2,opt,name=take,proto3" json:"take,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAcademicYearsRequest) Reset() {
+	*x = GetAcademicYearsRequest{}
+	mi := &file_education_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAcademicYearsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAcademicYearsRequest) ProtoMessage() {}
+
+func (x *GetAcademicYearsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAcademicYearsRequest.ProtoReflect.Descriptor instead.
+func (*GetAcademicYearsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetAcademicYearsRequest) GetSkip() uint64 {
+	if x != nil {
+		return x.Skip
+	}
+	return 0
+}
+
+func (x *GetAcademicYearsRequest) GetTake() uint64 {
+	if x != nil {
+		return x.Take
+	}
+	return 0
+}
+
+type DeleteAcademicYearRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAcademicYearRequest) Reset() {
+	*x = DeleteAcademicYearRequest{}
+	mi := &file_education_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAcademicYearRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAcademicYearRequest) ProtoMessage() {}
+
+func (x *DeleteAcademicYearRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAcademicYearRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAcademicYearRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteAcademicYearRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PostTermRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	YearId        string                 `protobuf:"bytes,1,opt,name=year_id,json=yearId,proto3" json:"year_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	StartsOn      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=starts_on,json=startsOn,proto3" json:"starts_on,omitempty"`
+	EndsOn        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ends_on,json=endsOn,proto3" json:"ends_on,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostTermRequest) Reset() {
+	*x = PostTermRequest{}
+	mi := &file_education_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostTermRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostTermRequest) ProtoMessage() {}
+
+func (x *PostTermRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostTermRequest.ProtoReflect.Descriptor instead.
+func (*PostTermRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PostTermRequest) GetYearId() string {
+	if x != nil {
+		return x.YearId
+	}
+	return ""
+}
+
+func (x *PostTermRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PostTermRequest) GetStartsOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsOn
+	}
+	return nil
+}
+
+func (x *PostTermRequest) GetEndsOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsOn
+	}
+	return nil
+}
+
+type GetTermsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	YearId        string                 `protobuf:"bytes,1,opt,name=year_id,json=yearId,proto3" json:"year_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTermsRequest) Reset() {
+	*x = GetTermsRequest{}
+	mi := &file_education_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTermsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTermsRequest) ProtoMessage() {}
+
+func (x *GetTermsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTermsRequest.ProtoReflect.Descriptor instead.
+func (*GetTermsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetTermsRequest) GetYearId() string {
+	if x != nil {
+		return x.YearId
+	}
+	return ""
+}
+
+type GetCurrentTermRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurrentTermRequest) Reset() {
+	*x = GetCurrentTermRequest{}
+	mi := &file_education_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurrentTermRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrentTermRequest) ProtoMessage() {}
+
+func (x *GetCurrentTermRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrentTermRequest.ProtoReflect.Descriptor instead.
+func (*GetCurrentTermRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{28}
+}
+
+type DeleteTermRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTermRequest) Reset() {
+	*x = DeleteTermRequest{}
+	mi := &file_education_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTermRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTermRequest) ProtoMessage() {}
+
+func (x *DeleteTermRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTermRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTermRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DeleteTermRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RolloverYearRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromYearId    string                 `protobuf:"bytes,1,opt,name=from_year_id,json=fromYearId,proto3" json:"from_year_id,omitempty"`
+	ToYearId      string                 `protobuf:"bytes,2,opt,name=to_year_id,json=toYearId,proto3" json:"to_year_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RolloverYearRequest) Reset() {
+	*x = RolloverYearRequest{}
+	mi := &file_education_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RolloverYearRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloverYearRequest) ProtoMessage() {}
+
+func (x *RolloverYearRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloverYearRequest.ProtoReflect.Descriptor instead.
+func (*RolloverYearRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RolloverYearRequest) GetFromYearId() string {
+	if x != nil {
+		return x.FromYearId
+	}
+	return ""
+}
+
+func (x *RolloverYearRequest) GetToYearId() string {
+	if x != nil {
+		return x.ToYearId
+	}
+	return ""
+}
+
+type PostLessonSlotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClassId       string                 `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Weekday       uint32                 `protobuf:"varint,2,opt,name=weekday,proto3" json:"weekday,omitempty"`
+	StartMinute   uint32                 `protobuf:"varint,3,opt,name=start_minute,json=startMinute,proto3" json:"start_minute,omitempty"`
+	EndMinute     uint32                 `protobuf:"varint,4,opt,name=end_minute,json=endMinute,proto3" json:"end_minute,omitempty"`
+	RoomId        *string                `protobuf:"bytes,5,opt,name=room_id,json=roomId,proto3,oneof" json:"room_id,omitempty"`
+	Teacher       string                 `protobuf:"bytes,6,opt,name=teacher,proto3" json:"teacher,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostLessonSlotRequest) Reset() {
+	*x = PostLessonSlotRequest{}
+	mi := &file_education_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostLessonSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostLessonSlotRequest) ProtoMessage() {}
+
+func (x *PostLessonSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostLessonSlotRequest.ProtoReflect.Descriptor instead.
+func (*PostLessonSlotRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PostLessonSlotRequest) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *PostLessonSlotRequest) GetWeekday() uint32 {
+	if x != nil {
+		return x.Weekday
+	}
+	return 0
+}
+
+func (x *PostLessonSlotRequest) GetStartMinute() uint32 {
+	if x != nil {
+		return x.StartMinute
+	}
+	return 0
+}
+
+func (x *PostLessonSlotRequest) GetEndMinute() uint32 {
+	if x != nil {
+		return x.EndMinute
+	}
+	return 0
+}
+
+func (x *PostLessonSlotRequest) GetRoomId() string {
+	if x != nil && x.RoomId != nil {
+		return *x.RoomId
+	}
+	return ""
+}
+
+func (x *PostLessonSlotRequest) GetTeacher() string {
+	if x != nil {
+		return x.Teacher
+	}
+	return ""
+}
+
+type UpdateLessonSlotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Weekday       *uint32                `protobuf:"varint,2,opt,name=weekday,proto3,oneof" json:"weekday,omitempty"`
+	StartMinute   *uint32                `protobuf:"varint,3,opt,name=start_minute,json=startMinute,proto3,oneof" json:"start_minute,omitempty"`
+	EndMinute     *uint32                `protobuf:"varint,4,opt,name=end_minute,json=endMinute,proto3,oneof" json:"end_minute,omitempty"`
+	RoomId        *string                `protobuf:"bytes,5,opt,name=room_id,json=roomId,proto3,oneof" json:"room_id,omitempty"`
+	Teacher       *string                `protobuf:"bytes,6,opt,name=teacher,proto3,oneof" json:"teacher,omitempty"`
+	ClearRoom     bool                   `protobuf:"varint,7,opt,name=clear_room,json=clearRoom,proto3" json:"clear_room,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLessonSlotRequest) Reset() {
+	*x = UpdateLessonSlotRequest{}
+	mi := &file_education_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLessonSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLessonSlotRequest) ProtoMessage() {}
+
+func (x *UpdateLessonSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLessonSlotRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLessonSlotRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdateLessonSlotRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateLessonSlotRequest) GetWeekday() uint32 {
+	if x != nil && x.Weekday != nil {
+		return *x.Weekday
+	}
+	return 0
+}
+
+func (x *UpdateLessonSlotRequest) GetStartMinute() uint32 {
+	if x != nil && x.StartMinute != nil {
+		return *x.StartMinute
+	}
+	return 0
+}
+
+func (x *UpdateLessonSlotRequest) GetEndMinute() uint32 {
+	if x != nil && x.EndMinute != nil {
+		return *x.EndMinute
+	}
+	return 0
+}
+
+func (x *UpdateLessonSlotRequest) GetRoomId() string {
+	if x != nil && x.RoomId != nil {
+		return *x.RoomId
+	}
+	return ""
+}
+
+func (x *UpdateLessonSlotRequest) GetTeacher() string {
+	if x != nil && x.Teacher != nil {
+		return *x.Teacher
+	}
+	return ""
+}
+
+func (x *UpdateLessonSlotRequest) GetClearRoom() bool {
+	if x != nil {
+		return x.ClearRoom
+	}
+	return false
+}
+
+type DeleteLessonSlotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLessonSlotRequest) Reset() {
+	*x = DeleteLessonSlotRequest{}
+	mi := &file_education_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLessonSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLessonSlotRequest) ProtoMessage() {}
+
+func (x *DeleteLessonSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLessonSlotRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLessonSlotRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteLessonSlotRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetLessonSlotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClassId       string                 `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLessonSlotsRequest) Reset() {
+	*x = GetLessonSlotsRequest{}
+	mi := &file_education_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLessonSlotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLessonSlotsRequest) ProtoMessage() {}
+
+func (x *GetLessonSlotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLessonSlotsRequest.ProtoReflect.Descriptor instead.
+func (*GetLessonSlotsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetLessonSlotsRequest) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+type PostLessonExceptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SlotId        *string                `protobuf:"bytes,1,opt,name=slot_id,json=slotId,proto3,oneof" json:"slot_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	StartsOn      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=starts_on,json=startsOn,proto3" json:"starts_on,omitempty"`
+	EndsOn        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ends_on,json=endsOn,proto3" json:"ends_on,omitempty"`
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostLessonExceptionRequest) Reset() {
+	*x = PostLessonExceptionRequest{}
+	mi := &file_education_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostLessonExceptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostLessonExceptionRequest) ProtoMessage() {}
+
+func (x *PostLessonExceptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostLessonExceptionRequest.ProtoReflect.Descriptor instead.
+func (*PostLessonExceptionRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PostLessonExceptionRequest) GetSlotId() string {
+	if x != nil && x.SlotId != nil {
+		return *x.SlotId
+	}
+	return ""
+}
+
+func (x *PostLessonExceptionRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *PostLessonExceptionRequest) GetStartsOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsOn
+	}
+	return nil
+}
+
+func (x *PostLessonExceptionRequest) GetEndsOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsOn
+	}
+	return nil
+}
+
+func (x *PostLessonExceptionRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type GetLessonExceptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLessonExceptionsRequest) Reset() {
+	*x = GetLessonExceptionsRequest{}
+	mi := &file_education_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLessonExceptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLessonExceptionsRequest) ProtoMessage() {}
+
+func (x *GetLessonExceptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLessonExceptionsRequest.ProtoReflect.Descriptor instead.
+func (*GetLessonExceptionsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetLessonExceptionsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetLessonExceptionsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type DeleteLessonExceptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLessonExceptionRequest) Reset() {
+	*x = DeleteLessonExceptionRequest{}
+	mi := &file_education_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLessonExceptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLessonExceptionRequest) ProtoMessage() {}
+
+func (x *DeleteLessonExceptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLessonExceptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLessonExceptionRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DeleteLessonExceptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetLessonsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClassId       string                 `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLessonsRequest) Reset() {
+	*x = GetLessonsRequest{}
+	mi := &file_education_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLessonsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLessonsRequest) ProtoMessage() {}
+
+func (x *GetLessonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLessonsRequest.ProtoReflect.Descriptor instead.
+func (*GetLessonsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetLessonsRequest) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *GetLessonsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetLessonsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type GetLessonsInRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLessonsInRoomRequest) Reset() {
+	*x = GetLessonsInRoomRequest{}
+	mi := &file_education_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLessonsInRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLessonsInRoomRequest) ProtoMessage() {}
+
+func (x *GetLessonsInRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLessonsInRoomRequest.ProtoReflect.Descriptor instead.
+func (*GetLessonsInRoomRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetLessonsInRoomRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *GetLessonsInRoomRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type GetNextLessonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClassId       string                 `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	After         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNextLessonRequest) Reset() {
+	*x = GetNextLessonRequest{}
+	mi := &file_education_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNextLessonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNextLessonRequest) ProtoMessage() {}
+
+func (x *GetNextLessonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNextLessonRequest.ProtoReflect.Descriptor instead.
+func (*GetNextLessonRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetNextLessonRequest) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *GetNextLessonRequest) GetAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+type PostEquipmentRequirementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	ItemType      string                 `protobuf:"bytes,2,opt,name=item_type,json=itemType,proto3" json:"item_type,omitempty"`
+	Quantity      uint32                 `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	PerStudents   uint32                 `protobuf:"varint,4,opt,name=per_students,json=perStudents,proto3" json:"per_students,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostEquipmentRequirementRequest) Reset() {
+	*x = PostEquipmentRequirementRequest{}
+	mi := &file_education_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostEquipmentRequirementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostEquipmentRequirementRequest) ProtoMessage() {}
+
+func (x *PostEquipmentRequirementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostEquipmentRequirementRequest.ProtoReflect.Descriptor instead.
+func (*PostEquipmentRequirementRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PostEquipmentRequirementRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *PostEquipmentRequirementRequest) GetItemType() string {
+	if x != nil {
+		return x.ItemType
+	}
+	return ""
+}
+
+func (x *PostEquipmentRequirementRequest) GetQuantity() uint32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PostEquipmentRequirementRequest) GetPerStudents() uint32 {
+	if x != nil {
+		return x.PerStudents
+	}
+	return 0
+}
+
+type GetEquipmentRequirementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEquipmentRequirementsRequest) Reset() {
+	*x = GetEquipmentRequirementsRequest{}
+	mi := &file_education_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEquipmentRequirementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEquipmentRequirementsRequest) ProtoMessage() {}
+
+func (x *GetEquipmentRequirementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEquipmentRequirementsRequest.ProtoReflect.Descriptor instead.
+func (*GetEquipmentRequirementsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetEquipmentRequirementsRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+type DeleteEquipmentRequirementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEquipmentRequirementRequest) Reset() {
+	*x = DeleteEquipmentRequirementRequest{}
+	mi := &file_education_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEquipmentRequirementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEquipmentRequirementRequest) ProtoMessage() {}
+
+func (x *DeleteEquipmentRequirementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEquipmentRequirementRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEquipmentRequirementRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DeleteEquipmentRequirementRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetEquipmentNeedsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClassId       string                 `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEquipmentNeedsRequest) Reset() {
+	*x = GetEquipmentNeedsRequest{}
+	mi := &file_education_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEquipmentNeedsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEquipmentNeedsRequest) ProtoMessage() {}
+
+func (x *GetEquipmentNeedsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEquipmentNeedsRequest.ProtoReflect.Descriptor instead.
+func (*GetEquipmentNeedsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetEquipmentNeedsRequest) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+// Responses
+type PostCourseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Course        *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostCourseResponse) Reset() {
+	*x = PostCourseResponse{}
+	mi := &file_education_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostCourseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostCourseResponse) ProtoMessage() {}
+
+func (x *PostCourseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostCourseResponse.ProtoReflect.Descriptor instead.
+func (*PostCourseResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *PostCourseResponse) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+type GetCourseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Course        *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseResponse) Reset() {
+	*x = GetCourseResponse{}
+	mi := &file_education_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseResponse) ProtoMessage() {}
+
+func (x *GetCourseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseResponse.ProtoReflect.Descriptor instead.
+func (*GetCourseResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetCourseResponse) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+type GetCoursesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Courses       []*Course              `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCoursesResponse) Reset() {
+	*x = GetCoursesResponse{}
+	mi := &file_education_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoursesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoursesResponse) ProtoMessage() {}
+
+func (x *GetCoursesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoursesResponse.ProtoReflect.Descriptor instead.
+func (*GetCoursesResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetCoursesResponse) GetCourses() []*Course {
+	if x != nil {
+		return x.Courses
+	}
+	return nil
+}
+
+type UpdateCourseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Course        *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCourseResponse) Reset() {
+	*x = UpdateCourseResponse{}
+	mi := &file_education_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCourseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCourseResponse) ProtoMessage() {}
+
+func (x *UpdateCourseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCourseResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCourseResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UpdateCourseResponse) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+type PostClassResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Class         *Class                 `protobuf:"bytes,1,opt,name=class,proto3" json:"class,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostClassResponse) Reset() {
+	*x = PostClassResponse{}
+	mi := &file_education_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostClassResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostClassResponse) ProtoMessage() {}
+
+func (x *PostClassResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostClassResponse.ProtoReflect.Descriptor instead.
+func (*PostClassResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *PostClassResponse) GetClass() *Class {
+	if x != nil {
+		return x.Class
+	}
+	return nil
+}
+
+type GetClassResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Class         *Class                 `protobuf:"bytes,1,opt,name=class,proto3" json:"class,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClassResponse) Reset() {
+	*x = GetClassResponse{}
+	mi := &file_education_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClassResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClassResponse) ProtoMessage() {}
+
+func (x *GetClassResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClassResponse.ProtoReflect.Descriptor instead.
+func (*GetClassResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetClassResponse) GetClass() *Class {
+	if x != nil {
+		return x.Class
+	}
+	return nil
+}
+
+type GetClassesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Classes       []*Class               `protobuf:"bytes,1,rep,name=classes,proto3" json:"classes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClassesResponse) Reset() {
+	*x = GetClassesResponse{}
+	mi := &file_education_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClassesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClassesResponse) ProtoMessage() {}
+
+func (x *GetClassesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClassesResponse.ProtoReflect.Descriptor instead.
+func (*GetClassesResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetClassesResponse) GetClasses() []*Class {
+	if x != nil {
+		return x.Classes
+	}
+	return nil
+}
+
+type UpdateClassResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Class         *Class                 `protobuf:"bytes,1,opt,name=class,proto3" json:"class,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateClassResponse) Reset() {
+	*x = UpdateClassResponse{}
+	mi := &file_education_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Class) String() string {
+func (x *UpdateClassResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Class) ProtoMessage() {}
+func (*UpdateClassResponse) ProtoMessage() {}
 
-func (x *Class) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[1]
+func (x *UpdateClassResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -128,76 +3066,84 @@ func (x *Class) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Class.ProtoReflect.Descriptor instead.
-func (*Class) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use UpdateClassResponse.ProtoReflect.Descriptor instead.
+func (*UpdateClassResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *Class) GetId() string {
+func (x *UpdateClassResponse) GetClass() *Class {
 	if x != nil {
-		return x.Id
+		return x.Class
 	}
-	return ""
+	return nil
 }
 
-func (x *Class) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type PostAcademicYearResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          *AcademicYear          `protobuf:"bytes,1,opt,name=year,proto3" json:"year,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Class) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+func (x *PostAcademicYearResponse) Reset() {
+	*x = PostAcademicYearResponse{}
+	mi := &file_education_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Class) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return nil
+func (x *PostAcademicYearResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Class) GetCourseId() string {
+func (*PostAcademicYearResponse) ProtoMessage() {}
+
+func (x *PostAcademicYearResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[53]
 	if x != nil {
-		return x.CourseId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Class) GetCourse() *Course {
+// Deprecated: Use PostAcademicYearResponse.ProtoReflect.Descriptor instead.
+func (*PostAcademicYearResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *PostAcademicYearResponse) GetYear() *AcademicYear {
 	if x != nil {
-		return x.Course
+		return x.Year
 	}
 	return nil
 }
 
-// Requests
-type PostCourseRequest struct {
+type GetAcademicYearsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Years         []*AcademicYear        `protobuf:"bytes,1,rep,name=years,proto3" json:"years,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PostCourseRequest) Reset() {
-	*x = PostCourseRequest{}
-	mi := &file_education_proto_msgTypes[2]
+func (x *GetAcademicYearsResponse) Reset() {
+	*x = GetAcademicYearsResponse{}
+	mi := &file_education_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PostCourseRequest) String() string {
+func (x *GetAcademicYearsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PostCourseRequest) ProtoMessage() {}
+func (*GetAcademicYearsResponse) ProtoMessage() {}
 
-func (x *PostCourseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[2]
+func (x *GetAcademicYearsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -208,40 +3154,40 @@ func (x *PostCourseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PostCourseRequest.ProtoReflect.Descriptor instead.
-func (*PostCourseRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use GetAcademicYearsResponse.ProtoReflect.Descriptor instead.
+func (*GetAcademicYearsResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *PostCourseRequest) GetName() string {
+func (x *GetAcademicYearsResponse) GetYears() []*AcademicYear {
 	if x != nil {
-		return x.Name
+		return x.Years
 	}
-	return ""
+	return nil
 }
 
-type GetCourseRequest struct {
+type PostTermResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Term          *Term                  `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCourseRequest) Reset() {
-	*x = GetCourseRequest{}
-	mi := &file_education_proto_msgTypes[3]
+func (x *PostTermResponse) Reset() {
+	*x = PostTermResponse{}
+	mi := &file_education_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCourseRequest) String() string {
+func (x *PostTermResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCourseRequest) ProtoMessage() {}
+func (*PostTermResponse) ProtoMessage() {}
 
-func (x *GetCourseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[3]
+func (x *PostTermResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -252,41 +3198,40 @@ func (x *GetCourseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCourseRequest.ProtoReflect.Descriptor instead.
-func (*GetCourseRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use PostTermResponse.ProtoReflect.Descriptor instead.
+func (*PostTermResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *GetCourseRequest) GetId() string {
+func (x *PostTermResponse) GetTerm() *Term {
 	if x != nil {
-		return x.Id
+		return x.Term
 	}
-	return ""
+	return nil
 }
 
-type GetCoursesRequest struct {
+type GetTermsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Skip          uint64                 `protobuf:"varint,1,opt,name=skip,proto3" json:"skip,omitempty"`
-	Take          uint64                 `protobuf:"varint,2,opt,name=take,proto3" json:"take,omitempty"`
+	Terms         []*Term                `protobuf:"bytes,1,rep,name=terms,proto3" json:"terms,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCoursesRequest) Reset() {
-	*x = GetCoursesRequest{}
-	mi := &file_education_proto_msgTypes[4]
+func (x *GetTermsResponse) Reset() {
+	*x = GetTermsResponse{}
+	mi := &file_education_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCoursesRequest) String() string {
+func (x *GetTermsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCoursesRequest) ProtoMessage() {}
+func (*GetTermsResponse) ProtoMessage() {}
 
-func (x *GetCoursesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[4]
+func (x *GetTermsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -297,48 +3242,40 @@ func (x *GetCoursesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCoursesRequest.ProtoReflect.Descriptor instead.
-func (*GetCoursesRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *GetCoursesRequest) GetSkip() uint64 {
-	if x != nil {
-		return x.Skip
-	}
-	return 0
+// Deprecated: Use GetTermsResponse.ProtoReflect.Descriptor instead.
+func (*GetTermsResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *GetCoursesRequest) GetTake() uint64 {
+func (x *GetTermsResponse) GetTerms() []*Term {
 	if x != nil {
-		return x.Take
+		return x.Terms
 	}
-	return 0
+	return nil
 }
 
-type UpdateCourseRequest struct {
+type GetCurrentTermResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Term          *Term                  `protobuf:"bytes,1,opt,name=term,proto3,oneof" json:"term,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCourseRequest) Reset() {
-	*x = UpdateCourseRequest{}
-	mi := &file_education_proto_msgTypes[5]
+func (x *GetCurrentTermResponse) Reset() {
+	*x = GetCurrentTermResponse{}
+	mi := &file_education_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCourseRequest) String() string {
+func (x *GetCurrentTermResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCourseRequest) ProtoMessage() {}
+func (*GetCurrentTermResponse) ProtoMessage() {}
 
-func (x *UpdateCourseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[5]
+func (x *GetCurrentTermResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -349,47 +3286,41 @@ func (x *UpdateCourseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCourseRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCourseRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use GetCurrentTermResponse.ProtoReflect.Descriptor instead.
+func (*GetCurrentTermResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *UpdateCourseRequest) GetId() string {
+func (x *GetCurrentTermResponse) GetTerm() *Term {
 	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *UpdateCourseRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+		return x.Term
 	}
-	return ""
+	return nil
 }
 
-type DeleteCourseRequest struct {
+type RolloverYearResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Courses       uint32                 `protobuf:"varint,1,opt,name=courses,proto3" json:"courses,omitempty"`
+	Classes       uint32                 `protobuf:"varint,2,opt,name=classes,proto3" json:"classes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCourseRequest) Reset() {
-	*x = DeleteCourseRequest{}
-	mi := &file_education_proto_msgTypes[6]
+func (x *RolloverYearResponse) Reset() {
+	*x = RolloverYearResponse{}
+	mi := &file_education_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCourseRequest) String() string {
+func (x *RolloverYearResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCourseRequest) ProtoMessage() {}
+func (*RolloverYearResponse) ProtoMessage() {}
 
-func (x *DeleteCourseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[6]
+func (x *RolloverYearResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -400,41 +3331,47 @@ func (x *DeleteCourseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCourseRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCourseRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use RolloverYearResponse.ProtoReflect.Descriptor instead.
+func (*RolloverYearResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *DeleteCourseRequest) GetId() string {
+func (x *RolloverYearResponse) GetCourses() uint32 {
 	if x != nil {
-		return x.Id
+		return x.Courses
 	}
-	return ""
+	return 0
 }
 
-type PostClassRequest struct {
+func (x *RolloverYearResponse) GetClasses() uint32 {
+	if x != nil {
+		return x.Classes
+	}
+	return 0
+}
+
+type PostLessonSlotResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	CourseId      string                 `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Slot          *LessonSlot            `protobuf:"bytes,1,opt,name=slot,proto3" json:"slot,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PostClassRequest) Reset() {
-	*x = PostClassRequest{}
-	mi := &file_education_proto_msgTypes[7]
+func (x *PostLessonSlotResponse) Reset() {
+	*x = PostLessonSlotResponse{}
+	mi := &file_education_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PostClassRequest) String() string {
+func (x *PostLessonSlotResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PostClassRequest) ProtoMessage() {}
+func (*PostLessonSlotResponse) ProtoMessage() {}
 
-func (x *PostClassRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[7]
+func (x *PostLessonSlotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -445,47 +3382,40 @@ func (x *PostClassRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PostClassRequest.ProtoReflect.Descriptor instead.
-func (*PostClassRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *PostClassRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use PostLessonSlotResponse.ProtoReflect.Descriptor instead.
+func (*PostLessonSlotResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *PostClassRequest) GetCourseId() string {
+func (x *PostLessonSlotResponse) GetSlot() *LessonSlot {
 	if x != nil {
-		return x.CourseId
+		return x.Slot
 	}
-	return ""
+	return nil
 }
 
-type GetClassRequest struct {
+type UpdateLessonSlotResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Slot          *LessonSlot            `protobuf:"bytes,1,opt,name=slot,proto3" json:"slot,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetClassRequest) Reset() {
-	*x = GetClassRequest{}
-	mi := &file_education_proto_msgTypes[8]
+func (x *UpdateLessonSlotResponse) Reset() {
+	*x = UpdateLessonSlotResponse{}
+	mi := &file_education_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetClassRequest) String() string {
+func (x *UpdateLessonSlotResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClassRequest) ProtoMessage() {}
+func (*UpdateLessonSlotResponse) ProtoMessage() {}
 
-func (x *GetClassRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[8]
+func (x *UpdateLessonSlotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -496,41 +3426,40 @@ func (x *GetClassRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClassRequest.ProtoReflect.Descriptor instead.
-func (*GetClassRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use UpdateLessonSlotResponse.ProtoReflect.Descriptor instead.
+func (*UpdateLessonSlotResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *GetClassRequest) GetId() string {
+func (x *UpdateLessonSlotResponse) GetSlot() *LessonSlot {
 	if x != nil {
-		return x.Id
+		return x.Slot
 	}
-	return ""
+	return nil
 }
 
-type GetClassesRequest struct {
+type GetLessonSlotsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Skip          uint64                 `protobuf:"varint,1,opt,name=skip,proto3" json:"skip,omitempty"`
-	Take          uint64                 `protobuf:"varint,2,opt,name=take,proto3" json:"take,omitempty"`
+	Slots         []*LessonSlot          `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetClassesRequest) Reset() {
-	*x = GetClassesRequest{}
-	mi := &file_education_proto_msgTypes[9]
+func (x *GetLessonSlotsResponse) Reset() {
+	*x = GetLessonSlotsResponse{}
+	mi := &file_education_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetClassesRequest) String() string {
+func (x *GetLessonSlotsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClassesRequest) ProtoMessage() {}
+func (*GetLessonSlotsResponse) ProtoMessage() {}
 
-func (x *GetClassesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[9]
+func (x *GetLessonSlotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -541,49 +3470,40 @@ func (x *GetClassesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClassesRequest.ProtoReflect.Descriptor instead.
-func (*GetClassesRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *GetClassesRequest) GetSkip() uint64 {
-	if x != nil {
-		return x.Skip
-	}
-	return 0
+// Deprecated: Use GetLessonSlotsResponse.ProtoReflect.Descriptor instead.
+func (*GetLessonSlotsResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *GetClassesRequest) GetTake() uint64 {
+func (x *GetLessonSlotsResponse) GetSlots() []*LessonSlot {
 	if x != nil {
-		return x.Take
+		return x.Slots
 	}
-	return 0
+	return nil
 }
 
-type UpdateClassRequest struct {
+type PostLessonExceptionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	CourseId      *string                `protobuf:"bytes,3,opt,name=course_id,json=courseId,proto3,oneof" json:"course_id,omitempty"`
+	Exception     *LessonException       `protobuf:"bytes,1,opt,name=exception,proto3" json:"exception,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateClassRequest) Reset() {
-	*x = UpdateClassRequest{}
-	mi := &file_education_proto_msgTypes[10]
+func (x *PostLessonExceptionResponse) Reset() {
+	*x = PostLessonExceptionResponse{}
+	mi := &file_education_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateClassRequest) String() string {
+func (x *PostLessonExceptionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateClassRequest) ProtoMessage() {}
+func (*PostLessonExceptionResponse) ProtoMessage() {}
 
-func (x *UpdateClassRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[10]
+func (x *PostLessonExceptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -594,54 +3514,40 @@ func (x *UpdateClassRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateClassRequest.ProtoReflect.Descriptor instead.
-func (*UpdateClassRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use PostLessonExceptionResponse.ProtoReflect.Descriptor instead.
+func (*PostLessonExceptionResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *UpdateClassRequest) GetId() string {
+func (x *PostLessonExceptionResponse) GetException() *LessonException {
 	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *UpdateClassRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
-	}
-	return ""
-}
-
-func (x *UpdateClassRequest) GetCourseId() string {
-	if x != nil && x.CourseId != nil {
-		return *x.CourseId
+		return x.Exception
 	}
-	return ""
+	return nil
 }
 
-type DeleteClassRequest struct {
+type GetLessonExceptionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Exceptions    []*LessonException     `protobuf:"bytes,1,rep,name=exceptions,proto3" json:"exceptions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteClassRequest) Reset() {
-	*x = DeleteClassRequest{}
-	mi := &file_education_proto_msgTypes[11]
+func (x *GetLessonExceptionsResponse) Reset() {
+	*x = GetLessonExceptionsResponse{}
+	mi := &file_education_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteClassRequest) String() string {
+func (x *GetLessonExceptionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteClassRequest) ProtoMessage() {}
+func (*GetLessonExceptionsResponse) ProtoMessage() {}
 
-func (x *DeleteClassRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[11]
+func (x *GetLessonExceptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -652,41 +3558,40 @@ func (x *DeleteClassRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteClassRequest.ProtoReflect.Descriptor instead.
-func (*DeleteClassRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GetLessonExceptionsResponse.ProtoReflect.Descriptor instead.
+func (*GetLessonExceptionsResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *DeleteClassRequest) GetId() string {
+func (x *GetLessonExceptionsResponse) GetExceptions() []*LessonException {
 	if x != nil {
-		return x.Id
+		return x.Exceptions
 	}
-	return ""
+	return nil
 }
 
-// Responses
-type PostCourseResponse struct {
+type GetLessonsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Course        *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
+	Lessons       []*Lesson              `protobuf:"bytes,1,rep,name=lessons,proto3" json:"lessons,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PostCourseResponse) Reset() {
-	*x = PostCourseResponse{}
-	mi := &file_education_proto_msgTypes[12]
+func (x *GetLessonsResponse) Reset() {
+	*x = GetLessonsResponse{}
+	mi := &file_education_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PostCourseResponse) String() string {
+func (x *GetLessonsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PostCourseResponse) ProtoMessage() {}
+func (*GetLessonsResponse) ProtoMessage() {}
 
-func (x *PostCourseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[12]
+func (x *GetLessonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -697,40 +3602,40 @@ func (x *PostCourseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PostCourseResponse.ProtoReflect.Descriptor instead.
-func (*PostCourseResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use GetLessonsResponse.ProtoReflect.Descriptor instead.
+func (*GetLessonsResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *PostCourseResponse) GetCourse() *Course {
+func (x *GetLessonsResponse) GetLessons() []*Lesson {
 	if x != nil {
-		return x.Course
+		return x.Lessons
 	}
 	return nil
 }
 
-type GetCourseResponse struct {
+type GetNextLessonResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Course        *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
+	Lesson        *Lesson                `protobuf:"bytes,1,opt,name=lesson,proto3,oneof" json:"lesson,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCourseResponse) Reset() {
-	*x = GetCourseResponse{}
-	mi := &file_education_proto_msgTypes[13]
+func (x *GetNextLessonResponse) Reset() {
+	*x = GetNextLessonResponse{}
+	mi := &file_education_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCourseResponse) String() string {
+func (x *GetNextLessonResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCourseResponse) ProtoMessage() {}
+func (*GetNextLessonResponse) ProtoMessage() {}
 
-func (x *GetCourseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[13]
+func (x *GetNextLessonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -741,40 +3646,40 @@ func (x *GetCourseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCourseResponse.ProtoReflect.Descriptor instead.
-func (*GetCourseResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use GetNextLessonResponse.ProtoReflect.Descriptor instead.
+func (*GetNextLessonResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *GetCourseResponse) GetCourse() *Course {
+func (x *GetNextLessonResponse) GetLesson() *Lesson {
 	if x != nil {
-		return x.Course
+		return x.Lesson
 	}
 	return nil
 }
 
-type GetCoursesResponse struct {
+type PostEquipmentRequirementResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Courses       []*Course              `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"`
+	Requirement   *EquipmentRequirement  `protobuf:"bytes,1,opt,name=requirement,proto3" json:"requirement,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCoursesResponse) Reset() {
-	*x = GetCoursesResponse{}
-	mi := &file_education_proto_msgTypes[14]
+func (x *PostEquipmentRequirementResponse) Reset() {
+	*x = PostEquipmentRequirementResponse{}
+	mi := &file_education_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCoursesResponse) String() string {
+func (x *PostEquipmentRequirementResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCoursesResponse) ProtoMessage() {}
+func (*PostEquipmentRequirementResponse) ProtoMessage() {}
 
-func (x *GetCoursesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[14]
+func (x *PostEquipmentRequirementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -785,40 +3690,40 @@ func (x *GetCoursesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCoursesResponse.ProtoReflect.Descriptor instead.
-func (*GetCoursesResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use PostEquipmentRequirementResponse.ProtoReflect.Descriptor instead.
+func (*PostEquipmentRequirementResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *GetCoursesResponse) GetCourses() []*Course {
+func (x *PostEquipmentRequirementResponse) GetRequirement() *EquipmentRequirement {
 	if x != nil {
-		return x.Courses
+		return x.Requirement
 	}
 	return nil
 }
 
-type UpdateCourseResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Course        *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
+type GetEquipmentRequirementsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Requirements  []*EquipmentRequirement `protobuf:"bytes,1,rep,name=requirements,proto3" json:"requirements,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCourseResponse) Reset() {
-	*x = UpdateCourseResponse{}
-	mi := &file_education_proto_msgTypes[15]
+func (x *GetEquipmentRequirementsResponse) Reset() {
+	*x = GetEquipmentRequirementsResponse{}
+	mi := &file_education_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCourseResponse) String() string {
+func (x *GetEquipmentRequirementsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCourseResponse) ProtoMessage() {}
+func (*GetEquipmentRequirementsResponse) ProtoMessage() {}
 
-func (x *UpdateCourseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[15]
+func (x *GetEquipmentRequirementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -829,40 +3734,41 @@ func (x *UpdateCourseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCourseResponse.ProtoReflect.Descriptor instead.
-func (*UpdateCourseResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GetEquipmentRequirementsResponse.ProtoReflect.Descriptor instead.
+func (*GetEquipmentRequirementsResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *UpdateCourseResponse) GetCourse() *Course {
+func (x *GetEquipmentRequirementsResponse) GetRequirements() []*EquipmentRequirement {
 	if x != nil {
-		return x.Course
+		return x.Requirements
 	}
 	return nil
 }
 
-type PostClassResponse struct {
+type GetEquipmentNeedsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Class         *Class                 `protobuf:"bytes,1,opt,name=class,proto3" json:"class,omitempty"`
+	StudentCount  uint32                 `protobuf:"varint,1,opt,name=student_count,json=studentCount,proto3" json:"student_count,omitempty"`
+	Needs         []*EquipmentNeed       `protobuf:"bytes,2,rep,name=needs,proto3" json:"needs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PostClassResponse) Reset() {
-	*x = PostClassResponse{}
-	mi := &file_education_proto_msgTypes[16]
+func (x *GetEquipmentNeedsResponse) Reset() {
+	*x = GetEquipmentNeedsResponse{}
+	mi := &file_education_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PostClassResponse) String() string {
+func (x *GetEquipmentNeedsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PostClassResponse) ProtoMessage() {}
+func (*GetEquipmentNeedsResponse) ProtoMessage() {}
 
-func (x *PostClassResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[16]
+func (x *GetEquipmentNeedsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -873,40 +3779,46 @@ func (x *PostClassResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PostClassResponse.ProtoReflect.Descriptor instead.
-func (*PostClassResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use GetEquipmentNeedsResponse.ProtoReflect.Descriptor instead.
+func (*GetEquipmentNeedsResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *PostClassResponse) GetClass() *Class {
+func (x *GetEquipmentNeedsResponse) GetStudentCount() uint32 {
 	if x != nil {
-		return x.Class
+		return x.StudentCount
+	}
+	return 0
+}
+
+func (x *GetEquipmentNeedsResponse) GetNeeds() []*EquipmentNeed {
+	if x != nil {
+		return x.Needs
 	}
 	return nil
 }
 
-type GetClassResponse struct {
+type DeleteCourseResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Class         *Class                 `protobuf:"bytes,1,opt,name=class,proto3" json:"class,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetClassResponse) Reset() {
-	*x = GetClassResponse{}
-	mi := &file_education_proto_msgTypes[17]
+func (x *DeleteCourseResponse) Reset() {
+	*x = DeleteCourseResponse{}
+	mi := &file_education_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetClassResponse) String() string {
+func (x *DeleteCourseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClassResponse) ProtoMessage() {}
+func (*DeleteCourseResponse) ProtoMessage() {}
 
-func (x *GetClassResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[17]
+func (x *DeleteCourseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -917,40 +3829,32 @@ func (x *GetClassResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClassResponse.ProtoReflect.Descriptor instead.
-func (*GetClassResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *GetClassResponse) GetClass() *Class {
-	if x != nil {
-		return x.Class
-	}
-	return nil
+// Deprecated: Use DeleteCourseResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCourseResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{69}
 }
 
-type GetClassesResponse struct {
+type DeleteClassResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Classes       []*Class               `protobuf:"bytes,1,rep,name=classes,proto3" json:"classes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetClassesResponse) Reset() {
-	*x = GetClassesResponse{}
-	mi := &file_education_proto_msgTypes[18]
+func (x *DeleteClassResponse) Reset() {
+	*x = DeleteClassResponse{}
+	mi := &file_education_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetClassesResponse) String() string {
+func (x *DeleteClassResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClassesResponse) ProtoMessage() {}
+func (*DeleteClassResponse) ProtoMessage() {}
 
-func (x *GetClassesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[18]
+func (x *DeleteClassResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -961,40 +3865,68 @@ func (x *GetClassesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClassesResponse.ProtoReflect.Descriptor instead.
-func (*GetClassesResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use DeleteClassResponse.ProtoReflect.Descriptor instead.
+func (*DeleteClassResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *GetClassesResponse) GetClasses() []*Class {
+type DeleteAcademicYearResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAcademicYearResponse) Reset() {
+	*x = DeleteAcademicYearResponse{}
+	mi := &file_education_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAcademicYearResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAcademicYearResponse) ProtoMessage() {}
+
+func (x *DeleteAcademicYearResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[71]
 	if x != nil {
-		return x.Classes
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type UpdateClassResponse struct {
+// Deprecated: Use DeleteAcademicYearResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAcademicYearResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{71}
+}
+
+type DeleteTermResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Class         *Class                 `protobuf:"bytes,1,opt,name=class,proto3" json:"class,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateClassResponse) Reset() {
-	*x = UpdateClassResponse{}
-	mi := &file_education_proto_msgTypes[19]
+func (x *DeleteTermResponse) Reset() {
+	*x = DeleteTermResponse{}
+	mi := &file_education_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateClassResponse) String() string {
+func (x *DeleteTermResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateClassResponse) ProtoMessage() {}
+func (*DeleteTermResponse) ProtoMessage() {}
 
-func (x *UpdateClassResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[19]
+func (x *DeleteTermResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1005,39 +3937,68 @@ func (x *UpdateClassResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateClassResponse.ProtoReflect.Descriptor instead.
-func (*UpdateClassResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use DeleteTermResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTermResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *UpdateClassResponse) GetClass() *Class {
+type DeleteLessonSlotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLessonSlotResponse) Reset() {
+	*x = DeleteLessonSlotResponse{}
+	mi := &file_education_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLessonSlotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLessonSlotResponse) ProtoMessage() {}
+
+func (x *DeleteLessonSlotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[73]
 	if x != nil {
-		return x.Class
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type DeleteCourseResponse struct {
+// Deprecated: Use DeleteLessonSlotResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLessonSlotResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{73}
+}
+
+type DeleteLessonExceptionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCourseResponse) Reset() {
-	*x = DeleteCourseResponse{}
-	mi := &file_education_proto_msgTypes[20]
+func (x *DeleteLessonExceptionResponse) Reset() {
+	*x = DeleteLessonExceptionResponse{}
+	mi := &file_education_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCourseResponse) String() string {
+func (x *DeleteLessonExceptionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCourseResponse) ProtoMessage() {}
+func (*DeleteLessonExceptionResponse) ProtoMessage() {}
 
-func (x *DeleteCourseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[20]
+func (x *DeleteLessonExceptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1048,32 +4009,32 @@ func (x *DeleteCourseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCourseResponse.ProtoReflect.Descriptor instead.
-func (*DeleteCourseResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use DeleteLessonExceptionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLessonExceptionResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{74}
 }
 
-type DeleteClassResponse struct {
+type DeleteEquipmentRequirementResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteClassResponse) Reset() {
-	*x = DeleteClassResponse{}
-	mi := &file_education_proto_msgTypes[21]
+func (x *DeleteEquipmentRequirementResponse) Reset() {
+	*x = DeleteEquipmentRequirementResponse{}
+	mi := &file_education_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteClassResponse) String() string {
+func (x *DeleteEquipmentRequirementResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteClassResponse) ProtoMessage() {}
+func (*DeleteEquipmentRequirementResponse) ProtoMessage() {}
 
-func (x *DeleteClassResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[21]
+func (x *DeleteEquipmentRequirementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1084,23 +4045,45 @@ func (x *DeleteClassResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteClassResponse.ProtoReflect.Descriptor instead.
-func (*DeleteClassResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use DeleteEquipmentRequirementResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEquipmentRequirementResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{75}
 }
 
 var File_education_proto protoreflect.FileDescriptor
 
 const file_education_proto_rawDesc = "" +
 	"\n" +
-	"\x0feducation.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa2\x01\n" +
+	"\x0feducation.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\"\x96\x02\n" +
+	"\fAcademicYear\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x127\n" +
+	"\tstarts_on\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsOn\x123\n" +
+	"\aends_on\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsOn\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa7\x02\n" +
+	"\x04Term\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\ayear_id\x18\x02 \x01(\tR\x06yearId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x127\n" +
+	"\tstarts_on\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsOn\x123\n" +
+	"\aends_on\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsOn\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xcc\x01\n" +
 	"\x06Course\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x129\n" +
 	"\n" +
 	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xf2\x01\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1c\n" +
+	"\ayear_id\x18\x05 \x01(\tH\x00R\x06yearId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_year_id\"\xc1\x02\n" +
 	"\x05Class\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x129\n" +
@@ -1110,38 +4093,206 @@ const file_education_proto_rawDesc = "" +
 	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1b\n" +
 	"\tcourse_id\x18\x05 \x01(\tR\bcourseId\x12'\n" +
 	"\x06course\x18\x06 \x01(\v2\n" +
-	".pb.CourseH\x00R\x06course\x88\x01\x01B\t\n" +
-	"\a_course\"'\n" +
+	".pb.CourseH\x00R\x06course\x88\x01\x01\x12\x1c\n" +
+	"\aterm_id\x18\a \x01(\tH\x01R\x06termId\x88\x01\x01\x12#\n" +
+	"\rstudent_count\x18\b \x01(\rR\fstudentCountB\t\n" +
+	"\a_courseB\n" +
+	"\n" +
+	"\b_term_id\"\x95\x02\n" +
+	"\x14EquipmentRequirement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tcourse_id\x18\x02 \x01(\tR\bcourseId\x12\x1b\n" +
+	"\titem_type\x18\x03 \x01(\tR\bitemType\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\rR\bquantity\x12!\n" +
+	"\fper_students\x18\x05 \x01(\rR\vperStudents\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"H\n" +
+	"\rEquipmentNeed\x12\x1b\n" +
+	"\titem_type\x18\x01 \x01(\tR\bitemType\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\rR\bquantity\"\xcd\x02\n" +
+	"\n" +
+	"LessonSlot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bclass_id\x18\x02 \x01(\tR\aclassId\x12\x18\n" +
+	"\aweekday\x18\x03 \x01(\rR\aweekday\x12!\n" +
+	"\fstart_minute\x18\x04 \x01(\rR\vstartMinute\x12\x1d\n" +
+	"\n" +
+	"end_minute\x18\x05 \x01(\rR\tendMinute\x12\x1c\n" +
+	"\aroom_id\x18\x06 \x01(\tH\x00R\x06roomId\x88\x01\x01\x12\x18\n" +
+	"\ateacher\x18\a \x01(\tR\ateacher\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\n" +
+	"\n" +
+	"\b_room_id\"\x9c\x02\n" +
+	"\x0fLessonException\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\aslot_id\x18\x02 \x01(\tH\x00R\x06slotId\x88\x01\x01\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x127\n" +
+	"\tstarts_on\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsOn\x123\n" +
+	"\aends_on\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsOn\x12\x12\n" +
+	"\x04note\x18\x06 \x01(\tR\x04note\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\n" +
+	"\n" +
+	"\b_slot_id\"\xee\x01\n" +
+	"\x06Lesson\x12\x17\n" +
+	"\aslot_id\x18\x01 \x01(\tR\x06slotId\x12\x19\n" +
+	"\bclass_id\x18\x02 \x01(\tR\aclassId\x12\x1c\n" +
+	"\aroom_id\x18\x03 \x01(\tH\x00R\x06roomId\x88\x01\x01\x12\x18\n" +
+	"\ateacher\x18\x04 \x01(\tR\ateacher\x127\n" +
+	"\tstarts_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAtB\n" +
+	"\n" +
+	"\b_room_id\"Q\n" +
 	"\x11PostCourseRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"\"\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\ayear_id\x18\x02 \x01(\tH\x00R\x06yearId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_year_id\"\"\n" +
 	"\x10GetCourseRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\";\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\",\n" +
+	"\x16GetCourseByNameRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"*\n" +
+	"\x16GetCoursesByIDsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"e\n" +
 	"\x11GetCoursesRequest\x12\x12\n" +
 	"\x04skip\x18\x01 \x01(\x04R\x04skip\x12\x12\n" +
-	"\x04take\x18\x02 \x01(\x04R\x04take\"G\n" +
+	"\x04take\x18\x02 \x01(\x04R\x04take\x12\x1c\n" +
+	"\ayear_id\x18\x03 \x01(\tH\x00R\x06yearId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_year_id\"G\n" +
 	"\x13UpdateCourseRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01B\a\n" +
 	"\x05_name\"%\n" +
 	"\x13DeleteCourseRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"C\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"m\n" +
 	"\x10PostClassRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
-	"\tcourse_id\x18\x02 \x01(\tR\bcourseId\"!\n" +
+	"\tcourse_id\x18\x02 \x01(\tR\bcourseId\x12\x1c\n" +
+	"\aterm_id\x18\x03 \x01(\tH\x00R\x06termId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_term_id\"!\n" +
 	"\x0fGetClassRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\";\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"+\n" +
+	"\x15GetClassByNameRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"*\n" +
+	"\x16GetClassesByIDsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"e\n" +
 	"\x11GetClassesRequest\x12\x12\n" +
 	"\x04skip\x18\x01 \x01(\x04R\x04skip\x12\x12\n" +
-	"\x04take\x18\x02 \x01(\x04R\x04take\"v\n" +
+	"\x04take\x18\x02 \x01(\x04R\x04take\x12\x1c\n" +
+	"\aterm_id\x18\x03 \x01(\tH\x00R\x06termId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_term_id\"\xdc\x01\n" +
 	"\x12UpdateClassRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12 \n" +
-	"\tcourse_id\x18\x03 \x01(\tH\x01R\bcourseId\x88\x01\x01B\a\n" +
+	"\tcourse_id\x18\x03 \x01(\tH\x01R\bcourseId\x88\x01\x01\x12\x1c\n" +
+	"\aterm_id\x18\x04 \x01(\tH\x02R\x06termId\x88\x01\x01\x12(\n" +
+	"\rstudent_count\x18\x05 \x01(\rH\x03R\fstudentCount\x88\x01\x01B\a\n" +
 	"\x05_nameB\f\n" +
 	"\n" +
-	"_course_id\"$\n" +
+	"_course_idB\n" +
+	"\n" +
+	"\b_term_idB\x10\n" +
+	"\x0e_student_count\"$\n" +
 	"\x12DeleteClassRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"8\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x9b\x01\n" +
+	"\x17PostAcademicYearRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x127\n" +
+	"\tstarts_on\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsOn\x123\n" +
+	"\aends_on\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsOn\"A\n" +
+	"\x17GetAcademicYearsRequest\x12\x12\n" +
+	"\x04skip\x18\x01 \x01(\x04R\x04skip\x12\x12\n" +
+	"\x04take\x18\x02 \x01(\x04R\x04take\"+\n" +
+	"\x19DeleteAcademicYearRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xac\x01\n" +
+	"\x0fPostTermRequest\x12\x17\n" +
+	"\ayear_id\x18\x01 \x01(\tR\x06yearId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x127\n" +
+	"\tstarts_on\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsOn\x123\n" +
+	"\aends_on\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsOn\"*\n" +
+	"\x0fGetTermsRequest\x12\x17\n" +
+	"\ayear_id\x18\x01 \x01(\tR\x06yearId\"\x17\n" +
+	"\x15GetCurrentTermRequest\"#\n" +
+	"\x11DeleteTermRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"U\n" +
+	"\x13RolloverYearRequest\x12 \n" +
+	"\ffrom_year_id\x18\x01 \x01(\tR\n" +
+	"fromYearId\x12\x1c\n" +
+	"\n" +
+	"to_year_id\x18\x02 \x01(\tR\btoYearId\"\xd2\x01\n" +
+	"\x15PostLessonSlotRequest\x12\x19\n" +
+	"\bclass_id\x18\x01 \x01(\tR\aclassId\x12\x18\n" +
+	"\aweekday\x18\x02 \x01(\rR\aweekday\x12!\n" +
+	"\fstart_minute\x18\x03 \x01(\rR\vstartMinute\x12\x1d\n" +
+	"\n" +
+	"end_minute\x18\x04 \x01(\rR\tendMinute\x12\x1c\n" +
+	"\aroom_id\x18\x05 \x01(\tH\x00R\x06roomId\x88\x01\x01\x12\x18\n" +
+	"\ateacher\x18\x06 \x01(\tR\ateacherB\n" +
+	"\n" +
+	"\b_room_id\"\xb4\x02\n" +
+	"\x17UpdateLessonSlotRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\aweekday\x18\x02 \x01(\rH\x00R\aweekday\x88\x01\x01\x12&\n" +
+	"\fstart_minute\x18\x03 \x01(\rH\x01R\vstartMinute\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"end_minute\x18\x04 \x01(\rH\x02R\tendMinute\x88\x01\x01\x12\x1c\n" +
+	"\aroom_id\x18\x05 \x01(\tH\x03R\x06roomId\x88\x01\x01\x12\x1d\n" +
+	"\ateacher\x18\x06 \x01(\tH\x04R\ateacher\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"clear_room\x18\a \x01(\bR\tclearRoomB\n" +
+	"\n" +
+	"\b_weekdayB\x0f\n" +
+	"\r_start_minuteB\r\n" +
+	"\v_end_minuteB\n" +
+	"\n" +
+	"\b_room_idB\n" +
+	"\n" +
+	"\b_teacher\")\n" +
+	"\x17DeleteLessonSlotRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"2\n" +
+	"\x15GetLessonSlotsRequest\x12\x19\n" +
+	"\bclass_id\x18\x01 \x01(\tR\aclassId\"\xdc\x01\n" +
+	"\x1aPostLessonExceptionRequest\x12\x1c\n" +
+	"\aslot_id\x18\x01 \x01(\tH\x00R\x06slotId\x88\x01\x01\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x127\n" +
+	"\tstarts_on\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsOn\x123\n" +
+	"\aends_on\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsOn\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04noteB\n" +
+	"\n" +
+	"\b_slot_id\"x\n" +
+	"\x1aGetLessonExceptionsRequest\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\".\n" +
+	"\x1cDeleteLessonExceptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x8a\x01\n" +
+	"\x11GetLessonsRequest\x12\x19\n" +
+	"\bclass_id\x18\x01 \x01(\tR\aclassId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"^\n" +
+	"\x17GetLessonsInRoomRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12*\n" +
+	"\x02at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\"c\n" +
+	"\x14GetNextLessonRequest\x12\x19\n" +
+	"\bclass_id\x18\x01 \x01(\tR\aclassId\x120\n" +
+	"\x05after\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05after\"\x9a\x01\n" +
+	"\x1fPostEquipmentRequirementRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1b\n" +
+	"\titem_type\x18\x02 \x01(\tR\bitemType\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\rR\bquantity\x12!\n" +
+	"\fper_students\x18\x04 \x01(\rR\vperStudents\">\n" +
+	"\x1fGetEquipmentRequirementsRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\"3\n" +
+	"!DeleteEquipmentRequirementRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"5\n" +
+	"\x18GetEquipmentNeedsRequest\x12\x19\n" +
+	"\bclass_id\x18\x01 \x01(\tR\aclassId\"8\n" +
 	"\x12PostCourseResponse\x12\"\n" +
 	"\x06course\x18\x01 \x01(\v2\n" +
 	".pb.CourseR\x06course\"7\n" +
@@ -1161,25 +4312,98 @@ const file_education_proto_rawDesc = "" +
 	"\x12GetClassesResponse\x12#\n" +
 	"\aclasses\x18\x01 \x03(\v2\t.pb.ClassR\aclasses\"6\n" +
 	"\x13UpdateClassResponse\x12\x1f\n" +
-	"\x05class\x18\x01 \x01(\v2\t.pb.ClassR\x05class\"\x16\n" +
+	"\x05class\x18\x01 \x01(\v2\t.pb.ClassR\x05class\"@\n" +
+	"\x18PostAcademicYearResponse\x12$\n" +
+	"\x04year\x18\x01 \x01(\v2\x10.pb.AcademicYearR\x04year\"B\n" +
+	"\x18GetAcademicYearsResponse\x12&\n" +
+	"\x05years\x18\x01 \x03(\v2\x10.pb.AcademicYearR\x05years\"0\n" +
+	"\x10PostTermResponse\x12\x1c\n" +
+	"\x04term\x18\x01 \x01(\v2\b.pb.TermR\x04term\"2\n" +
+	"\x10GetTermsResponse\x12\x1e\n" +
+	"\x05terms\x18\x01 \x03(\v2\b.pb.TermR\x05terms\"D\n" +
+	"\x16GetCurrentTermResponse\x12!\n" +
+	"\x04term\x18\x01 \x01(\v2\b.pb.TermH\x00R\x04term\x88\x01\x01B\a\n" +
+	"\x05_term\"J\n" +
+	"\x14RolloverYearResponse\x12\x18\n" +
+	"\acourses\x18\x01 \x01(\rR\acourses\x12\x18\n" +
+	"\aclasses\x18\x02 \x01(\rR\aclasses\"<\n" +
+	"\x16PostLessonSlotResponse\x12\"\n" +
+	"\x04slot\x18\x01 \x01(\v2\x0e.pb.LessonSlotR\x04slot\">\n" +
+	"\x18UpdateLessonSlotResponse\x12\"\n" +
+	"\x04slot\x18\x01 \x01(\v2\x0e.pb.LessonSlotR\x04slot\">\n" +
+	"\x16GetLessonSlotsResponse\x12$\n" +
+	"\x05slots\x18\x01 \x03(\v2\x0e.pb.LessonSlotR\x05slots\"P\n" +
+	"\x1bPostLessonExceptionResponse\x121\n" +
+	"\texception\x18\x01 \x01(\v2\x13.pb.LessonExceptionR\texception\"R\n" +
+	"\x1bGetLessonExceptionsResponse\x123\n" +
+	"\n" +
+	"exceptions\x18\x01 \x03(\v2\x13.pb.LessonExceptionR\n" +
+	"exceptions\":\n" +
+	"\x12GetLessonsResponse\x12$\n" +
+	"\alessons\x18\x01 \x03(\v2\n" +
+	".pb.LessonR\alessons\"K\n" +
+	"\x15GetNextLessonResponse\x12'\n" +
+	"\x06lesson\x18\x01 \x01(\v2\n" +
+	".pb.LessonH\x00R\x06lesson\x88\x01\x01B\t\n" +
+	"\a_lesson\"^\n" +
+	" PostEquipmentRequirementResponse\x12:\n" +
+	"\vrequirement\x18\x01 \x01(\v2\x18.pb.EquipmentRequirementR\vrequirement\"`\n" +
+	" GetEquipmentRequirementsResponse\x12<\n" +
+	"\frequirements\x18\x01 \x03(\v2\x18.pb.EquipmentRequirementR\frequirements\"i\n" +
+	"\x19GetEquipmentNeedsResponse\x12#\n" +
+	"\rstudent_count\x18\x01 \x01(\rR\fstudentCount\x12'\n" +
+	"\x05needs\x18\x02 \x03(\v2\x11.pb.EquipmentNeedR\x05needs\"\x16\n" +
 	"\x14DeleteCourseResponse\"\x15\n" +
-	"\x13DeleteClassResponse2\xfa\x05\n" +
+	"\x13DeleteClassResponse\"\x1c\n" +
+	"\x1aDeleteAcademicYearResponse\"\x14\n" +
+	"\x12DeleteTermResponse\"\x1a\n" +
+	"\x18DeleteLessonSlotResponse\"\x1f\n" +
+	"\x1dDeleteLessonExceptionResponse\"$\n" +
+	"\"DeleteEquipmentRequirementResponse2\xd2\x15\n" +
 	"\x10EducationService\x12;\n" +
 	"\n" +
 	"PostCourse\x12\x15.pb.PostCourseRequest\x1a\x16.pb.PostCourseResponse\x128\n" +
-	"\tGetCourse\x12\x14.pb.GetCourseRequest\x1a\x15.pb.GetCourseResponse\x12;\n" +
+	"\tGetCourse\x12\x14.pb.GetCourseRequest\x1a\x15.pb.GetCourseResponse\x12D\n" +
+	"\x0fGetCourseByName\x12\x1a.pb.GetCourseByNameRequest\x1a\x15.pb.GetCourseResponse\x12;\n" +
 	"\n" +
-	"GetCourses\x12\x15.pb.GetCoursesRequest\x1a\x16.pb.GetCoursesResponse\x12A\n" +
+	"GetCourses\x12\x15.pb.GetCoursesRequest\x1a\x16.pb.GetCoursesResponse\x12E\n" +
+	"\x0fGetCoursesByIDs\x12\x1a.pb.GetCoursesByIDsRequest\x1a\x16.pb.GetCoursesResponse\x12A\n" +
 	"\fUpdateCourse\x12\x17.pb.UpdateCourseRequest\x1a\x18.pb.UpdateCourseResponse\x12A\n" +
 	"\fDeleteCourse\x12\x17.pb.DeleteCourseRequest\x1a\x18.pb.DeleteCourseResponse\x12>\n" +
 	"\vLiveCourses\x12\x15.pb.GetCoursesRequest\x1a\x16.pb.GetCoursesResponse0\x01\x128\n" +
 	"\tPostClass\x12\x14.pb.PostClassRequest\x1a\x15.pb.PostClassResponse\x125\n" +
-	"\bGetClass\x12\x13.pb.GetClassRequest\x1a\x14.pb.GetClassResponse\x12;\n" +
+	"\bGetClass\x12\x13.pb.GetClassRequest\x1a\x14.pb.GetClassResponse\x12A\n" +
+	"\x0eGetClassByName\x12\x19.pb.GetClassByNameRequest\x1a\x14.pb.GetClassResponse\x12;\n" +
 	"\n" +
-	"GetClasses\x12\x15.pb.GetClassesRequest\x1a\x16.pb.GetClassesResponse\x12>\n" +
+	"GetClasses\x12\x15.pb.GetClassesRequest\x1a\x16.pb.GetClassesResponse\x12E\n" +
+	"\x0fGetClassesByIDs\x12\x1a.pb.GetClassesByIDsRequest\x1a\x16.pb.GetClassesResponse\x12>\n" +
 	"\vUpdateClass\x12\x16.pb.UpdateClassRequest\x1a\x17.pb.UpdateClassResponse\x12>\n" +
 	"\vDeleteClass\x12\x16.pb.DeleteClassRequest\x1a\x17.pb.DeleteClassResponse\x12>\n" +
-	"\vLiveClasses\x12\x15.pb.GetClassesRequest\x1a\x16.pb.GetClassesResponse0\x01B8Z6github.com/jochem11/inventory-system-back/education/pbb\x06proto3"
+	"\vLiveClasses\x12\x15.pb.GetClassesRequest\x1a\x16.pb.GetClassesResponse0\x01\x12M\n" +
+	"\x10PostAcademicYear\x12\x1b.pb.PostAcademicYearRequest\x1a\x1c.pb.PostAcademicYearResponse\x12M\n" +
+	"\x10GetAcademicYears\x12\x1b.pb.GetAcademicYearsRequest\x1a\x1c.pb.GetAcademicYearsResponse\x12S\n" +
+	"\x12DeleteAcademicYear\x12\x1d.pb.DeleteAcademicYearRequest\x1a\x1e.pb.DeleteAcademicYearResponse\x125\n" +
+	"\bPostTerm\x12\x13.pb.PostTermRequest\x1a\x14.pb.PostTermResponse\x125\n" +
+	"\bGetTerms\x12\x13.pb.GetTermsRequest\x1a\x14.pb.GetTermsResponse\x12G\n" +
+	"\x0eGetCurrentTerm\x12\x19.pb.GetCurrentTermRequest\x1a\x1a.pb.GetCurrentTermResponse\x12;\n" +
+	"\n" +
+	"DeleteTerm\x12\x15.pb.DeleteTermRequest\x1a\x16.pb.DeleteTermResponse\x12A\n" +
+	"\fRolloverYear\x12\x17.pb.RolloverYearRequest\x1a\x18.pb.RolloverYearResponse\x12G\n" +
+	"\x0ePostLessonSlot\x12\x19.pb.PostLessonSlotRequest\x1a\x1a.pb.PostLessonSlotResponse\x12M\n" +
+	"\x10UpdateLessonSlot\x12\x1b.pb.UpdateLessonSlotRequest\x1a\x1c.pb.UpdateLessonSlotResponse\x12M\n" +
+	"\x10DeleteLessonSlot\x12\x1b.pb.DeleteLessonSlotRequest\x1a\x1c.pb.DeleteLessonSlotResponse\x12G\n" +
+	"\x0eGetLessonSlots\x12\x19.pb.GetLessonSlotsRequest\x1a\x1a.pb.GetLessonSlotsResponse\x12V\n" +
+	"\x13PostLessonException\x12\x1e.pb.PostLessonExceptionRequest\x1a\x1f.pb.PostLessonExceptionResponse\x12V\n" +
+	"\x13GetLessonExceptions\x12\x1e.pb.GetLessonExceptionsRequest\x1a\x1f.pb.GetLessonExceptionsResponse\x12\\\n" +
+	"\x15DeleteLessonException\x12 .pb.DeleteLessonExceptionRequest\x1a!.pb.DeleteLessonExceptionResponse\x12;\n" +
+	"\n" +
+	"GetLessons\x12\x15.pb.GetLessonsRequest\x1a\x16.pb.GetLessonsResponse\x12G\n" +
+	"\x10GetLessonsInRoom\x12\x1b.pb.GetLessonsInRoomRequest\x1a\x16.pb.GetLessonsResponse\x12D\n" +
+	"\rGetNextLesson\x12\x18.pb.GetNextLessonRequest\x1a\x19.pb.GetNextLessonResponse\x12e\n" +
+	"\x18PostEquipmentRequirement\x12#.pb.PostEquipmentRequirementRequest\x1a$.pb.PostEquipmentRequirementResponse\x12e\n" +
+	"\x18GetEquipmentRequirements\x12#.pb.GetEquipmentRequirementsRequest\x1a$.pb.GetEquipmentRequirementsResponse\x12k\n" +
+	"\x1aDeleteEquipmentRequirement\x12%.pb.DeleteEquipmentRequirementRequest\x1a&.pb.DeleteEquipmentRequirementResponse\x12P\n" +
+	"\x11GetEquipmentNeeds\x12\x1c.pb.GetEquipmentNeedsRequest\x1a\x1d.pb.GetEquipmentNeedsResponseB8Z6github.com/jochem11/inventory-system-back/education/pbb\x06proto3"
 
 var (
 	file_education_proto_rawDescOnce sync.Once
@@ -1193,75 +4417,225 @@ func file_education_proto_rawDescGZIP() []byte {
 	return file_education_proto_rawDescData
 }
 
-var file_education_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_education_proto_msgTypes = make([]protoimpl.MessageInfo, 76)
 var file_education_proto_goTypes = []any{
-	(*Course)(nil),                // 0: pb.Course
-	(*Class)(nil),                 // 1: pb.Class
-	(*PostCourseRequest)(nil),     // 2: pb.PostCourseRequest
-	(*GetCourseRequest)(nil),      // 3: pb.GetCourseRequest
-	(*GetCoursesRequest)(nil),     // 4: pb.GetCoursesRequest
-	(*UpdateCourseRequest)(nil),   // 5: pb.UpdateCourseRequest
-	(*DeleteCourseRequest)(nil),   // 6: pb.DeleteCourseRequest
-	(*PostClassRequest)(nil),      // 7: pb.PostClassRequest
-	(*GetClassRequest)(nil),       // 8: pb.GetClassRequest
-	(*GetClassesRequest)(nil),     // 9: pb.GetClassesRequest
-	(*UpdateClassRequest)(nil),    // 10: pb.UpdateClassRequest
-	(*DeleteClassRequest)(nil),    // 11: pb.DeleteClassRequest
-	(*PostCourseResponse)(nil),    // 12: pb.PostCourseResponse
-	(*GetCourseResponse)(nil),     // 13: pb.GetCourseResponse
-	(*GetCoursesResponse)(nil),    // 14: pb.GetCoursesResponse
-	(*UpdateCourseResponse)(nil),  // 15: pb.UpdateCourseResponse
-	(*PostClassResponse)(nil),     // 16: pb.PostClassResponse
-	(*GetClassResponse)(nil),      // 17: pb.GetClassResponse
-	(*GetClassesResponse)(nil),    // 18: pb.GetClassesResponse
-	(*UpdateClassResponse)(nil),   // 19: pb.UpdateClassResponse
-	(*DeleteCourseResponse)(nil),  // 20: pb.DeleteCourseResponse
-	(*DeleteClassResponse)(nil),   // 21: pb.DeleteClassResponse
-	(*timestamppb.Timestamp)(nil), // 22: google.protobuf.Timestamp
+	(*AcademicYear)(nil),                       // 0: pb.AcademicYear
+	(*Term)(nil),                               // 1: pb.Term
+	(*Course)(nil),                             // 2: pb.Course
+	(*Class)(nil),                              // 3: pb.Class
+	(*EquipmentRequirement)(nil),               // 4: pb.EquipmentRequirement
+	(*EquipmentNeed)(nil),                      // 5: pb.EquipmentNeed
+	(*LessonSlot)(nil),                         // 6: pb.LessonSlot
+	(*LessonException)(nil),                    // 7: pb.LessonException
+	(*Lesson)(nil),                             // 8: pb.Lesson
+	(*PostCourseRequest)(nil),                  // 9: pb.PostCourseRequest
+	(*GetCourseRequest)(nil),                   // 10: pb.GetCourseRequest
+	(*GetCourseByNameRequest)(nil),             // 11: pb.GetCourseByNameRequest
+	(*GetCoursesByIDsRequest)(nil),             // 12: pb.GetCoursesByIDsRequest
+	(*GetCoursesRequest)(nil),                  // 13: pb.GetCoursesRequest
+	(*UpdateCourseRequest)(nil),                // 14: pb.UpdateCourseRequest
+	(*DeleteCourseRequest)(nil),                // 15: pb.DeleteCourseRequest
+	(*PostClassRequest)(nil),                   // 16: pb.PostClassRequest
+	(*GetClassRequest)(nil),                    // 17: pb.GetClassRequest
+	(*GetClassByNameRequest)(nil),              // 18: pb.GetClassByNameRequest
+	(*GetClassesByIDsRequest)(nil),             // 19: pb.GetClassesByIDsRequest
+	(*GetClassesRequest)(nil),                  // 20: pb.GetClassesRequest
+	(*UpdateClassRequest)(nil),                 // 21: pb.UpdateClassRequest
+	(*DeleteClassRequest)(nil),                 // 22: pb.DeleteClassRequest
+	(*PostAcademicYearRequest)(nil),            // 23: pb.PostAcademicYearRequest
+	(*GetAcademicYearsRequest)(nil),            // 24: pb.GetAcademicYearsRequest
+	(*DeleteAcademicYearRequest)(nil),          // 25: pb.DeleteAcademicYearRequest
+	(*PostTermRequest)(nil),                    // 26: pb.PostTermRequest
+	(*GetTermsRequest)(nil),                    // 27: pb.GetTermsRequest
+	(*GetCurrentTermRequest)(nil),              // 28: pb.GetCurrentTermRequest
+	(*DeleteTermRequest)(nil),                  // 29: pb.DeleteTermRequest
+	(*RolloverYearRequest)(nil),                // 30: pb.RolloverYearRequest
+	(*PostLessonSlotRequest)(nil),              // 31: pb.PostLessonSlotRequest
+	(*UpdateLessonSlotRequest)(nil),            // 32: pb.UpdateLessonSlotRequest
+	(*DeleteLessonSlotRequest)(nil),            // 33: pb.DeleteLessonSlotRequest
+	(*GetLessonSlotsRequest)(nil),              // 34: pb.GetLessonSlotsRequest
+	(*PostLessonExceptionRequest)(nil),         // 35: pb.PostLessonExceptionRequest
+	(*GetLessonExceptionsRequest)(nil),         // 36: pb.GetLessonExceptionsRequest
+	(*DeleteLessonExceptionRequest)(nil),       // 37: pb.DeleteLessonExceptionRequest
+	(*GetLessonsRequest)(nil),                  // 38: pb.GetLessonsRequest
+	(*GetLessonsInRoomRequest)(nil),            // 39: pb.GetLessonsInRoomRequest
+	(*GetNextLessonRequest)(nil),               // 40: pb.GetNextLessonRequest
+	(*PostEquipmentRequirementRequest)(nil),    // 41: pb.PostEquipmentRequirementRequest
+	(*GetEquipmentRequirementsRequest)(nil),    // 42: pb.GetEquipmentRequirementsRequest
+	(*DeleteEquipmentRequirementRequest)(nil),  // 43: pb.DeleteEquipmentRequirementRequest
+	(*GetEquipmentNeedsRequest)(nil),           // 44: pb.GetEquipmentNeedsRequest
+	(*PostCourseResponse)(nil),                 // 45: pb.PostCourseResponse
+	(*GetCourseResponse)(nil),                  // 46: pb.GetCourseResponse
+	(*GetCoursesResponse)(nil),                 // 47: pb.GetCoursesResponse
+	(*UpdateCourseResponse)(nil),               // 48: pb.UpdateCourseResponse
+	(*PostClassResponse)(nil),                  // 49: pb.PostClassResponse
+	(*GetClassResponse)(nil),                   // 50: pb.GetClassResponse
+	(*GetClassesResponse)(nil),                 // 51: pb.GetClassesResponse
+	(*UpdateClassResponse)(nil),                // 52: pb.UpdateClassResponse
+	(*PostAcademicYearResponse)(nil),           // 53: pb.PostAcademicYearResponse
+	(*GetAcademicYearsResponse)(nil),           // 54: pb.GetAcademicYearsResponse
+	(*PostTermResponse)(nil),                   // 55: pb.PostTermResponse
+	(*GetTermsResponse)(nil),                   // 56: pb.GetTermsResponse
+	(*GetCurrentTermResponse)(nil),             // 57: pb.GetCurrentTermResponse
+	(*RolloverYearResponse)(nil),               // 58: pb.RolloverYearResponse
+	(*PostLessonSlotResponse)(nil),             // 59: pb.PostLessonSlotResponse
+	(*UpdateLessonSlotResponse)(nil),           // 60: pb.UpdateLessonSlotResponse
+	(*GetLessonSlotsResponse)(nil),             // 61: pb.GetLessonSlotsResponse
+	(*PostLessonExceptionResponse)(nil),        // 62: pb.PostLessonExceptionResponse
+	(*GetLessonExceptionsResponse)(nil),        // 63: pb.GetLessonExceptionsResponse
+	(*GetLessonsResponse)(nil),                 // 64: pb.GetLessonsResponse
+	(*GetNextLessonResponse)(nil),              // 65: pb.GetNextLessonResponse
+	(*PostEquipmentRequirementResponse)(nil),   // 66: pb.PostEquipmentRequirementResponse
+	(*GetEquipmentRequirementsResponse)(nil),   // 67: pb.GetEquipmentRequirementsResponse
+	(*GetEquipmentNeedsResponse)(nil),          // 68: pb.GetEquipmentNeedsResponse
+	(*DeleteCourseResponse)(nil),               // 69: pb.DeleteCourseResponse
+	(*DeleteClassResponse)(nil),                // 70: pb.DeleteClassResponse
+	(*DeleteAcademicYearResponse)(nil),         // 71: pb.DeleteAcademicYearResponse
+	(*DeleteTermResponse)(nil),                 // 72: pb.DeleteTermResponse
+	(*DeleteLessonSlotResponse)(nil),           // 73: pb.DeleteLessonSlotResponse
+	(*DeleteLessonExceptionResponse)(nil),      // 74: pb.DeleteLessonExceptionResponse
+	(*DeleteEquipmentRequirementResponse)(nil), // 75: pb.DeleteEquipmentRequirementResponse
+	(*timestamppb.Timestamp)(nil),              // 76: google.protobuf.Timestamp
 }
 var file_education_proto_depIdxs = []int32{
-	22, // 0: pb.Course.created_at:type_name -> google.protobuf.Timestamp
-	22, // 1: pb.Course.updated_at:type_name -> google.protobuf.Timestamp
-	22, // 2: pb.Class.created_at:type_name -> google.protobuf.Timestamp
-	22, // 3: pb.Class.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 4: pb.Class.course:type_name -> pb.Course
-	0,  // 5: pb.PostCourseResponse.course:type_name -> pb.Course
-	0,  // 6: pb.GetCourseResponse.course:type_name -> pb.Course
-	0,  // 7: pb.GetCoursesResponse.courses:type_name -> pb.Course
-	0,  // 8: pb.UpdateCourseResponse.course:type_name -> pb.Course
-	1,  // 9: pb.PostClassResponse.class:type_name -> pb.Class
-	1,  // 10: pb.GetClassResponse.class:type_name -> pb.Class
-	1,  // 11: pb.GetClassesResponse.classes:type_name -> pb.Class
-	1,  // 12: pb.UpdateClassResponse.class:type_name -> pb.Class
-	2,  // 13: pb.EducationService.PostCourse:input_type -> pb.PostCourseRequest
-	3,  // 14: pb.EducationService.GetCourse:input_type -> pb.GetCourseRequest
-	4,  // 15: pb.EducationService.GetCourses:input_type -> pb.GetCoursesRequest
-	5,  // 16: pb.EducationService.UpdateCourse:input_type -> pb.UpdateCourseRequest
-	6,  // 17: pb.EducationService.DeleteCourse:input_type -> pb.DeleteCourseRequest
-	4,  // 18: pb.EducationService.LiveCourses:input_type -> pb.GetCoursesRequest
-	7,  // 19: pb.EducationService.PostClass:input_type -> pb.PostClassRequest
-	8,  // 20: pb.EducationService.GetClass:input_type -> pb.GetClassRequest
-	9,  // 21: pb.EducationService.GetClasses:input_type -> pb.GetClassesRequest
-	10, // 22: pb.EducationService.UpdateClass:input_type -> pb.UpdateClassRequest
-	11, // 23: pb.EducationService.DeleteClass:input_type -> pb.DeleteClassRequest
-	9,  // 24: pb.EducationService.LiveClasses:input_type -> pb.GetClassesRequest
-	12, // 25: pb.EducationService.PostCourse:output_type -> pb.PostCourseResponse
-	13, // 26: pb.EducationService.GetCourse:output_type -> pb.GetCourseResponse
-	14, // 27: pb.EducationService.GetCourses:output_type -> pb.GetCoursesResponse
-	15, // 28: pb.EducationService.UpdateCourse:output_type -> pb.UpdateCourseResponse
-	20, // 29: pb.EducationService.DeleteCourse:output_type -> pb.DeleteCourseResponse
-	14, // 30: pb.EducationService.LiveCourses:output_type -> pb.GetCoursesResponse
-	16, // 31: pb.EducationService.PostClass:output_type -> pb.PostClassResponse
-	17, // 32: pb.EducationService.GetClass:output_type -> pb.GetClassResponse
-	18, // 33: pb.EducationService.GetClasses:output_type -> pb.GetClassesResponse
-	19, // 34: pb.EducationService.UpdateClass:output_type -> pb.UpdateClassResponse
-	21, // 35: pb.EducationService.DeleteClass:output_type -> pb.DeleteClassResponse
-	18, // 36: pb.EducationService.LiveClasses:output_type -> pb.GetClassesResponse
-	25, // [25:37] is the sub-list for method output_type
-	13, // [13:25] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	76, // 0: pb.AcademicYear.starts_on:type_name -> google.protobuf.Timestamp
+	76, // 1: pb.AcademicYear.ends_on:type_name -> google.protobuf.Timestamp
+	76, // 2: pb.AcademicYear.created_at:type_name -> google.protobuf.Timestamp
+	76, // 3: pb.AcademicYear.updated_at:type_name -> google.protobuf.Timestamp
+	76, // 4: pb.Term.starts_on:type_name -> google.protobuf.Timestamp
+	76, // 5: pb.Term.ends_on:type_name -> google.protobuf.Timestamp
+	76, // 6: pb.Term.created_at:type_name -> google.protobuf.Timestamp
+	76, // 7: pb.Term.updated_at:type_name -> google.protobuf.Timestamp
+	76, // 8: pb.Course.created_at:type_name -> google.protobuf.Timestamp
+	76, // 9: pb.Course.updated_at:type_name -> google.protobuf.Timestamp
+	76, // 10: pb.Class.created_at:type_name -> google.protobuf.Timestamp
+	76, // 11: pb.Class.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 12: pb.Class.course:type_name -> pb.Course
+	76, // 13: pb.EquipmentRequirement.created_at:type_name -> google.protobuf.Timestamp
+	76, // 14: pb.EquipmentRequirement.updated_at:type_name -> google.protobuf.Timestamp
+	76, // 15: pb.LessonSlot.created_at:type_name -> google.protobuf.Timestamp
+	76, // 16: pb.LessonSlot.updated_at:type_name -> google.protobuf.Timestamp
+	76, // 17: pb.LessonException.starts_on:type_name -> google.protobuf.Timestamp
+	76, // 18: pb.LessonException.ends_on:type_name -> google.protobuf.Timestamp
+	76, // 19: pb.LessonException.created_at:type_name -> google.protobuf.Timestamp
+	76, // 20: pb.Lesson.starts_at:type_name -> google.protobuf.Timestamp
+	76, // 21: pb.Lesson.ends_at:type_name -> google.protobuf.Timestamp
+	76, // 22: pb.PostAcademicYearRequest.starts_on:type_name -> google.protobuf.Timestamp
+	76, // 23: pb.PostAcademicYearRequest.ends_on:type_name -> google.protobuf.Timestamp
+	76, // 24: pb.PostTermRequest.starts_on:type_name -> google.protobuf.Timestamp
+	76, // 25: pb.PostTermRequest.ends_on:type_name -> google.protobuf.Timestamp
+	76, // 26: pb.PostLessonExceptionRequest.starts_on:type_name -> google.protobuf.Timestamp
+	76, // 27: pb.PostLessonExceptionRequest.ends_on:type_name -> google.protobuf.Timestamp
+	76, // 28: pb.GetLessonExceptionsRequest.from:type_name -> google.protobuf.Timestamp
+	76, // 29: pb.GetLessonExceptionsRequest.to:type_name -> google.protobuf.Timestamp
+	76, // 30: pb.GetLessonsRequest.from:type_name -> google.protobuf.Timestamp
+	76, // 31: pb.GetLessonsRequest.to:type_name -> google.protobuf.Timestamp
+	76, // 32: pb.GetLessonsInRoomRequest.at:type_name -> google.protobuf.Timestamp
+	76, // 33: pb.GetNextLessonRequest.after:type_name -> google.protobuf.Timestamp
+	2,  // 34: pb.PostCourseResponse.course:type_name -> pb.Course
+	2,  // 35: pb.GetCourseResponse.course:type_name -> pb.Course
+	2,  // 36: pb.GetCoursesResponse.courses:type_name -> pb.Course
+	2,  // 37: pb.UpdateCourseResponse.course:type_name -> pb.Course
+	3,  // 38: pb.PostClassResponse.class:type_name -> pb.Class
+	3,  // 39: pb.GetClassResponse.class:type_name -> pb.Class
+	3,  // 40: pb.GetClassesResponse.classes:type_name -> pb.Class
+	3,  // 41: pb.UpdateClassResponse.class:type_name -> pb.Class
+	0,  // 42: pb.PostAcademicYearResponse.year:type_name -> pb.AcademicYear
+	0,  // 43: pb.GetAcademicYearsResponse.years:type_name -> pb.AcademicYear
+	1,  // 44: pb.PostTermResponse.term:type_name -> pb.Term
+	1,  // 45: pb.GetTermsResponse.terms:type_name -> pb.Term
+	1,  // 46: pb.GetCurrentTermResponse.term:type_name -> pb.Term
+	6,  // 47: pb.PostLessonSlotResponse.slot:type_name -> pb.LessonSlot
+	6,  // 48: pb.UpdateLessonSlotResponse.slot:type_name -> pb.LessonSlot
+	6,  // 49: pb.GetLessonSlotsResponse.slots:type_name -> pb.LessonSlot
+	7,  // 50: pb.PostLessonExceptionResponse.exception:type_name -> pb.LessonException
+	7,  // 51: pb.GetLessonExceptionsResponse.exceptions:type_name -> pb.LessonException
+	8,  // 52: pb.GetLessonsResponse.lessons:type_name -> pb.Lesson
+	8,  // 53: pb.GetNextLessonResponse.lesson:type_name -> pb.Lesson
+	4,  // 54: pb.PostEquipmentRequirementResponse.requirement:type_name -> pb.EquipmentRequirement
+	4,  // 55: pb.GetEquipmentRequirementsResponse.requirements:type_name -> pb.EquipmentRequirement
+	5,  // 56: pb.GetEquipmentNeedsResponse.needs:type_name -> pb.EquipmentNeed
+	9,  // 57: pb.EducationService.PostCourse:input_type -> pb.PostCourseRequest
+	10, // 58: pb.EducationService.GetCourse:input_type -> pb.GetCourseRequest
+	11, // 59: pb.EducationService.GetCourseByName:input_type -> pb.GetCourseByNameRequest
+	13, // 60: pb.EducationService.GetCourses:input_type -> pb.GetCoursesRequest
+	12, // 61: pb.EducationService.GetCoursesByIDs:input_type -> pb.GetCoursesByIDsRequest
+	14, // 62: pb.EducationService.UpdateCourse:input_type -> pb.UpdateCourseRequest
+	15, // 63: pb.EducationService.DeleteCourse:input_type -> pb.DeleteCourseRequest
+	13, // 64: pb.EducationService.LiveCourses:input_type -> pb.GetCoursesRequest
+	16, // 65: pb.EducationService.PostClass:input_type -> pb.PostClassRequest
+	17, // 66: pb.EducationService.GetClass:input_type -> pb.GetClassRequest
+	18, // 67: pb.EducationService.GetClassByName:input_type -> pb.GetClassByNameRequest
+	20, // 68: pb.EducationService.GetClasses:input_type -> pb.GetClassesRequest
+	19, // 69: pb.EducationService.GetClassesByIDs:input_type -> pb.GetClassesByIDsRequest
+	21, // 70: pb.EducationService.UpdateClass:input_type -> pb.UpdateClassRequest
+	22, // 71: pb.EducationService.DeleteClass:input_type -> pb.DeleteClassRequest
+	20, // 72: pb.EducationService.LiveClasses:input_type -> pb.GetClassesRequest
+	23, // 73: pb.EducationService.PostAcademicYear:input_type -> pb.PostAcademicYearRequest
+	24, // 74: pb.EducationService.GetAcademicYears:input_type -> pb.GetAcademicYearsRequest
+	25, // 75: pb.EducationService.DeleteAcademicYear:input_type -> pb.DeleteAcademicYearRequest
+	26, // 76: pb.EducationService.PostTerm:input_type -> pb.PostTermRequest
+	27, // 77: pb.EducationService.GetTerms:input_type -> pb.GetTermsRequest
+	28, // 78: pb.EducationService.GetCurrentTerm:input_type -> pb.GetCurrentTermRequest
+	29, // 79: pb.EducationService.DeleteTerm:input_type -> pb.DeleteTermRequest
+	30, // 80: pb.EducationService.RolloverYear:input_type -> pb.RolloverYearRequest
+	31, // 81: pb.EducationService.PostLessonSlot:input_type -> pb.PostLessonSlotRequest
+	32, // 82: pb.EducationService.UpdateLessonSlot:input_type -> pb.UpdateLessonSlotRequest
+	33, // 83: pb.EducationService.DeleteLessonSlot:input_type -> pb.DeleteLessonSlotRequest
+	34, // 84: pb.EducationService.GetLessonSlots:input_type -> pb.GetLessonSlotsRequest
+	35, // 85: pb.EducationService.PostLessonException:input_type -> pb.PostLessonExceptionRequest
+	36, // 86: pb.EducationService.GetLessonExceptions:input_type -> pb.GetLessonExceptionsRequest
+	37, // 87: pb.EducationService.DeleteLessonException:input_type -> pb.DeleteLessonExceptionRequest
+	38, // 88: pb.EducationService.GetLessons:input_type -> pb.GetLessonsRequest
+	39, // 89: pb.EducationService.GetLessonsInRoom:input_type -> pb.GetLessonsInRoomRequest
+	40, // 90: pb.EducationService.GetNextLesson:input_type -> pb.GetNextLessonRequest
+	41, // 91: pb.EducationService.PostEquipmentRequirement:input_type -> pb.PostEquipmentRequirementRequest
+	42, // 92: pb.EducationService.GetEquipmentRequirements:input_type -> pb.GetEquipmentRequirementsRequest
+	43, // 93: pb.EducationService.DeleteEquipmentRequirement:input_type -> pb.DeleteEquipmentRequirementRequest
+	44, // 94: pb.EducationService.GetEquipmentNeeds:input_type -> pb.GetEquipmentNeedsRequest
+	45, // 95: pb.EducationService.PostCourse:output_type -> pb.PostCourseResponse
+	46, // 96: pb.EducationService.GetCourse:output_type -> pb.GetCourseResponse
+	46, // 97: pb.EducationService.GetCourseByName:output_type -> pb.GetCourseResponse
+	47, // 98: pb.EducationService.GetCourses:output_type -> pb.GetCoursesResponse
+	47, // 99: pb.EducationService.GetCoursesByIDs:output_type -> pb.GetCoursesResponse
+	48, // 100: pb.EducationService.UpdateCourse:output_type -> pb.UpdateCourseResponse
+	69, // 101: pb.EducationService.DeleteCourse:output_type -> pb.DeleteCourseResponse
+	47, // 102: pb.EducationService.LiveCourses:output_type -> pb.GetCoursesResponse
+	49, // 103: pb.EducationService.PostClass:output_type -> pb.PostClassResponse
+	50, // 104: pb.EducationService.GetClass:output_type -> pb.GetClassResponse
+	50, // 105: pb.EducationService.GetClassByName:output_type -> pb.GetClassResponse
+	51, // 106: pb.EducationService.GetClasses:output_type -> pb.GetClassesResponse
+	51, // 107: pb.EducationService.GetClassesByIDs:output_type -> pb.GetClassesResponse
+	52, // 108: pb.EducationService.UpdateClass:output_type -> pb.UpdateClassResponse
+	70, // 109: pb.EducationService.DeleteClass:output_type -> pb.DeleteClassResponse
+	51, // 110: pb.EducationService.LiveClasses:output_type -> pb.GetClassesResponse
+	53, // 111: pb.EducationService.PostAcademicYear:output_type -> pb.PostAcademicYearResponse
+	54, // 112: pb.EducationService.GetAcademicYears:output_type -> pb.GetAcademicYearsResponse
+	71, // 113: pb.EducationService.DeleteAcademicYear:output_type -> pb.DeleteAcademicYearResponse
+	55, // 114: pb.EducationService.PostTerm:output_type -> pb.PostTermResponse
+	56, // 115: pb.EducationService.GetTerms:output_type -> pb.GetTermsResponse
+	57, // 116: pb.EducationService.GetCurrentTerm:output_type -> pb.GetCurrentTermResponse
+	72, // 117: pb.EducationService.DeleteTerm:output_type -> pb.DeleteTermResponse
+	58, // 118: pb.EducationService.RolloverYear:output_type -> pb.RolloverYearResponse
+	59, // 119: pb.EducationService.PostLessonSlot:output_type -> pb.PostLessonSlotResponse
+	60, // 120: pb.EducationService.UpdateLessonSlot:output_type -> pb.UpdateLessonSlotResponse
+	73, // 121: pb.EducationService.DeleteLessonSlot:output_type -> pb.DeleteLessonSlotResponse
+	61, // 122: pb.EducationService.GetLessonSlots:output_type -> pb.GetLessonSlotsResponse
+	62, // 123: pb.EducationService.PostLessonException:output_type -> pb.PostLessonExceptionResponse
+	63, // 124: pb.EducationService.GetLessonExceptions:output_type -> pb.GetLessonExceptionsResponse
+	74, // 125: pb.EducationService.DeleteLessonException:output_type -> pb.DeleteLessonExceptionResponse
+	64, // 126: pb.EducationService.GetLessons:output_type -> pb.GetLessonsResponse
+	64, // 127: pb.EducationService.GetLessonsInRoom:output_type -> pb.GetLessonsResponse
+	65, // 128: pb.EducationService.GetNextLesson:output_type -> pb.GetNextLessonResponse
+	66, // 129: pb.EducationService.PostEquipmentRequirement:output_type -> pb.PostEquipmentRequirementResponse
+	67, // 130: pb.EducationService.GetEquipmentRequirements:output_type -> pb.GetEquipmentRequirementsResponse
+	75, // 131: pb.EducationService.DeleteEquipmentRequirement:output_type -> pb.DeleteEquipmentRequirementResponse
+	68, // 132: pb.EducationService.GetEquipmentNeeds:output_type -> pb.GetEquipmentNeedsResponse
+	95, // [95:133] is the sub-list for method output_type
+	57, // [57:95] is the sub-list for method input_type
+	57, // [57:57] is the sub-list for extension type_name
+	57, // [57:57] is the sub-list for extension extendee
+	0,  // [0:57] is the sub-list for field type_name
 }
 
 func init() { file_education_proto_init() }
@@ -1269,16 +4643,29 @@ func file_education_proto_init() {
 	if File_education_proto != nil {
 		return
 	}
-	file_education_proto_msgTypes[1].OneofWrappers = []any{}
-	file_education_proto_msgTypes[5].OneofWrappers = []any{}
-	file_education_proto_msgTypes[10].OneofWrappers = []any{}
+	file_education_proto_msgTypes[2].OneofWrappers = []any{}
+	file_education_proto_msgTypes[3].OneofWrappers = []any{}
+	file_education_proto_msgTypes[6].OneofWrappers = []any{}
+	file_education_proto_msgTypes[7].OneofWrappers = []any{}
+	file_education_proto_msgTypes[8].OneofWrappers = []any{}
+	file_education_proto_msgTypes[9].OneofWrappers = []any{}
+	file_education_proto_msgTypes[13].OneofWrappers = []any{}
+	file_education_proto_msgTypes[14].OneofWrappers = []any{}
+	file_education_proto_msgTypes[16].OneofWrappers = []any{}
+	file_education_proto_msgTypes[20].OneofWrappers = []any{}
+	file_education_proto_msgTypes[21].OneofWrappers = []any{}
+	file_education_proto_msgTypes[31].OneofWrappers = []any{}
+	file_education_proto_msgTypes[32].OneofWrappers = []any{}
+	file_education_proto_msgTypes[35].OneofWrappers = []any{}
+	file_education_proto_msgTypes[57].OneofWrappers = []any{}
+	file_education_proto_msgTypes[65].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_education_proto_rawDesc), len(file_education_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   76,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
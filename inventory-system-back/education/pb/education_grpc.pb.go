@@ -19,18 +19,44 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	EducationService_PostCourse_FullMethodName   = "/pb.EducationService/PostCourse"
-	EducationService_GetCourse_FullMethodName    = "/pb.EducationService/GetCourse"
-	EducationService_GetCourses_FullMethodName   = "/pb.EducationService/GetCourses"
-	EducationService_UpdateCourse_FullMethodName = "/pb.EducationService/UpdateCourse"
-	EducationService_DeleteCourse_FullMethodName = "/pb.EducationService/DeleteCourse"
-	EducationService_LiveCourses_FullMethodName  = "/pb.EducationService/LiveCourses"
-	EducationService_PostClass_FullMethodName    = "/pb.EducationService/PostClass"
-	EducationService_GetClass_FullMethodName     = "/pb.EducationService/GetClass"
-	EducationService_GetClasses_FullMethodName   = "/pb.EducationService/GetClasses"
-	EducationService_UpdateClass_FullMethodName  = "/pb.EducationService/UpdateClass"
-	EducationService_DeleteClass_FullMethodName  = "/pb.EducationService/DeleteClass"
-	EducationService_LiveClasses_FullMethodName  = "/pb.EducationService/LiveClasses"
+	EducationService_PostCourse_FullMethodName                 = "/pb.EducationService/PostCourse"
+	EducationService_GetCourse_FullMethodName                  = "/pb.EducationService/GetCourse"
+	EducationService_GetCourseByName_FullMethodName            = "/pb.EducationService/GetCourseByName"
+	EducationService_GetCourses_FullMethodName                 = "/pb.EducationService/GetCourses"
+	EducationService_GetCoursesByIDs_FullMethodName            = "/pb.EducationService/GetCoursesByIDs"
+	EducationService_UpdateCourse_FullMethodName               = "/pb.EducationService/UpdateCourse"
+	EducationService_DeleteCourse_FullMethodName               = "/pb.EducationService/DeleteCourse"
+	EducationService_LiveCourses_FullMethodName                = "/pb.EducationService/LiveCourses"
+	EducationService_PostClass_FullMethodName                  = "/pb.EducationService/PostClass"
+	EducationService_GetClass_FullMethodName                   = "/pb.EducationService/GetClass"
+	EducationService_GetClassByName_FullMethodName             = "/pb.EducationService/GetClassByName"
+	EducationService_GetClasses_FullMethodName                 = "/pb.EducationService/GetClasses"
+	EducationService_GetClassesByIDs_FullMethodName            = "/pb.EducationService/GetClassesByIDs"
+	EducationService_UpdateClass_FullMethodName                = "/pb.EducationService/UpdateClass"
+	EducationService_DeleteClass_FullMethodName                = "/pb.EducationService/DeleteClass"
+	EducationService_LiveClasses_FullMethodName                = "/pb.EducationService/LiveClasses"
+	EducationService_PostAcademicYear_FullMethodName           = "/pb.EducationService/PostAcademicYear"
+	EducationService_GetAcademicYears_FullMethodName           = "/pb.EducationService/GetAcademicYears"
+	EducationService_DeleteAcademicYear_FullMethodName         = "/pb.EducationService/DeleteAcademicYear"
+	EducationService_PostTerm_FullMethodName                   = "/pb.EducationService/PostTerm"
+	EducationService_GetTerms_FullMethodName                   = "/pb.EducationService/GetTerms"
+	EducationService_GetCurrentTerm_FullMethodName             = "/pb.EducationService/GetCurrentTerm"
+	EducationService_DeleteTerm_FullMethodName                 = "/pb.EducationService/DeleteTerm"
+	EducationService_RolloverYear_FullMethodName               = "/pb.EducationService/RolloverYear"
+	EducationService_PostLessonSlot_FullMethodName             = "/pb.EducationService/PostLessonSlot"
+	EducationService_UpdateLessonSlot_FullMethodName           = "/pb.EducationService/UpdateLessonSlot"
+	EducationService_DeleteLessonSlot_FullMethodName           = "/pb.EducationService/DeleteLessonSlot"
+	EducationService_GetLessonSlots_FullMethodName             = "/pb.EducationService/GetLessonSlots"
+	EducationService_PostLessonException_FullMethodName        = "/pb.EducationService/PostLessonException"
+	EducationService_GetLessonExceptions_FullMethodName        = "/pb.EducationService/GetLessonExceptions"
+	EducationService_DeleteLessonException_FullMethodName      = "/pb.EducationService/DeleteLessonException"
+	EducationService_GetLessons_FullMethodName                 = "/pb.EducationService/GetLessons"
+	EducationService_GetLessonsInRoom_FullMethodName           = "/pb.EducationService/GetLessonsInRoom"
+	EducationService_GetNextLesson_FullMethodName              = "/pb.EducationService/GetNextLesson"
+	EducationService_PostEquipmentRequirement_FullMethodName   = "/pb.EducationService/PostEquipmentRequirement"
+	EducationService_GetEquipmentRequirements_FullMethodName   = "/pb.EducationService/GetEquipmentRequirements"
+	EducationService_DeleteEquipmentRequirement_FullMethodName = "/pb.EducationService/DeleteEquipmentRequirement"
+	EducationService_GetEquipmentNeeds_FullMethodName          = "/pb.EducationService/GetEquipmentNeeds"
 )
 
 // EducationServiceClient is the client API for EducationService service.
@@ -42,17 +68,46 @@ type EducationServiceClient interface {
 	// Course methods
 	PostCourse(ctx context.Context, in *PostCourseRequest, opts ...grpc.CallOption) (*PostCourseResponse, error)
 	GetCourse(ctx context.Context, in *GetCourseRequest, opts ...grpc.CallOption) (*GetCourseResponse, error)
+	GetCourseByName(ctx context.Context, in *GetCourseByNameRequest, opts ...grpc.CallOption) (*GetCourseResponse, error)
 	GetCourses(ctx context.Context, in *GetCoursesRequest, opts ...grpc.CallOption) (*GetCoursesResponse, error)
+	GetCoursesByIDs(ctx context.Context, in *GetCoursesByIDsRequest, opts ...grpc.CallOption) (*GetCoursesResponse, error)
 	UpdateCourse(ctx context.Context, in *UpdateCourseRequest, opts ...grpc.CallOption) (*UpdateCourseResponse, error)
 	DeleteCourse(ctx context.Context, in *DeleteCourseRequest, opts ...grpc.CallOption) (*DeleteCourseResponse, error)
 	LiveCourses(ctx context.Context, in *GetCoursesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetCoursesResponse], error)
 	// Class methods
 	PostClass(ctx context.Context, in *PostClassRequest, opts ...grpc.CallOption) (*PostClassResponse, error)
 	GetClass(ctx context.Context, in *GetClassRequest, opts ...grpc.CallOption) (*GetClassResponse, error)
+	GetClassByName(ctx context.Context, in *GetClassByNameRequest, opts ...grpc.CallOption) (*GetClassResponse, error)
 	GetClasses(ctx context.Context, in *GetClassesRequest, opts ...grpc.CallOption) (*GetClassesResponse, error)
+	GetClassesByIDs(ctx context.Context, in *GetClassesByIDsRequest, opts ...grpc.CallOption) (*GetClassesResponse, error)
 	UpdateClass(ctx context.Context, in *UpdateClassRequest, opts ...grpc.CallOption) (*UpdateClassResponse, error)
 	DeleteClass(ctx context.Context, in *DeleteClassRequest, opts ...grpc.CallOption) (*DeleteClassResponse, error)
 	LiveClasses(ctx context.Context, in *GetClassesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetClassesResponse], error)
+	// Academic year and term methods
+	PostAcademicYear(ctx context.Context, in *PostAcademicYearRequest, opts ...grpc.CallOption) (*PostAcademicYearResponse, error)
+	GetAcademicYears(ctx context.Context, in *GetAcademicYearsRequest, opts ...grpc.CallOption) (*GetAcademicYearsResponse, error)
+	DeleteAcademicYear(ctx context.Context, in *DeleteAcademicYearRequest, opts ...grpc.CallOption) (*DeleteAcademicYearResponse, error)
+	PostTerm(ctx context.Context, in *PostTermRequest, opts ...grpc.CallOption) (*PostTermResponse, error)
+	GetTerms(ctx context.Context, in *GetTermsRequest, opts ...grpc.CallOption) (*GetTermsResponse, error)
+	GetCurrentTerm(ctx context.Context, in *GetCurrentTermRequest, opts ...grpc.CallOption) (*GetCurrentTermResponse, error)
+	DeleteTerm(ctx context.Context, in *DeleteTermRequest, opts ...grpc.CallOption) (*DeleteTermResponse, error)
+	RolloverYear(ctx context.Context, in *RolloverYearRequest, opts ...grpc.CallOption) (*RolloverYearResponse, error)
+	// Timetable methods
+	PostLessonSlot(ctx context.Context, in *PostLessonSlotRequest, opts ...grpc.CallOption) (*PostLessonSlotResponse, error)
+	UpdateLessonSlot(ctx context.Context, in *UpdateLessonSlotRequest, opts ...grpc.CallOption) (*UpdateLessonSlotResponse, error)
+	DeleteLessonSlot(ctx context.Context, in *DeleteLessonSlotRequest, opts ...grpc.CallOption) (*DeleteLessonSlotResponse, error)
+	GetLessonSlots(ctx context.Context, in *GetLessonSlotsRequest, opts ...grpc.CallOption) (*GetLessonSlotsResponse, error)
+	PostLessonException(ctx context.Context, in *PostLessonExceptionRequest, opts ...grpc.CallOption) (*PostLessonExceptionResponse, error)
+	GetLessonExceptions(ctx context.Context, in *GetLessonExceptionsRequest, opts ...grpc.CallOption) (*GetLessonExceptionsResponse, error)
+	DeleteLessonException(ctx context.Context, in *DeleteLessonExceptionRequest, opts ...grpc.CallOption) (*DeleteLessonExceptionResponse, error)
+	GetLessons(ctx context.Context, in *GetLessonsRequest, opts ...grpc.CallOption) (*GetLessonsResponse, error)
+	GetLessonsInRoom(ctx context.Context, in *GetLessonsInRoomRequest, opts ...grpc.CallOption) (*GetLessonsResponse, error)
+	GetNextLesson(ctx context.Context, in *GetNextLessonRequest, opts ...grpc.CallOption) (*GetNextLessonResponse, error)
+	// Equipment methods
+	PostEquipmentRequirement(ctx context.Context, in *PostEquipmentRequirementRequest, opts ...grpc.CallOption) (*PostEquipmentRequirementResponse, error)
+	GetEquipmentRequirements(ctx context.Context, in *GetEquipmentRequirementsRequest, opts ...grpc.CallOption) (*GetEquipmentRequirementsResponse, error)
+	DeleteEquipmentRequirement(ctx context.Context, in *DeleteEquipmentRequirementRequest, opts ...grpc.CallOption) (*DeleteEquipmentRequirementResponse, error)
+	GetEquipmentNeeds(ctx context.Context, in *GetEquipmentNeedsRequest, opts ...grpc.CallOption) (*GetEquipmentNeedsResponse, error)
 }
 
 type educationServiceClient struct {
@@ -83,6 +138,16 @@ func (c *educationServiceClient) GetCourse(ctx context.Context, in *GetCourseReq
 	return out, nil
 }
 
+func (c *educationServiceClient) GetCourseByName(ctx context.Context, in *GetCourseByNameRequest, opts ...grpc.CallOption) (*GetCourseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCourseResponse)
+	err := c.cc.Invoke(ctx, EducationService_GetCourseByName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *educationServiceClient) GetCourses(ctx context.Context, in *GetCoursesRequest, opts ...grpc.CallOption) (*GetCoursesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetCoursesResponse)
@@ -93,6 +158,16 @@ func (c *educationServiceClient) GetCourses(ctx context.Context, in *GetCoursesR
 	return out, nil
 }
 
+func (c *educationServiceClient) GetCoursesByIDs(ctx context.Context, in *GetCoursesByIDsRequest, opts ...grpc.CallOption) (*GetCoursesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCoursesResponse)
+	err := c.cc.Invoke(ctx, EducationService_GetCoursesByIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *educationServiceClient) UpdateCourse(ctx context.Context, in *UpdateCourseRequest, opts ...grpc.CallOption) (*UpdateCourseResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateCourseResponse)
@@ -152,6 +227,16 @@ func (c *educationServiceClient) GetClass(ctx context.Context, in *GetClassReque
 	return out, nil
 }
 
+func (c *educationServiceClient) GetClassByName(ctx context.Context, in *GetClassByNameRequest, opts ...grpc.CallOption) (*GetClassResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetClassResponse)
+	err := c.cc.Invoke(ctx, EducationService_GetClassByName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *educationServiceClient) GetClasses(ctx context.Context, in *GetClassesRequest, opts ...grpc.CallOption) (*GetClassesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetClassesResponse)
@@ -162,6 +247,16 @@ func (c *educationServiceClient) GetClasses(ctx context.Context, in *GetClassesR
 	return out, nil
 }
 
+func (c *educationServiceClient) GetClassesByIDs(ctx context.Context, in *GetClassesByIDsRequest, opts ...grpc.CallOption) (*GetClassesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetClassesResponse)
+	err := c.cc.Invoke(ctx, EducationService_GetClassesByIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *educationServiceClient) UpdateClass(ctx context.Context, in *UpdateClassRequest, opts ...grpc.CallOption) (*UpdateClassResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateClassResponse)
@@ -201,6 +296,226 @@ func (c *educationServiceClient) LiveClasses(ctx context.Context, in *GetClasses
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type EducationService_LiveClassesClient = grpc.ServerStreamingClient[GetClassesResponse]
 
+func (c *educationServiceClient) PostAcademicYear(ctx context.Context, in *PostAcademicYearRequest, opts ...grpc.CallOption) (*PostAcademicYearResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PostAcademicYearResponse)
+	err := c.cc.Invoke(ctx, EducationService_PostAcademicYear_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *educationServiceClient) GetAcademicYears(ctx context.Context, in *GetAcademicYearsRequest, opts ...grpc.CallOption) (*GetAcademicYearsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAcademicYearsResponse)
+	err := c.cc.Invoke(ctx, EducationService_GetAcademicYears_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *educationServiceClient) DeleteAcademicYear(ctx context.Context, in *DeleteAcademicYearRequest, opts ...grpc.CallOption) (*DeleteAcademicYearResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAcademicYearResponse)
+	err := c.cc.Invoke(ctx, EducationService_DeleteAcademicYear_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *educationServiceClient) PostTerm(ctx context.Context, in *PostTermRequest, opts ...grpc.CallOption) (*PostTermResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PostTermResponse)
+	err := c.cc.Invoke(ctx, EducationService_PostTerm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *educationServiceClient) GetTerms(ctx context.Context, in *GetTermsRequest, opts ...grpc.CallOption) (*GetTermsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTermsResponse)
+	err := c.cc.Invoke(ctx, EducationService_GetTerms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *educationServiceClient) GetCurrentTerm(ctx context.Context, in *GetCurrentTermRequest, opts ...grpc.CallOption) (*GetCurrentTermResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCurrentTermResponse)
+	err := c.cc.Invoke(ctx, EducationService_GetCurrentTerm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *educationServiceClient) DeleteTerm(ctx context.Context, in *DeleteTermRequest, opts ...grpc.CallOption) (*DeleteTermResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTermResponse)
+	err := c.cc.Invoke(ctx, EducationService_DeleteTerm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *educationServiceClient) RolloverYear(ctx context.Context, in *RolloverYearRequest, opts ...grpc.CallOption) (*RolloverYearResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RolloverYearResponse)
+	err := c.cc.Invoke(ctx, EducationService_RolloverYear_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *educationServiceClient) PostLessonSlot(ctx context.Context, in *PostLessonSlotRequest, opts ...grpc.CallOption) (*PostLessonSlotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PostLessonSlotResponse)
+	err := c.cc.Invoke(ctx, EducationService_PostLessonSlot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *educationServiceClient) UpdateLessonSlot(ctx context.Context, in *UpdateLessonSlotRequest, opts ...grpc.CallOption) (*UpdateLessonSlotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateLessonSlotResponse)
+	err := c.cc.Invoke(ctx, EducationService_UpdateLessonSlot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *educationServiceClient) DeleteLessonSlot(ctx context.Context, in *DeleteLessonSlotRequest, opts ...grpc.CallOption) (*DeleteLessonSlotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteLessonSlotResponse)
+	err := c.cc.Invoke(ctx, EducationService_DeleteLessonSlot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *educationServiceClient) GetLessonSlots(ctx context.Context, in *GetLessonSlotsRequest, opts ...grpc.CallOption) (*GetLessonSlotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLessonSlotsResponse)
+	err := c.cc.Invoke(ctx, EducationService_GetLessonSlots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *educationServiceClient) PostLessonException(ctx context.Context, in *PostLessonExceptionRequest, opts ...grpc.CallOption) (*PostLessonExceptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PostLessonExceptionResponse)
+	err := c.cc.Invoke(ctx, EducationService_PostLessonException_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *educationServiceClient) GetLessonExceptions(ctx context.Context, in *GetLessonExceptionsRequest, opts ...grpc.CallOption) (*GetLessonExceptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLessonExceptionsResponse)
+	err := c.cc.Invoke(ctx, EducationService_GetLessonExceptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *educationServiceClient) DeleteLessonException(ctx context.Context, in *DeleteLessonExceptionRequest, opts ...grpc.CallOption) (*DeleteLessonExceptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteLessonExceptionResponse)
+	err := c.cc.Invoke(ctx, EducationService_DeleteLessonException_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *educationServiceClient) GetLessons(ctx context.Context, in *GetLessonsRequest, opts ...grpc.CallOption) (*GetLessonsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLessonsResponse)
+	err := c.cc.Invoke(ctx, EducationService_GetLessons_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *educationServiceClient) GetLessonsInRoom(ctx context.Context, in *GetLessonsInRoomRequest, opts ...grpc.CallOption) (*GetLessonsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLessonsResponse)
+	err := c.cc.Invoke(ctx, EducationService_GetLessonsInRoom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *educationServiceClient) GetNextLesson(ctx context.Context, in *GetNextLessonRequest, opts ...grpc.CallOption) (*GetNextLessonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNextLessonResponse)
+	err := c.cc.Invoke(ctx, EducationService_GetNextLesson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *educationServiceClient) PostEquipmentRequirement(ctx context.Context, in *PostEquipmentRequirementRequest, opts ...grpc.CallOption) (*PostEquipmentRequirementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PostEquipmentRequirementResponse)
+	err := c.cc.Invoke(ctx, EducationService_PostEquipmentRequirement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *educationServiceClient) GetEquipmentRequirements(ctx context.Context, in *GetEquipmentRequirementsRequest, opts ...grpc.CallOption) (*GetEquipmentRequirementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEquipmentRequirementsResponse)
+	err := c.cc.Invoke(ctx, EducationService_GetEquipmentRequirements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *educationServiceClient) DeleteEquipmentRequirement(ctx context.Context, in *DeleteEquipmentRequirementRequest, opts ...grpc.CallOption) (*DeleteEquipmentRequirementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteEquipmentRequirementResponse)
+	err := c.cc.Invoke(ctx, EducationService_DeleteEquipmentRequirement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *educationServiceClient) GetEquipmentNeeds(ctx context.Context, in *GetEquipmentNeedsRequest, opts ...grpc.CallOption) (*GetEquipmentNeedsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEquipmentNeedsResponse)
+	err := c.cc.Invoke(ctx, EducationService_GetEquipmentNeeds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EducationServiceServer is the server API for EducationService service.
 // All implementations must embed UnimplementedEducationServiceServer
 // for forward compatibility.
@@ -210,17 +525,46 @@ type EducationServiceServer interface {
 	// Course methods
 	PostCourse(context.Context, *PostCourseRequest) (*PostCourseResponse, error)
 	GetCourse(context.Context, *GetCourseRequest) (*GetCourseResponse, error)
+	GetCourseByName(context.Context, *GetCourseByNameRequest) (*GetCourseResponse, error)
 	GetCourses(context.Context, *GetCoursesRequest) (*GetCoursesResponse, error)
+	GetCoursesByIDs(context.Context, *GetCoursesByIDsRequest) (*GetCoursesResponse, error)
 	UpdateCourse(context.Context, *UpdateCourseRequest) (*UpdateCourseResponse, error)
 	DeleteCourse(context.Context, *DeleteCourseRequest) (*DeleteCourseResponse, error)
 	LiveCourses(*GetCoursesRequest, grpc.ServerStreamingServer[GetCoursesResponse]) error
 	// Class methods
 	PostClass(context.Context, *PostClassRequest) (*PostClassResponse, error)
 	GetClass(context.Context, *GetClassRequest) (*GetClassResponse, error)
+	GetClassByName(context.Context, *GetClassByNameRequest) (*GetClassResponse, error)
 	GetClasses(context.Context, *GetClassesRequest) (*GetClassesResponse, error)
+	GetClassesByIDs(context.Context, *GetClassesByIDsRequest) (*GetClassesResponse, error)
 	UpdateClass(context.Context, *UpdateClassRequest) (*UpdateClassResponse, error)
 	DeleteClass(context.Context, *DeleteClassRequest) (*DeleteClassResponse, error)
 	LiveClasses(*GetClassesRequest, grpc.ServerStreamingServer[GetClassesResponse]) error
+	// Academic year and term methods
+	PostAcademicYear(context.Context, *PostAcademicYearRequest) (*PostAcademicYearResponse, error)
+	GetAcademicYears(context.Context, *GetAcademicYearsRequest) (*GetAcademicYearsResponse, error)
+	DeleteAcademicYear(context.Context, *DeleteAcademicYearRequest) (*DeleteAcademicYearResponse, error)
+	PostTerm(context.Context, *PostTermRequest) (*PostTermResponse, error)
+	GetTerms(context.Context, *GetTermsRequest) (*GetTermsResponse, error)
+	GetCurrentTerm(context.Context, *GetCurrentTermRequest) (*GetCurrentTermResponse, error)
+	DeleteTerm(context.Context, *DeleteTermRequest) (*DeleteTermResponse, error)
+	RolloverYear(context.Context, *RolloverYearRequest) (*RolloverYearResponse, error)
+	// Timetable methods
+	PostLessonSlot(context.Context, *PostLessonSlotRequest) (*PostLessonSlotResponse, error)
+	UpdateLessonSlot(context.Context, *UpdateLessonSlotRequest) (*UpdateLessonSlotResponse, error)
+	DeleteLessonSlot(context.Context, *DeleteLessonSlotRequest) (*DeleteLessonSlotResponse, error)
+	GetLessonSlots(context.Context, *GetLessonSlotsRequest) (*GetLessonSlotsResponse, error)
+	PostLessonException(context.Context, *PostLessonExceptionRequest) (*PostLessonExceptionResponse, error)
+	GetLessonExceptions(context.Context, *GetLessonExceptionsRequest) (*GetLessonExceptionsResponse, error)
+	DeleteLessonException(context.Context, *DeleteLessonExceptionRequest) (*DeleteLessonExceptionResponse, error)
+	GetLessons(context.Context, *GetLessonsRequest) (*GetLessonsResponse, error)
+	GetLessonsInRoom(context.Context, *GetLessonsInRoomRequest) (*GetLessonsResponse, error)
+	GetNextLesson(context.Context, *GetNextLessonRequest) (*GetNextLessonResponse, error)
+	// Equipment methods
+	PostEquipmentRequirement(context.Context, *PostEquipmentRequirementRequest) (*PostEquipmentRequirementResponse, error)
+	GetEquipmentRequirements(context.Context, *GetEquipmentRequirementsRequest) (*GetEquipmentRequirementsResponse, error)
+	DeleteEquipmentRequirement(context.Context, *DeleteEquipmentRequirementRequest) (*DeleteEquipmentRequirementResponse, error)
+	GetEquipmentNeeds(context.Context, *GetEquipmentNeedsRequest) (*GetEquipmentNeedsResponse, error)
 	mustEmbedUnimplementedEducationServiceServer()
 }
 
@@ -237,9 +581,15 @@ func (UnimplementedEducationServiceServer) PostCourse(context.Context, *PostCour
 func (UnimplementedEducationServiceServer) GetCourse(context.Context, *GetCourseRequest) (*GetCourseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCourse not implemented")
 }
+func (UnimplementedEducationServiceServer) GetCourseByName(context.Context, *GetCourseByNameRequest) (*GetCourseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourseByName not implemented")
+}
 func (UnimplementedEducationServiceServer) GetCourses(context.Context, *GetCoursesRequest) (*GetCoursesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCourses not implemented")
 }
+func (UnimplementedEducationServiceServer) GetCoursesByIDs(context.Context, *GetCoursesByIDsRequest) (*GetCoursesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCoursesByIDs not implemented")
+}
 func (UnimplementedEducationServiceServer) UpdateCourse(context.Context, *UpdateCourseRequest) (*UpdateCourseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCourse not implemented")
 }
@@ -255,9 +605,15 @@ func (UnimplementedEducationServiceServer) PostClass(context.Context, *PostClass
 func (UnimplementedEducationServiceServer) GetClass(context.Context, *GetClassRequest) (*GetClassResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetClass not implemented")
 }
+func (UnimplementedEducationServiceServer) GetClassByName(context.Context, *GetClassByNameRequest) (*GetClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClassByName not implemented")
+}
 func (UnimplementedEducationServiceServer) GetClasses(context.Context, *GetClassesRequest) (*GetClassesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetClasses not implemented")
 }
+func (UnimplementedEducationServiceServer) GetClassesByIDs(context.Context, *GetClassesByIDsRequest) (*GetClassesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClassesByIDs not implemented")
+}
 func (UnimplementedEducationServiceServer) UpdateClass(context.Context, *UpdateClassRequest) (*UpdateClassResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateClass not implemented")
 }
@@ -267,6 +623,72 @@ func (UnimplementedEducationServiceServer) DeleteClass(context.Context, *DeleteC
 func (UnimplementedEducationServiceServer) LiveClasses(*GetClassesRequest, grpc.ServerStreamingServer[GetClassesResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method LiveClasses not implemented")
 }
+func (UnimplementedEducationServiceServer) PostAcademicYear(context.Context, *PostAcademicYearRequest) (*PostAcademicYearResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostAcademicYear not implemented")
+}
+func (UnimplementedEducationServiceServer) GetAcademicYears(context.Context, *GetAcademicYearsRequest) (*GetAcademicYearsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAcademicYears not implemented")
+}
+func (UnimplementedEducationServiceServer) DeleteAcademicYear(context.Context, *DeleteAcademicYearRequest) (*DeleteAcademicYearResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAcademicYear not implemented")
+}
+func (UnimplementedEducationServiceServer) PostTerm(context.Context, *PostTermRequest) (*PostTermResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostTerm not implemented")
+}
+func (UnimplementedEducationServiceServer) GetTerms(context.Context, *GetTermsRequest) (*GetTermsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTerms not implemented")
+}
+func (UnimplementedEducationServiceServer) GetCurrentTerm(context.Context, *GetCurrentTermRequest) (*GetCurrentTermResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentTerm not implemented")
+}
+func (UnimplementedEducationServiceServer) DeleteTerm(context.Context, *DeleteTermRequest) (*DeleteTermResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTerm not implemented")
+}
+func (UnimplementedEducationServiceServer) RolloverYear(context.Context, *RolloverYearRequest) (*RolloverYearResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RolloverYear not implemented")
+}
+func (UnimplementedEducationServiceServer) PostLessonSlot(context.Context, *PostLessonSlotRequest) (*PostLessonSlotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostLessonSlot not implemented")
+}
+func (UnimplementedEducationServiceServer) UpdateLessonSlot(context.Context, *UpdateLessonSlotRequest) (*UpdateLessonSlotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLessonSlot not implemented")
+}
+func (UnimplementedEducationServiceServer) DeleteLessonSlot(context.Context, *DeleteLessonSlotRequest) (*DeleteLessonSlotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLessonSlot not implemented")
+}
+func (UnimplementedEducationServiceServer) GetLessonSlots(context.Context, *GetLessonSlotsRequest) (*GetLessonSlotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLessonSlots not implemented")
+}
+func (UnimplementedEducationServiceServer) PostLessonException(context.Context, *PostLessonExceptionRequest) (*PostLessonExceptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostLessonException not implemented")
+}
+func (UnimplementedEducationServiceServer) GetLessonExceptions(context.Context, *GetLessonExceptionsRequest) (*GetLessonExceptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLessonExceptions not implemented")
+}
+func (UnimplementedEducationServiceServer) DeleteLessonException(context.Context, *DeleteLessonExceptionRequest) (*DeleteLessonExceptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLessonException not implemented")
+}
+func (UnimplementedEducationServiceServer) GetLessons(context.Context, *GetLessonsRequest) (*GetLessonsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLessons not implemented")
+}
+func (UnimplementedEducationServiceServer) GetLessonsInRoom(context.Context, *GetLessonsInRoomRequest) (*GetLessonsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLessonsInRoom not implemented")
+}
+func (UnimplementedEducationServiceServer) GetNextLesson(context.Context, *GetNextLessonRequest) (*GetNextLessonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNextLesson not implemented")
+}
+func (UnimplementedEducationServiceServer) PostEquipmentRequirement(context.Context, *PostEquipmentRequirementRequest) (*PostEquipmentRequirementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostEquipmentRequirement not implemented")
+}
+func (UnimplementedEducationServiceServer) GetEquipmentRequirements(context.Context, *GetEquipmentRequirementsRequest) (*GetEquipmentRequirementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEquipmentRequirements not implemented")
+}
+func (UnimplementedEducationServiceServer) DeleteEquipmentRequirement(context.Context, *DeleteEquipmentRequirementRequest) (*DeleteEquipmentRequirementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEquipmentRequirement not implemented")
+}
+func (UnimplementedEducationServiceServer) GetEquipmentNeeds(context.Context, *GetEquipmentNeedsRequest) (*GetEquipmentNeedsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEquipmentNeeds not implemented")
+}
 func (UnimplementedEducationServiceServer) mustEmbedUnimplementedEducationServiceServer() {}
 func (UnimplementedEducationServiceServer) testEmbeddedByValue()                          {}
 
@@ -324,6 +746,24 @@ func _EducationService_GetCourse_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EducationService_GetCourseByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourseByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EducationServiceServer).GetCourseByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EducationService_GetCourseByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EducationServiceServer).GetCourseByName(ctx, req.(*GetCourseByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EducationService_GetCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCoursesRequest)
 	if err := dec(in); err != nil {
@@ -342,6 +782,24 @@ func _EducationService_GetCourses_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EducationService_GetCoursesByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCoursesByIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EducationServiceServer).GetCoursesByIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EducationService_GetCoursesByIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EducationServiceServer).GetCoursesByIDs(ctx, req.(*GetCoursesByIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EducationService_UpdateCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateCourseRequest)
 	if err := dec(in); err != nil {
@@ -425,49 +883,85 @@ func _EducationService_GetClass_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EducationService_GetClasses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetClassesRequest)
+func _EducationService_GetClassByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClassByNameRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EducationServiceServer).GetClasses(ctx, in)
+		return srv.(EducationServiceServer).GetClassByName(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EducationService_GetClasses_FullMethodName,
+		FullMethod: EducationService_GetClassByName_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EducationServiceServer).GetClasses(ctx, req.(*GetClassesRequest))
+		return srv.(EducationServiceServer).GetClassByName(ctx, req.(*GetClassByNameRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EducationService_UpdateClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateClassRequest)
+func _EducationService_GetClasses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClassesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EducationServiceServer).UpdateClass(ctx, in)
+		return srv.(EducationServiceServer).GetClasses(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EducationService_UpdateClass_FullMethodName,
+		FullMethod: EducationService_GetClasses_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EducationServiceServer).UpdateClass(ctx, req.(*UpdateClassRequest))
+		return srv.(EducationServiceServer).GetClasses(ctx, req.(*GetClassesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EducationService_DeleteClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteClassRequest)
+func _EducationService_GetClassesByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClassesByIDsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EducationServiceServer).DeleteClass(ctx, in)
+		return srv.(EducationServiceServer).GetClassesByIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EducationService_GetClassesByIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EducationServiceServer).GetClassesByIDs(ctx, req.(*GetClassesByIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EducationService_UpdateClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EducationServiceServer).UpdateClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EducationService_UpdateClass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EducationServiceServer).UpdateClass(ctx, req.(*UpdateClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EducationService_DeleteClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EducationServiceServer).DeleteClass(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
@@ -490,6 +984,402 @@ func _EducationService_LiveClasses_Handler(srv interface{}, stream grpc.ServerSt
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type EducationService_LiveClassesServer = grpc.ServerStreamingServer[GetClassesResponse]
 
+func _EducationService_PostAcademicYear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostAcademicYearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EducationServiceServer).PostAcademicYear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EducationService_PostAcademicYear_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EducationServiceServer).PostAcademicYear(ctx, req.(*PostAcademicYearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EducationService_GetAcademicYears_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAcademicYearsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EducationServiceServer).GetAcademicYears(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EducationService_GetAcademicYears_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EducationServiceServer).GetAcademicYears(ctx, req.(*GetAcademicYearsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EducationService_DeleteAcademicYear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAcademicYearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EducationServiceServer).DeleteAcademicYear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EducationService_DeleteAcademicYear_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EducationServiceServer).DeleteAcademicYear(ctx, req.(*DeleteAcademicYearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EducationService_PostTerm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostTermRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EducationServiceServer).PostTerm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EducationService_PostTerm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EducationServiceServer).PostTerm(ctx, req.(*PostTermRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EducationService_GetTerms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTermsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EducationServiceServer).GetTerms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EducationService_GetTerms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EducationServiceServer).GetTerms(ctx, req.(*GetTermsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EducationService_GetCurrentTerm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCurrentTermRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EducationServiceServer).GetCurrentTerm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EducationService_GetCurrentTerm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EducationServiceServer).GetCurrentTerm(ctx, req.(*GetCurrentTermRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EducationService_DeleteTerm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTermRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EducationServiceServer).DeleteTerm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EducationService_DeleteTerm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EducationServiceServer).DeleteTerm(ctx, req.(*DeleteTermRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EducationService_RolloverYear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RolloverYearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EducationServiceServer).RolloverYear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EducationService_RolloverYear_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EducationServiceServer).RolloverYear(ctx, req.(*RolloverYearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EducationService_PostLessonSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostLessonSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EducationServiceServer).PostLessonSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EducationService_PostLessonSlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EducationServiceServer).PostLessonSlot(ctx, req.(*PostLessonSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EducationService_UpdateLessonSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLessonSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EducationServiceServer).UpdateLessonSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EducationService_UpdateLessonSlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EducationServiceServer).UpdateLessonSlot(ctx, req.(*UpdateLessonSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EducationService_DeleteLessonSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLessonSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EducationServiceServer).DeleteLessonSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EducationService_DeleteLessonSlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EducationServiceServer).DeleteLessonSlot(ctx, req.(*DeleteLessonSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EducationService_GetLessonSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLessonSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EducationServiceServer).GetLessonSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EducationService_GetLessonSlots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EducationServiceServer).GetLessonSlots(ctx, req.(*GetLessonSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EducationService_PostLessonException_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostLessonExceptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EducationServiceServer).PostLessonException(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EducationService_PostLessonException_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EducationServiceServer).PostLessonException(ctx, req.(*PostLessonExceptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EducationService_GetLessonExceptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLessonExceptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EducationServiceServer).GetLessonExceptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EducationService_GetLessonExceptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EducationServiceServer).GetLessonExceptions(ctx, req.(*GetLessonExceptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EducationService_DeleteLessonException_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLessonExceptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EducationServiceServer).DeleteLessonException(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EducationService_DeleteLessonException_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EducationServiceServer).DeleteLessonException(ctx, req.(*DeleteLessonExceptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EducationService_GetLessons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLessonsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EducationServiceServer).GetLessons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EducationService_GetLessons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EducationServiceServer).GetLessons(ctx, req.(*GetLessonsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EducationService_GetLessonsInRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLessonsInRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EducationServiceServer).GetLessonsInRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EducationService_GetLessonsInRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EducationServiceServer).GetLessonsInRoom(ctx, req.(*GetLessonsInRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EducationService_GetNextLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNextLessonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EducationServiceServer).GetNextLesson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EducationService_GetNextLesson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EducationServiceServer).GetNextLesson(ctx, req.(*GetNextLessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EducationService_PostEquipmentRequirement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostEquipmentRequirementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EducationServiceServer).PostEquipmentRequirement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EducationService_PostEquipmentRequirement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EducationServiceServer).PostEquipmentRequirement(ctx, req.(*PostEquipmentRequirementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EducationService_GetEquipmentRequirements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEquipmentRequirementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EducationServiceServer).GetEquipmentRequirements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EducationService_GetEquipmentRequirements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EducationServiceServer).GetEquipmentRequirements(ctx, req.(*GetEquipmentRequirementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EducationService_DeleteEquipmentRequirement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEquipmentRequirementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EducationServiceServer).DeleteEquipmentRequirement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EducationService_DeleteEquipmentRequirement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EducationServiceServer).DeleteEquipmentRequirement(ctx, req.(*DeleteEquipmentRequirementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EducationService_GetEquipmentNeeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEquipmentNeedsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EducationServiceServer).GetEquipmentNeeds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EducationService_GetEquipmentNeeds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EducationServiceServer).GetEquipmentNeeds(ctx, req.(*GetEquipmentNeedsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EducationService_ServiceDesc is the grpc.ServiceDesc for EducationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -505,10 +1395,18 @@ var EducationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCourse",
 			Handler:    _EducationService_GetCourse_Handler,
 		},
+		{
+			MethodName: "GetCourseByName",
+			Handler:    _EducationService_GetCourseByName_Handler,
+		},
 		{
 			MethodName: "GetCourses",
 			Handler:    _EducationService_GetCourses_Handler,
 		},
+		{
+			MethodName: "GetCoursesByIDs",
+			Handler:    _EducationService_GetCoursesByIDs_Handler,
+		},
 		{
 			MethodName: "UpdateCourse",
 			Handler:    _EducationService_UpdateCourse_Handler,
@@ -525,10 +1423,18 @@ var EducationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetClass",
 			Handler:    _EducationService_GetClass_Handler,
 		},
+		{
+			MethodName: "GetClassByName",
+			Handler:    _EducationService_GetClassByName_Handler,
+		},
 		{
 			MethodName: "GetClasses",
 			Handler:    _EducationService_GetClasses_Handler,
 		},
+		{
+			MethodName: "GetClassesByIDs",
+			Handler:    _EducationService_GetClassesByIDs_Handler,
+		},
 		{
 			MethodName: "UpdateClass",
 			Handler:    _EducationService_UpdateClass_Handler,
@@ -537,6 +1443,94 @@ var EducationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteClass",
 			Handler:    _EducationService_DeleteClass_Handler,
 		},
+		{
+			MethodName: "PostAcademicYear",
+			Handler:    _EducationService_PostAcademicYear_Handler,
+		},
+		{
+			MethodName: "GetAcademicYears",
+			Handler:    _EducationService_GetAcademicYears_Handler,
+		},
+		{
+			MethodName: "DeleteAcademicYear",
+			Handler:    _EducationService_DeleteAcademicYear_Handler,
+		},
+		{
+			MethodName: "PostTerm",
+			Handler:    _EducationService_PostTerm_Handler,
+		},
+		{
+			MethodName: "GetTerms",
+			Handler:    _EducationService_GetTerms_Handler,
+		},
+		{
+			MethodName: "GetCurrentTerm",
+			Handler:    _EducationService_GetCurrentTerm_Handler,
+		},
+		{
+			MethodName: "DeleteTerm",
+			Handler:    _EducationService_DeleteTerm_Handler,
+		},
+		{
+			MethodName: "RolloverYear",
+			Handler:    _EducationService_RolloverYear_Handler,
+		},
+		{
+			MethodName: "PostLessonSlot",
+			Handler:    _EducationService_PostLessonSlot_Handler,
+		},
+		{
+			MethodName: "UpdateLessonSlot",
+			Handler:    _EducationService_UpdateLessonSlot_Handler,
+		},
+		{
+			MethodName: "DeleteLessonSlot",
+			Handler:    _EducationService_DeleteLessonSlot_Handler,
+		},
+		{
+			MethodName: "GetLessonSlots",
+			Handler:    _EducationService_GetLessonSlots_Handler,
+		},
+		{
+			MethodName: "PostLessonException",
+			Handler:    _EducationService_PostLessonException_Handler,
+		},
+		{
+			MethodName: "GetLessonExceptions",
+			Handler:    _EducationService_GetLessonExceptions_Handler,
+		},
+		{
+			MethodName: "DeleteLessonException",
+			Handler:    _EducationService_DeleteLessonException_Handler,
+		},
+		{
+			MethodName: "GetLessons",
+			Handler:    _EducationService_GetLessons_Handler,
+		},
+		{
+			MethodName: "GetLessonsInRoom",
+			Handler:    _EducationService_GetLessonsInRoom_Handler,
+		},
+		{
+			MethodName: "GetNextLesson",
+			Handler:    _EducationService_GetNextLesson_Handler,
+		},
+		{
+			MethodName: "PostEquipmentRequirement",
+			Handler:    _EducationService_PostEquipmentRequirement_Handler,
+		},
+		{
+			MethodName: "GetEquipmentRequirements",
+			Handler:    _EducationService_GetEquipmentRequirements_Handler,
+		},
+		{
+			MethodName: "DeleteEquipmentRequirement",
+			Handler:    _EducationService_DeleteEquipmentRequirement_Handler,
+		},
+		{
+			MethodName: "GetEquipmentNeeds",
+			Handler:    _EducationService_GetEquipmentNeeds_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
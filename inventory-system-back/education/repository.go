@@ -3,39 +3,90 @@ package education
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"time"
 
-	_ "github.com/lib/pq"
+	"github.com/jochem11/inventory-system-back/internal/config"
+	"github.com/jochem11/inventory-system-back/internal/metrics"
+	"github.com/jochem11/inventory-system-back/internal/tracing"
+	"github.com/lib/pq"
 )
 
 type Repository interface {
 	Close()
+	Ping(ctx context.Context) error
 
 	PutCourse(ctx context.Context, c *Course) error
 	GetCourseByID(ctx context.Context, id string) (*Course, error)
-	ListCourses(ctx context.Context, skip uint64, take uint64) ([]*Course, error)
+	GetCourseByName(ctx context.Context, name string, yearID *string) (*Course, error)
+	ListCourses(ctx context.Context, skip uint64, take uint64, yearID *string) ([]*Course, error)
+	ListCoursesByIDs(ctx context.Context, ids []string) ([]*Course, error)
+	ListCoursesInYear(ctx context.Context, yearID string) ([]*Course, error)
 	UpdateCourse(ctx context.Context, c *Course) (*Course, error)
 	DeleteCourseByID(ctx context.Context, id string) error
 
 	PutClass(ctx context.Context, c *Class) error
 	GetClassByID(ctx context.Context, id string) (*Class, error)
-	ListClasses(ctx context.Context, skip uint64, take uint64) ([]*Class, error)
+	GetClassByName(ctx context.Context, name string, termID *string) (*Class, error)
+	ListClasses(ctx context.Context, skip uint64, take uint64, termID *string) ([]*Class, error)
+	ListClassesByIDs(ctx context.Context, ids []string) ([]*Class, error)
+	ListClassesInTerm(ctx context.Context, termID string) ([]*Class, error)
 	UpdateClass(ctx context.Context, c *Class) (*Class, error)
 	DeleteClassByID(ctx context.Context, id string) error
+
+	PutAcademicYear(ctx context.Context, y *AcademicYear) error
+	GetAcademicYearByID(ctx context.Context, id string) (*AcademicYear, error)
+	ListAcademicYears(ctx context.Context, skip uint64, take uint64) ([]*AcademicYear, error)
+	AcademicYearOverlaps(ctx context.Context, startsOn, endsOn time.Time) (bool, error)
+	CurrentAcademicYear(ctx context.Context, on time.Time) (*AcademicYear, error)
+	DeleteAcademicYearByID(ctx context.Context, id string) error
+
+	PutTerm(ctx context.Context, t *Term) error
+	GetTermByID(ctx context.Context, id string) (*Term, error)
+	ListTerms(ctx context.Context, yearID string) ([]*Term, error)
+	CurrentTerm(ctx context.Context, on time.Time) (*Term, error)
+	DeleteTermByID(ctx context.Context, id string) error
+
+	PutRollover(ctx context.Context, courses []*Course, classes []*Class, equipment []*EquipmentRequirement) error
+
+	PutLessonSlot(ctx context.Context, l *LessonSlot) error
+	GetLessonSlotByID(ctx context.Context, id string) (*LessonSlot, error)
+	ListLessonSlots(ctx context.Context, classID string) ([]*LessonSlot, error)
+	ListLessonSlotsInRoom(ctx context.Context, roomID string, weekday time.Weekday) ([]*LessonSlot, error)
+	UpdateLessonSlot(ctx context.Context, l *LessonSlot) (*LessonSlot, error)
+	DeleteLessonSlotByID(ctx context.Context, id string) error
+
+	PutLessonException(ctx context.Context, e *LessonException) error
+	ListLessonExceptions(ctx context.Context, from, to time.Time) ([]*LessonException, error)
+	DeleteLessonExceptionByID(ctx context.Context, id string) error
+
+	PutEquipmentRequirement(ctx context.Context, e *EquipmentRequirement) error
+	ListEquipmentRequirements(ctx context.Context, courseID string) ([]*EquipmentRequirement, error)
+	DeleteEquipmentRequirementByID(ctx context.Context, id string) error
 }
 
+// ErrPeriodInUse is returned when deleting a year or term that still has
+// terms, courses or classes.
+var ErrPeriodInUse = errors.New("academic year or term is still in use")
+
 type postgresRepository struct {
 	db *sql.DB
 }
 
-func NewPostgresRepository(url string) (Repository, error) {
-	db, err := sql.Open("postgres", url)
+func NewPostgresRepository(url string, pool config.DBPool) (Repository, error) {
+	db, err := tracing.OpenPostgres(url)
 	if err != nil {
 		return nil, err
 	}
+	pool.Apply(db)
 	err = db.Ping()
 	if err != nil {
 		return nil, err
 	}
+	if err := metrics.RegisterDB(db, "education"); err != nil {
+		db.Close()
+		return nil, err
+	}
 	return &postgresRepository{db}, nil
 }
 
@@ -44,25 +95,55 @@ func (r postgresRepository) Close() {
 
 }
 
+func (r postgresRepository) Ping(ctx context.Context) error {
+	return r.db.PingContext(ctx)
+}
+
 func (r *postgresRepository) PutCourse(ctx context.Context, c *Course) error {
-	_, err := r.db.ExecContext(ctx, "INSERT INTO courses(id, name, created_at, updated_at) VALUES ($1, $2, $3, $4)", c.ID, c.Name, c.CreatedAt, c.UpdatedAt)
+	_, err := r.db.ExecContext(ctx, "INSERT INTO courses(id, name, created_at, updated_at, year_id) VALUES ($1, $2, $3, $4, $5)", c.ID, c.Name, c.CreatedAt, c.UpdatedAt, c.YearID)
 	return err
 }
 
 func (r *postgresRepository) GetCourseByID(ctx context.Context, id string) (*Course, error) {
-	row := r.db.QueryRowContext(ctx, "SELECT * FROM courses WHERE id = $1", id)
+	row := r.db.QueryRowContext(ctx, "SELECT id, name, created_at, updated_at, year_id FROM courses WHERE id = $1", id)
+	c := &Course{}
+	if err := row.Scan(&c.ID, &c.Name, &c.CreatedAt, &c.UpdatedAt, &c.YearID); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// GetCourseByName looks name up within yearID, a nil yearID matches the
+// courses from before academic years.
+func (r *postgresRepository) GetCourseByName(ctx context.Context, name string, yearID *string) (*Course, error) {
+	row := r.db.QueryRowContext(ctx, "SELECT id, name, created_at, updated_at, year_id FROM courses WHERE name = $1 AND year_id IS NOT DISTINCT FROM $2", name, yearID)
 	c := &Course{}
-	if err := row.Scan(&c.ID, &c.Name, &c.CreatedAt, &c.UpdatedAt); err != nil {
+	if err := row.Scan(&c.ID, &c.Name, &c.CreatedAt, &c.UpdatedAt, &c.YearID); err != nil {
 		return nil, err
 	}
 	return c, nil
 }
 
-func (r *postgresRepository) ListCourses(ctx context.Context, skip uint64, take uint64) ([]*Course, error) {
-	rows, err := r.db.QueryContext(ctx, "SELECT id, name, created_at, updated_at FROM courses ORDER BY id DESC OFFSET $1 LIMIT $2",
+// ListCourses pages through the courses of yearID, or all of them when
+// yearID is nil.
+func (r *postgresRepository) ListCourses(ctx context.Context, skip uint64, take uint64, yearID *string) ([]*Course, error) {
+	return r.queryCourses(ctx, "SELECT id, name, created_at, updated_at, year_id FROM courses WHERE $3::text IS NULL OR year_id = $3 ORDER BY id DESC OFFSET $1 LIMIT $2",
 		skip,
 		take,
+		yearID,
 	)
+}
+
+func (r *postgresRepository) ListCoursesByIDs(ctx context.Context, ids []string) ([]*Course, error) {
+	return r.queryCourses(ctx, "SELECT id, name, created_at, updated_at, year_id FROM courses WHERE id = ANY($1)", pq.Array(ids))
+}
+
+func (r *postgresRepository) ListCoursesInYear(ctx context.Context, yearID string) ([]*Course, error) {
+	return r.queryCourses(ctx, "SELECT id, name, created_at, updated_at, year_id FROM courses WHERE year_id = $1 ORDER BY name", yearID)
+}
+
+func (r *postgresRepository) queryCourses(ctx context.Context, query string, args ...interface{}) ([]*Course, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -71,7 +152,7 @@ func (r *postgresRepository) ListCourses(ctx context.Context, skip uint64, take
 	courses := []*Course{}
 	for rows.Next() {
 		c := &Course{}
-		if err := rows.Scan(&c.ID, &c.Name, &c.CreatedAt, &c.UpdatedAt); err != nil {
+		if err := rows.Scan(&c.ID, &c.Name, &c.CreatedAt, &c.UpdatedAt, &c.YearID); err != nil {
 			return nil, err
 		}
 		courses = append(courses, c) // Add this line to append the course to the slice
@@ -119,26 +200,39 @@ func (r *postgresRepository) DeleteCourseByID(ctx context.Context, id string) er
 }
 
 func (r *postgresRepository) PutClass(ctx context.Context, c *Class) error {
-	_, err := r.db.ExecContext(ctx, "INSERT INTO classes(id, name, created_at, updated_at) VALUES ($1, $2, $3, $4)", c.ID, c.Name, c.CreatedAt, c.UpdatedAt)
+	_, err := r.db.ExecContext(ctx, "INSERT INTO classes(id, name, created_at, updated_at, course_id, term_id) VALUES ($1, $2, $3, $4, $5, $6)", c.ID, c.Name, c.CreatedAt, c.UpdatedAt, c.CourseID, c.TermID)
 	return err
 }
 
+const classColumns = `c.id, c.name, c.created_at, c.updated_at, c.year_id,
+               cl.id, cl.name, cl.created_at, cl.updated_at, cl.course_id, cl.term_id, cl.student_count`
+
 func (r *postgresRepository) GetClassByID(ctx context.Context, id string) (*Class, error) {
 	row := r.db.QueryRowContext(ctx, `
-        SELECT c.id, c.name, c.created_at, c.updated_at, 
-               cl.id, cl.name, cl.created_at, cl.updated_at
+        SELECT `+classColumns+`
         FROM classes cl
         JOIN courses c ON cl.course_id = c.id
         WHERE cl.id = $1`, id)
+	return scanClass(row)
+}
 
+// GetClassByName looks name up within termID, a nil termID matches the
+// classes from before terms.
+func (r *postgresRepository) GetClassByName(ctx context.Context, name string, termID *string) (*Class, error) {
+	row := r.db.QueryRowContext(ctx, `
+        SELECT `+classColumns+`
+        FROM classes cl
+        JOIN courses c ON cl.course_id = c.id
+        WHERE cl.name = $1 AND cl.term_id IS NOT DISTINCT FROM $2`, name, termID)
+	return scanClass(row)
+}
+
+func scanClass(row interface{ Scan(...any) error }) (*Class, error) {
 	class := &Class{}
 	course := &Course{}
-	err := row.Scan(&course.ID, &course.Name, &course.CreatedAt, &course.UpdatedAt,
-		&class.ID, &class.Name, &class.CreatedAt, &class.UpdatedAt)
+	err := row.Scan(&course.ID, &course.Name, &course.CreatedAt, &course.UpdatedAt, &course.YearID,
+		&class.ID, &class.Name, &class.CreatedAt, &class.UpdatedAt, &class.CourseID, &class.TermID, &class.StudentCount)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, nil
-		}
 		return nil, err
 	}
 
@@ -148,13 +242,37 @@ func (r *postgresRepository) GetClassByID(ctx context.Context, id string) (*Clas
 	return class, nil
 }
 
-func (r *postgresRepository) ListClasses(ctx context.Context, skip uint64, take uint64) ([]*Class, error) {
-	rows, err := r.db.QueryContext(ctx, `
-        SELECT c.id, c.name, c.created_at, c.updated_at, cl.id, cl.name, cl.created_at, cl.updated_at
+// ListClasses pages through the classes of termID, or all of them when
+// termID is nil.
+func (r *postgresRepository) ListClasses(ctx context.Context, skip uint64, take uint64, termID *string) ([]*Class, error) {
+	return r.queryClasses(ctx, `
+        SELECT `+classColumns+`
         FROM classes cl
         JOIN courses c ON cl.course_id = c.id
+        WHERE $3::text IS NULL OR cl.term_id = $3
         ORDER BY cl.id DESC
-        OFFSET $1 LIMIT $2`, skip, take)
+        OFFSET $1 LIMIT $2`, skip, take, termID)
+}
+
+func (r *postgresRepository) ListClassesByIDs(ctx context.Context, ids []string) ([]*Class, error) {
+	return r.queryClasses(ctx, `
+        SELECT `+classColumns+`
+        FROM classes cl
+        JOIN courses c ON cl.course_id = c.id
+        WHERE cl.id = ANY($1)`, pq.Array(ids))
+}
+
+func (r *postgresRepository) ListClassesInTerm(ctx context.Context, termID string) ([]*Class, error) {
+	return r.queryClasses(ctx, `
+        SELECT `+classColumns+`
+        FROM classes cl
+        JOIN courses c ON cl.course_id = c.id
+        WHERE cl.term_id = $1
+        ORDER BY cl.name`, termID)
+}
+
+func (r *postgresRepository) queryClasses(ctx context.Context, query string, args ...interface{}) ([]*Class, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -162,14 +280,10 @@ func (r *postgresRepository) ListClasses(ctx context.Context, skip uint64, take
 
 	classes := []*Class{}
 	for rows.Next() {
-		course := &Course{}
-		class := &Class{}
-		if err := rows.Scan(&course.ID, &course.Name, &course.CreatedAt, &course.UpdatedAt,
-			&class.ID, &class.Name, &class.CreatedAt, &class.UpdatedAt); err != nil {
+		class, err := scanClass(rows)
+		if err != nil {
 			return nil, err
 		}
-		// Associate the course with the class
-		class.Course = course
 		classes = append(classes, class)
 	}
 
@@ -183,8 +297,8 @@ func (r *postgresRepository) ListClasses(ctx context.Context, skip uint64, take
 func (r *postgresRepository) UpdateClass(ctx context.Context, c *Class) (*Class, error) {
 	_, err := r.db.ExecContext(ctx, `
         UPDATE classes 
-        SET name = $1, updated_at = $2 
-        WHERE id = $3`, c.Name, c.UpdatedAt, c.ID)
+        SET name = $1, updated_at = $2, course_id = $3, term_id = $4, student_count = $5
+        WHERE id = $6`, c.Name, c.UpdatedAt, c.CourseID, c.TermID, c.StudentCount, c.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -210,3 +324,358 @@ func (r *postgresRepository) DeleteClassByID(ctx context.Context, id string) err
 
 	return nil
 }
+
+const academicYearColumns = `id, name, starts_on, ends_on, created_at, updated_at`
+
+func scanAcademicYear(row interface{ Scan(...any) error }) (*AcademicYear, error) {
+	y := &AcademicYear{}
+	if err := row.Scan(&y.ID, &y.Name, &y.StartsOn, &y.EndsOn, &y.CreatedAt, &y.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return y, nil
+}
+
+func (r *postgresRepository) PutAcademicYear(ctx context.Context, y *AcademicYear) error {
+	_, err := r.db.ExecContext(ctx, `
+        INSERT INTO academic_years(id, name, starts_on, ends_on, created_at, updated_at)
+        VALUES ($1, $2, $3, $4, $5, $6)`,
+		y.ID, y.Name, dateParam(y.StartsOn), dateParam(y.EndsOn), y.CreatedAt, y.UpdatedAt)
+	return err
+}
+
+func (r *postgresRepository) GetAcademicYearByID(ctx context.Context, id string) (*AcademicYear, error) {
+	return scanAcademicYear(r.db.QueryRowContext(ctx, "SELECT "+academicYearColumns+" FROM academic_years WHERE id = $1", id))
+}
+
+func (r *postgresRepository) ListAcademicYears(ctx context.Context, skip uint64, take uint64) ([]*AcademicYear, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT "+academicYearColumns+" FROM academic_years ORDER BY starts_on DESC OFFSET $1 LIMIT $2", skip, take)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	years := []*AcademicYear{}
+	for rows.Next() {
+		y, err := scanAcademicYear(rows)
+		if err != nil {
+			return nil, err
+		}
+		years = append(years, y)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return years, nil
+}
+
+// AcademicYearOverlaps reports whether any year shares a day with the one
+// from startsOn through endsOn.
+func (r *postgresRepository) AcademicYearOverlaps(ctx context.Context, startsOn, endsOn time.Time) (bool, error) {
+	var overlaps bool
+	err := r.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM academic_years WHERE starts_on <= $2 AND ends_on >= $1)",
+		dateParam(startsOn), dateParam(endsOn)).Scan(&overlaps)
+	return overlaps, err
+}
+
+// CurrentAcademicYear returns the last year that started on or before on, so
+// the summer holiday still counts as the year that just ended.
+func (r *postgresRepository) CurrentAcademicYear(ctx context.Context, on time.Time) (*AcademicYear, error) {
+	return scanAcademicYear(r.db.QueryRowContext(ctx, `
+        SELECT `+academicYearColumns+`
+        FROM academic_years
+        WHERE starts_on <= $1
+        ORDER BY starts_on DESC
+        LIMIT 1`, dateParam(on)))
+}
+
+func (r *postgresRepository) DeleteAcademicYearByID(ctx context.Context, id string) error {
+	return deletePeriod(ctx, r.db, "DELETE FROM academic_years WHERE id = $1", id)
+}
+
+const termColumns = `id, year_id, name, starts_on, ends_on, created_at, updated_at`
+
+func scanTerm(row interface{ Scan(...any) error }) (*Term, error) {
+	t := &Term{}
+	if err := row.Scan(&t.ID, &t.YearID, &t.Name, &t.StartsOn, &t.EndsOn, &t.CreatedAt, &t.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (r *postgresRepository) PutTerm(ctx context.Context, t *Term) error {
+	_, err := r.db.ExecContext(ctx, `
+        INSERT INTO terms(id, year_id, name, starts_on, ends_on, created_at, updated_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		t.ID, t.YearID, t.Name, dateParam(t.StartsOn), dateParam(t.EndsOn), t.CreatedAt, t.UpdatedAt)
+	return err
+}
+
+func (r *postgresRepository) GetTermByID(ctx context.Context, id string) (*Term, error) {
+	return scanTerm(r.db.QueryRowContext(ctx, "SELECT "+termColumns+" FROM terms WHERE id = $1", id))
+}
+
+// ListTerms returns the terms of yearID in the order they run.
+func (r *postgresRepository) ListTerms(ctx context.Context, yearID string) ([]*Term, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT "+termColumns+" FROM terms WHERE year_id = $1 ORDER BY starts_on", yearID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	terms := []*Term{}
+	for rows.Next() {
+		t, err := scanTerm(rows)
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, t)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return terms, nil
+}
+
+// CurrentTerm returns the last term that started on or before on, holidays
+// between terms belong to the term before them.
+func (r *postgresRepository) CurrentTerm(ctx context.Context, on time.Time) (*Term, error) {
+	return scanTerm(r.db.QueryRowContext(ctx, `
+        SELECT `+termColumns+`
+        FROM terms
+        WHERE starts_on <= $1
+        ORDER BY starts_on DESC
+        LIMIT 1`, dateParam(on)))
+}
+
+func (r *postgresRepository) DeleteTermByID(ctx context.Context, id string) error {
+	return deletePeriod(ctx, r.db, "DELETE FROM terms WHERE id = $1", id)
+}
+
+// PutRollover inserts what a rollover copied in one transaction, so a failed
+// rollover leaves nothing half done.
+func (r *postgresRepository) PutRollover(ctx context.Context, courses []*Course, classes []*Class, equipment []*EquipmentRequirement) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, c := range courses {
+		_, err := tx.ExecContext(ctx, "INSERT INTO courses(id, name, created_at, updated_at, year_id) VALUES ($1, $2, $3, $4, $5)",
+			c.ID, c.Name, c.CreatedAt, c.UpdatedAt, c.YearID)
+		if err != nil {
+			return err
+		}
+	}
+	for _, c := range classes {
+		_, err := tx.ExecContext(ctx, "INSERT INTO classes(id, name, created_at, updated_at, course_id, term_id) VALUES ($1, $2, $3, $4, $5, $6)",
+			c.ID, c.Name, c.CreatedAt, c.UpdatedAt, c.CourseID, c.TermID)
+		if err != nil {
+			return err
+		}
+	}
+	for _, e := range equipment {
+		_, err := tx.ExecContext(ctx, `
+            INSERT INTO course_equipment(id, course_id, item_type, quantity, per_students, created_at, updated_at)
+            VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+			e.ID, e.CourseID, e.ItemType, e.Quantity, e.PerStudents, e.CreatedAt, e.UpdatedAt)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// PutEquipmentRequirement replaces the course's requirement for the same item
+// type, which then keeps its ID and created_at.
+func (r *postgresRepository) PutEquipmentRequirement(ctx context.Context, e *EquipmentRequirement) error {
+	return r.db.QueryRowContext(ctx, `
+        INSERT INTO course_equipment(id, course_id, item_type, quantity, per_students, created_at, updated_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7)
+        ON CONFLICT (course_id, item_type) DO UPDATE
+        SET quantity = EXCLUDED.quantity, per_students = EXCLUDED.per_students, updated_at = EXCLUDED.updated_at
+        RETURNING id, created_at`,
+		e.ID, e.CourseID, e.ItemType, e.Quantity, e.PerStudents, e.CreatedAt, e.UpdatedAt).Scan(&e.ID, &e.CreatedAt)
+}
+
+func (r *postgresRepository) ListEquipmentRequirements(ctx context.Context, courseID string) ([]*EquipmentRequirement, error) {
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT id, course_id, item_type, quantity, per_students, created_at, updated_at
+        FROM course_equipment
+        WHERE course_id = $1
+        ORDER BY item_type`, courseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	requirements := []*EquipmentRequirement{}
+	for rows.Next() {
+		e := &EquipmentRequirement{}
+		if err := rows.Scan(&e.ID, &e.CourseID, &e.ItemType, &e.Quantity, &e.PerStudents, &e.CreatedAt, &e.UpdatedAt); err != nil {
+			return nil, err
+		}
+		requirements = append(requirements, e)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return requirements, nil
+}
+
+func (r *postgresRepository) DeleteEquipmentRequirementByID(ctx context.Context, id string) error {
+	return deleteByID(ctx, r.db, "DELETE FROM course_equipment WHERE id = $1", id)
+}
+
+const lessonSlotColumns = `id, class_id, weekday, start_minute, end_minute, room_id, teacher, created_at, updated_at`
+
+func scanLessonSlot(row interface{ Scan(...any) error }) (*LessonSlot, error) {
+	l := &LessonSlot{}
+	var weekday int
+	if err := row.Scan(&l.ID, &l.ClassID, &weekday, &l.StartMinute, &l.EndMinute, &l.RoomID, &l.Teacher, &l.CreatedAt, &l.UpdatedAt); err != nil {
+		return nil, err
+	}
+	l.Weekday = time.Weekday(weekday % 7)
+	return l, nil
+}
+
+// isoWeekday numbers the days from Monday as 1 through Sunday as 7, the way
+// the weekday column stores them.
+func isoWeekday(d time.Weekday) int {
+	if d == time.Sunday {
+		return 7
+	}
+	return int(d)
+}
+
+func (r *postgresRepository) PutLessonSlot(ctx context.Context, l *LessonSlot) error {
+	_, err := r.db.ExecContext(ctx, `
+        INSERT INTO lesson_slots(id, class_id, weekday, start_minute, end_minute, room_id, teacher, created_at, updated_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		l.ID, l.ClassID, isoWeekday(l.Weekday), l.StartMinute, l.EndMinute, l.RoomID, l.Teacher, l.CreatedAt, l.UpdatedAt)
+	return err
+}
+
+func (r *postgresRepository) GetLessonSlotByID(ctx context.Context, id string) (*LessonSlot, error) {
+	return scanLessonSlot(r.db.QueryRowContext(ctx, "SELECT "+lessonSlotColumns+" FROM lesson_slots WHERE id = $1", id))
+}
+
+// ListLessonSlots returns the slots of classID through the week.
+func (r *postgresRepository) ListLessonSlots(ctx context.Context, classID string) ([]*LessonSlot, error) {
+	return r.queryLessonSlots(ctx, "SELECT "+lessonSlotColumns+" FROM lesson_slots WHERE class_id = $1 ORDER BY weekday, start_minute", classID)
+}
+
+func (r *postgresRepository) ListLessonSlotsInRoom(ctx context.Context, roomID string, weekday time.Weekday) ([]*LessonSlot, error) {
+	return r.queryLessonSlots(ctx, "SELECT "+lessonSlotColumns+" FROM lesson_slots WHERE room_id = $1 AND weekday = $2 ORDER BY start_minute",
+		roomID, isoWeekday(weekday))
+}
+
+func (r *postgresRepository) queryLessonSlots(ctx context.Context, query string, args ...interface{}) ([]*LessonSlot, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	slots := []*LessonSlot{}
+	for rows.Next() {
+		l, err := scanLessonSlot(rows)
+		if err != nil {
+			return nil, err
+		}
+		slots = append(slots, l)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return slots, nil
+}
+
+func (r *postgresRepository) UpdateLessonSlot(ctx context.Context, l *LessonSlot) (*LessonSlot, error) {
+	_, err := r.db.ExecContext(ctx, `
+        UPDATE lesson_slots
+        SET weekday = $1, start_minute = $2, end_minute = $3, room_id = $4, teacher = $5, updated_at = $6
+        WHERE id = $7`, isoWeekday(l.Weekday), l.StartMinute, l.EndMinute, l.RoomID, l.Teacher, l.UpdatedAt, l.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetLessonSlotByID(ctx, l.ID)
+}
+
+func (r *postgresRepository) DeleteLessonSlotByID(ctx context.Context, id string) error {
+	return deleteByID(ctx, r.db, "DELETE FROM lesson_slots WHERE id = $1", id)
+}
+
+const lessonExceptionColumns = `id, slot_id, kind, starts_on, ends_on, note, created_at`
+
+func (r *postgresRepository) PutLessonException(ctx context.Context, e *LessonException) error {
+	_, err := r.db.ExecContext(ctx, `
+        INSERT INTO lesson_exceptions(id, slot_id, kind, starts_on, ends_on, note, created_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		e.ID, e.SlotID, e.Kind, dateParam(e.StartsOn), dateParam(e.EndsOn), e.Note, e.CreatedAt)
+	return err
+}
+
+// ListLessonExceptions returns the exceptions that share a day with from
+// through to.
+func (r *postgresRepository) ListLessonExceptions(ctx context.Context, from, to time.Time) ([]*LessonException, error) {
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT `+lessonExceptionColumns+`
+        FROM lesson_exceptions
+        WHERE starts_on <= $2 AND ends_on >= $1
+        ORDER BY starts_on`, dateParam(from), dateParam(to))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	exceptions := []*LessonException{}
+	for rows.Next() {
+		e := &LessonException{}
+		if err := rows.Scan(&e.ID, &e.SlotID, &e.Kind, &e.StartsOn, &e.EndsOn, &e.Note, &e.CreatedAt); err != nil {
+			return nil, err
+		}
+		exceptions = append(exceptions, e)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return exceptions, nil
+}
+
+func (r *postgresRepository) DeleteLessonExceptionByID(ctx context.Context, id string) error {
+	return deleteByID(ctx, r.db, "DELETE FROM lesson_exceptions WHERE id = $1", id)
+}
+
+func deleteByID(ctx context.Context, db *sql.DB, query, id string) error {
+	res, err := db.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func deletePeriod(ctx context.Context, db *sql.DB, query, id string) error {
+	err := deleteByID(ctx, db, query, id)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23503" { // foreign_key_violation
+		return ErrPeriodInUse
+	}
+	return err
+}
+
+// dateParam sends t as a plain date, a timestamp would be shifted into the
+// database's time zone before being cut to a day.
+func dateParam(t time.Time) string {
+	return t.Format(time.DateOnly)
+}
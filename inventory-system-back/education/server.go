@@ -2,11 +2,17 @@ package education
 
 import (
 	"context"
-	"fmt"
+	"database/sql"
+	"errors"
 	"github.com/jochem11/inventory-system-back/education/pb"
+	"github.com/jochem11/inventory-system-back/internal/logging"
+	"github.com/jochem11/inventory-system-back/internal/metrics"
+	"github.com/jochem11/inventory-system-back/internal/serve"
+	"github.com/jochem11/inventory-system-back/internal/tracing"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
-	"net"
 	"time"
 )
 
@@ -15,35 +21,35 @@ type grpcServer struct {
 	service Service
 }
 
-func ListenGRPC(s Service, port int) error {
-	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
-	if err != nil {
-		return err
-	}
-	serv := grpc.NewServer()
+// ListenGRPC serves s until ctx is cancelled and then drains in-flight RPCs.
+// The grpc.health.v1 status follows the database connection.
+func ListenGRPC(ctx context.Context, s Service, r Repository, opts serve.GRPCOptions) error {
+	serv := grpc.NewServer(
+		grpc.Creds(opts.Creds),
+		tracing.ServerOption(),
+		grpc.ChainUnaryInterceptor(
+			logging.UnaryServerInterceptor(),
+			metrics.GRPCServer.UnaryServerInterceptor(),
+			serve.TimeoutInterceptor(opts.Timeout),
+		),
+		grpc.ChainStreamInterceptor(logging.StreamServerInterceptor(), metrics.GRPCServer.StreamServerInterceptor()),
+	)
 	pb.RegisterEducationServiceServer(serv, &grpcServer{
 		service: s,
 	})
-	return serv.Serve(lis)
+	metrics.InitGRPCServer(serv)
+	serve.RegisterHealth(ctx, serv, pb.EducationService_ServiceDesc.ServiceName, r.Ping)
+	return serve.GRPC(ctx, serv, opts.Port, opts.Drain)
 }
 
 // --- Course Methods ---
 
 func (s *grpcServer) PostCourse(ctx context.Context, req *pb.PostCourseRequest) (*pb.PostCourseResponse, error) {
-	c, err := s.service.PostCourse(ctx, req.Name)
+	c, err := s.service.PostCourse(ctx, req.Name, req.YearId)
 	if err != nil {
 		return nil, err
 	}
-
-	updatedAt := timestamppb.New(c.UpdatedAt)
-	createdAt := timestamppb.New(c.CreatedAt)
-
-	return &pb.PostCourseResponse{Course: &pb.Course{
-		Id:        c.ID,
-		Name:      c.Name,
-		UpdatedAt: updatedAt,
-		CreatedAt: createdAt,
-	}}, nil
+	return &pb.PostCourseResponse{Course: courseToProto(c)}, nil
 }
 
 func (s *grpcServer) GetCourse(ctx context.Context, req *pb.GetCourseRequest) (*pb.GetCourseResponse, error) {
@@ -51,37 +57,43 @@ func (s *grpcServer) GetCourse(ctx context.Context, req *pb.GetCourseRequest) (*
 	if err != nil {
 		return nil, err
 	}
+	return &pb.GetCourseResponse{Course: courseToProto(c)}, nil
+}
 
-	updatedAt := timestamppb.New(c.UpdatedAt)
-	createdAt := timestamppb.New(c.CreatedAt)
-
-	return &pb.GetCourseResponse{Course: &pb.Course{
-		Id:        c.ID,
-		Name:      c.Name,
-		UpdatedAt: updatedAt,
-		CreatedAt: createdAt,
-	}}, nil
+func (s *grpcServer) GetCourseByName(ctx context.Context, req *pb.GetCourseByNameRequest) (*pb.GetCourseResponse, error) {
+	c, err := s.service.GetCourseByName(ctx, req.Name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "course not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetCourseResponse{Course: courseToProto(c)}, nil
 }
 
 func (s *grpcServer) GetCourses(ctx context.Context, req *pb.GetCoursesRequest) (*pb.GetCoursesResponse, error) {
-	res, err := s.service.GetCourses(ctx, &req.Skip, &req.Take)
+	res, err := s.service.GetCourses(ctx, &req.Skip, &req.Take, req.YearId)
 	if err != nil {
 		return nil, err
 	}
 
 	courses := []*pb.Course{}
-
 	for _, c := range res {
-		updatedAt := timestamppb.New(c.UpdatedAt)
-		createdAt := timestamppb.New(c.CreatedAt)
-		courses = append(courses, &pb.Course{
-			Id:        c.ID,
-			Name:      c.Name,
-			UpdatedAt: updatedAt,
-			CreatedAt: createdAt,
-		})
+		courses = append(courses, courseToProto(c))
 	}
+	return &pb.GetCoursesResponse{Courses: courses}, nil
+}
 
+func (s *grpcServer) GetCoursesByIDs(ctx context.Context, req *pb.GetCoursesByIDsRequest) (*pb.GetCoursesResponse, error) {
+	res, err := s.service.GetCoursesByIDs(ctx, req.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	courses := []*pb.Course{}
+	for _, c := range res {
+		courses = append(courses, courseToProto(c))
+	}
 	return &pb.GetCoursesResponse{Courses: courses}, nil
 }
 
@@ -90,16 +102,7 @@ func (s *grpcServer) UpdateCourse(ctx context.Context, req *pb.UpdateCourseReque
 	if err != nil {
 		return nil, err
 	}
-
-	updatedAt := timestamppb.New(c.UpdatedAt)
-	createdAt := timestamppb.New(c.CreatedAt)
-
-	return &pb.UpdateCourseResponse{Course: &pb.Course{
-		Id:        c.ID,
-		Name:      c.Name,
-		UpdatedAt: updatedAt,
-		CreatedAt: createdAt,
-	}}, nil
+	return &pb.UpdateCourseResponse{Course: courseToProto(c)}, nil
 }
 
 func (s *grpcServer) DeleteCourse(ctx context.Context, req *pb.DeleteCourseRequest) (*pb.DeleteCourseResponse, error) {
@@ -111,69 +114,34 @@ func (s *grpcServer) DeleteCourse(ctx context.Context, req *pb.DeleteCourseReque
 }
 
 func (s *grpcServer) LiveCourses(req *pb.GetCoursesRequest, stream pb.EducationService_LiveCoursesServer) error {
-	// Create a ticker to periodically send updates
-	ticker := time.NewTicker(5 * time.Second)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-stream.Context().Done():
-			return stream.Context().Err()
-		case <-ticker.C:
-			// Get the latest courses using your existing service method
-			courses, err := s.service.GetCourses(stream.Context(), &req.Skip, &req.Take)
-			if err != nil {
-				return err
-			}
-
-			// Convert the courses to protobuf format
-			pbCourses := make([]*pb.Course, 0, len(courses))
-			for _, c := range courses {
-				pbCourses = append(pbCourses, &pb.Course{
-					Id:        c.ID,
-					Name:      c.Name,
-					UpdatedAt: timestamppb.New(c.UpdatedAt),
-					CreatedAt: timestamppb.New(c.CreatedAt),
-				})
-			}
-
-			// Send the courses through the stream
-			if err := stream.Send(&pb.GetCoursesResponse{
-				Courses: pbCourses,
-			}); err != nil {
-				return err
-			}
+	courses, err := s.service.LiveCourses(stream.Context(), &req.Skip, &req.Take)
+	if err != nil {
+		return err
+	}
+
+	for page := range courses {
+		pbCourses := make([]*pb.Course, 0, len(page))
+		for _, c := range page {
+			pbCourses = append(pbCourses, courseToProto(c))
+		}
+
+		if err := stream.Send(&pb.GetCoursesResponse{
+			Courses: pbCourses,
+		}); err != nil {
+			return err
 		}
 	}
+	return status.FromContextError(stream.Context().Err()).Err()
 }
 
 // --- Class Methods ---
 
 func (s *grpcServer) PostClass(ctx context.Context, req *pb.PostClassRequest) (*pb.PostClassResponse, error) {
-	c, err := s.service.PostClass(ctx, req.Name, req.CourseId)
+	c, err := s.service.PostClass(ctx, req.Name, req.CourseId, req.TermId)
 	if err != nil {
 		return nil, err
 	}
-
-	updatedAt := timestamppb.New(c.UpdatedAt)
-	createdAt := timestamppb.New(c.CreatedAt)
-
-	courseUpdatedAt := timestamppb.New(c.Course.UpdatedAt)
-	courseCreatedAt := timestamppb.New(c.Course.CreatedAt)
-
-	return &pb.PostClassResponse{Class: &pb.Class{
-		Id:       c.ID,
-		Name:     c.Name,
-		CourseId: c.CourseID,
-		Course: &pb.Course{
-			Id:        c.Course.ID,
-			Name:      c.Course.Name,
-			CreatedAt: courseCreatedAt,
-			UpdatedAt: courseUpdatedAt,
-		},
-		UpdatedAt: updatedAt,
-		CreatedAt: createdAt,
-	}}, nil
+	return &pb.PostClassResponse{Class: classToProto(c)}, nil
 }
 
 func (s *grpcServer) GetClass(ctx context.Context, req *pb.GetClassRequest) (*pb.GetClassResponse, error) {
@@ -181,55 +149,43 @@ func (s *grpcServer) GetClass(ctx context.Context, req *pb.GetClassRequest) (*pb
 	if err != nil {
 		return nil, err
 	}
-	updatedAt := timestamppb.New(c.UpdatedAt)
-	createdAt := timestamppb.New(c.CreatedAt)
-
-	courseUpdatedAt := timestamppb.New(c.Course.UpdatedAt)
-	courseCreatedAt := timestamppb.New(c.Course.CreatedAt)
+	return &pb.GetClassResponse{Class: classToProto(c)}, nil
+}
 
-	return &pb.GetClassResponse{Class: &pb.Class{
-		Id:       c.ID,
-		Name:     c.Name,
-		CourseId: c.CourseID,
-		Course: &pb.Course{
-			Id:        c.Course.ID,
-			Name:      c.Course.Name,
-			UpdatedAt: courseUpdatedAt,
-			CreatedAt: courseCreatedAt,
-		},
-		UpdatedAt: updatedAt,
-		CreatedAt: createdAt,
-	}}, nil
+func (s *grpcServer) GetClassByName(ctx context.Context, req *pb.GetClassByNameRequest) (*pb.GetClassResponse, error) {
+	c, err := s.service.GetClassByName(ctx, req.Name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "class not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetClassResponse{Class: classToProto(c)}, nil
 }
 
 func (s *grpcServer) GetClasses(ctx context.Context, req *pb.GetClassesRequest) (*pb.GetClassesResponse, error) {
-	res, err := s.service.GetClasses(ctx, &req.Skip, &req.Take)
+	res, err := s.service.GetClasses(ctx, &req.Skip, &req.Take, req.TermId)
 	if err != nil {
 		return nil, err
 	}
 
 	classes := []*pb.Class{}
-
 	for _, c := range res {
-		updatedAt := timestamppb.New(c.UpdatedAt)
-		createdAt := timestamppb.New(c.CreatedAt)
-		courseUpdatedAt := timestamppb.New(c.Course.UpdatedAt)
-		courseCreatedAt := timestamppb.New(c.Course.CreatedAt)
-		classes = append(classes, &pb.Class{
-			Id:       c.ID,
-			Name:     c.Name,
-			CourseId: c.CourseID,
-			Course: &pb.Course{
-				Id:        c.Course.ID,
-				Name:      c.Course.Name,
-				CreatedAt: courseCreatedAt,
-				UpdatedAt: courseUpdatedAt,
-			},
-			UpdatedAt: updatedAt,
-			CreatedAt: createdAt,
-		})
+		classes = append(classes, classToProto(c))
 	}
+	return &pb.GetClassesResponse{Classes: classes}, nil
+}
 
+func (s *grpcServer) GetClassesByIDs(ctx context.Context, req *pb.GetClassesByIDsRequest) (*pb.GetClassesResponse, error) {
+	res, err := s.service.GetClassesByIDs(ctx, req.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	classes := []*pb.Class{}
+	for _, c := range res {
+		classes = append(classes, classToProto(c))
+	}
 	return &pb.GetClassesResponse{Classes: classes}, nil
 }
 
@@ -238,30 +194,13 @@ func (s *grpcServer) UpdateClass(ctx context.Context, req *pb.UpdateClassRequest
 		ctx,
 		req.Id,
 		req.Name,
-		req.CourseId)
+		req.CourseId,
+		req.TermId,
+		optionalInt(req.StudentCount))
 	if err != nil {
 		return nil, err
 	}
-
-	updatedAt := timestamppb.New(c.UpdatedAt)
-	createdAt := timestamppb.New(c.CreatedAt)
-
-	courseUpdatedAt := timestamppb.New(c.Course.UpdatedAt)
-	courseCreatedAt := timestamppb.New(c.Course.CreatedAt)
-
-	return &pb.UpdateClassResponse{Class: &pb.Class{
-		Id:       c.ID,
-		Name:     c.Name,
-		CourseId: c.CourseID,
-		Course: &pb.Course{
-			Id:        c.Course.ID,
-			Name:      c.Course.Name,
-			UpdatedAt: courseUpdatedAt,
-			CreatedAt: courseCreatedAt,
-		},
-		CreatedAt: createdAt,
-		UpdatedAt: updatedAt,
-	}}, nil
+	return &pb.UpdateClassResponse{Class: classToProto(c)}, nil
 }
 
 func (s *grpcServer) DeleteClass(ctx context.Context, req *pb.DeleteClassRequest) (*pb.DeleteClassResponse, error) {
@@ -272,3 +211,384 @@ func (s *grpcServer) DeleteClass(ctx context.Context, req *pb.DeleteClassRequest
 
 	return &pb.DeleteClassResponse{}, nil
 }
+
+// --- Academic Year and Term Methods ---
+
+func (s *grpcServer) PostAcademicYear(ctx context.Context, req *pb.PostAcademicYearRequest) (*pb.PostAcademicYearResponse, error) {
+	y, err := s.service.PostAcademicYear(ctx, req.Name, req.StartsOn.AsTime(), req.EndsOn.AsTime())
+	if err != nil {
+		return nil, periodError(err)
+	}
+	return &pb.PostAcademicYearResponse{Year: academicYearToProto(y)}, nil
+}
+
+func (s *grpcServer) GetAcademicYears(ctx context.Context, req *pb.GetAcademicYearsRequest) (*pb.GetAcademicYearsResponse, error) {
+	res, err := s.service.GetAcademicYears(ctx, &req.Skip, &req.Take)
+	if err != nil {
+		return nil, err
+	}
+
+	years := []*pb.AcademicYear{}
+	for _, y := range res {
+		years = append(years, academicYearToProto(y))
+	}
+	return &pb.GetAcademicYearsResponse{Years: years}, nil
+}
+
+func (s *grpcServer) DeleteAcademicYear(ctx context.Context, req *pb.DeleteAcademicYearRequest) (*pb.DeleteAcademicYearResponse, error) {
+	if err := s.service.DeleteAcademicYearByID(ctx, req.Id); err != nil {
+		return nil, periodError(err)
+	}
+	return &pb.DeleteAcademicYearResponse{}, nil
+}
+
+func (s *grpcServer) PostTerm(ctx context.Context, req *pb.PostTermRequest) (*pb.PostTermResponse, error) {
+	t, err := s.service.PostTerm(ctx, req.YearId, req.Name, req.StartsOn.AsTime(), req.EndsOn.AsTime())
+	if err != nil {
+		return nil, periodError(err)
+	}
+	return &pb.PostTermResponse{Term: termToProto(t)}, nil
+}
+
+func (s *grpcServer) GetTerms(ctx context.Context, req *pb.GetTermsRequest) (*pb.GetTermsResponse, error) {
+	res, err := s.service.GetTerms(ctx, req.YearId)
+	if err != nil {
+		return nil, err
+	}
+
+	terms := []*pb.Term{}
+	for _, t := range res {
+		terms = append(terms, termToProto(t))
+	}
+	return &pb.GetTermsResponse{Terms: terms}, nil
+}
+
+func (s *grpcServer) GetCurrentTerm(ctx context.Context, req *pb.GetCurrentTermRequest) (*pb.GetCurrentTermResponse, error) {
+	t, err := s.service.GetCurrentTerm(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &pb.GetCurrentTermResponse{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetCurrentTermResponse{Term: termToProto(t)}, nil
+}
+
+func (s *grpcServer) DeleteTerm(ctx context.Context, req *pb.DeleteTermRequest) (*pb.DeleteTermResponse, error) {
+	if err := s.service.DeleteTermByID(ctx, req.Id); err != nil {
+		return nil, periodError(err)
+	}
+	return &pb.DeleteTermResponse{}, nil
+}
+
+func (s *grpcServer) RolloverYear(ctx context.Context, req *pb.RolloverYearRequest) (*pb.RolloverYearResponse, error) {
+	r, err := s.service.RolloverYear(ctx, req.FromYearId, req.ToYearId)
+	if err != nil {
+		return nil, periodError(err)
+	}
+	return &pb.RolloverYearResponse{Courses: uint32(r.Courses), Classes: uint32(r.Classes)}, nil
+}
+
+// --- Timetable Methods ---
+
+func (s *grpcServer) PostLessonSlot(ctx context.Context, req *pb.PostLessonSlotRequest) (*pb.PostLessonSlotResponse, error) {
+	l, err := s.service.PostLessonSlot(ctx, req.ClassId, time.Weekday(req.Weekday), int(req.StartMinute), int(req.EndMinute), req.RoomId, req.Teacher)
+	if err != nil {
+		return nil, timetableError(err)
+	}
+	return &pb.PostLessonSlotResponse{Slot: lessonSlotToProto(l)}, nil
+}
+
+func (s *grpcServer) UpdateLessonSlot(ctx context.Context, req *pb.UpdateLessonSlotRequest) (*pb.UpdateLessonSlotResponse, error) {
+	var weekday *time.Weekday
+	if req.Weekday != nil {
+		w := time.Weekday(*req.Weekday)
+		weekday = &w
+	}
+	l, err := s.service.UpdateLessonSlot(
+		ctx,
+		req.Id,
+		weekday,
+		optionalInt(req.StartMinute),
+		optionalInt(req.EndMinute),
+		req.RoomId,
+		req.ClearRoom,
+		req.Teacher)
+	if err != nil {
+		return nil, timetableError(err)
+	}
+	return &pb.UpdateLessonSlotResponse{Slot: lessonSlotToProto(l)}, nil
+}
+
+func (s *grpcServer) DeleteLessonSlot(ctx context.Context, req *pb.DeleteLessonSlotRequest) (*pb.DeleteLessonSlotResponse, error) {
+	if err := s.service.DeleteLessonSlotByID(ctx, req.Id); err != nil {
+		return nil, timetableError(err)
+	}
+	return &pb.DeleteLessonSlotResponse{}, nil
+}
+
+func (s *grpcServer) GetLessonSlots(ctx context.Context, req *pb.GetLessonSlotsRequest) (*pb.GetLessonSlotsResponse, error) {
+	res, err := s.service.GetLessonSlots(ctx, req.ClassId)
+	if err != nil {
+		return nil, err
+	}
+
+	slots := []*pb.LessonSlot{}
+	for _, l := range res {
+		slots = append(slots, lessonSlotToProto(l))
+	}
+	return &pb.GetLessonSlotsResponse{Slots: slots}, nil
+}
+
+func (s *grpcServer) PostLessonException(ctx context.Context, req *pb.PostLessonExceptionRequest) (*pb.PostLessonExceptionResponse, error) {
+	e, err := s.service.PostLessonException(ctx, req.SlotId, req.Kind, req.StartsOn.AsTime(), req.EndsOn.AsTime(), req.Note)
+	if err != nil {
+		return nil, timetableError(err)
+	}
+	return &pb.PostLessonExceptionResponse{Exception: lessonExceptionToProto(e)}, nil
+}
+
+func (s *grpcServer) GetLessonExceptions(ctx context.Context, req *pb.GetLessonExceptionsRequest) (*pb.GetLessonExceptionsResponse, error) {
+	res, err := s.service.GetLessonExceptions(ctx, req.From.AsTime(), req.To.AsTime())
+	if err != nil {
+		return nil, err
+	}
+
+	exceptions := []*pb.LessonException{}
+	for _, e := range res {
+		exceptions = append(exceptions, lessonExceptionToProto(e))
+	}
+	return &pb.GetLessonExceptionsResponse{Exceptions: exceptions}, nil
+}
+
+func (s *grpcServer) DeleteLessonException(ctx context.Context, req *pb.DeleteLessonExceptionRequest) (*pb.DeleteLessonExceptionResponse, error) {
+	if err := s.service.DeleteLessonExceptionByID(ctx, req.Id); err != nil {
+		return nil, timetableError(err)
+	}
+	return &pb.DeleteLessonExceptionResponse{}, nil
+}
+
+func (s *grpcServer) GetLessons(ctx context.Context, req *pb.GetLessonsRequest) (*pb.GetLessonsResponse, error) {
+	res, err := s.service.GetLessons(ctx, req.ClassId, req.From.AsTime(), req.To.AsTime())
+	if err != nil {
+		return nil, timetableError(err)
+	}
+	return &pb.GetLessonsResponse{Lessons: lessonsToProto(res)}, nil
+}
+
+func (s *grpcServer) GetLessonsInRoom(ctx context.Context, req *pb.GetLessonsInRoomRequest) (*pb.GetLessonsResponse, error) {
+	res, err := s.service.GetLessonsInRoom(ctx, req.RoomId, req.At.AsTime())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetLessonsResponse{Lessons: lessonsToProto(res)}, nil
+}
+
+func (s *grpcServer) GetNextLesson(ctx context.Context, req *pb.GetNextLessonRequest) (*pb.GetNextLessonResponse, error) {
+	l, err := s.service.GetNextLesson(ctx, req.ClassId, req.After.AsTime())
+	if err != nil {
+		return nil, timetableError(err)
+	}
+	if l == nil {
+		return &pb.GetNextLessonResponse{}, nil
+	}
+	return &pb.GetNextLessonResponse{Lesson: lessonToProto(l)}, nil
+}
+
+// --- Equipment Methods ---
+
+func (s *grpcServer) PostEquipmentRequirement(ctx context.Context, req *pb.PostEquipmentRequirementRequest) (*pb.PostEquipmentRequirementResponse, error) {
+	e, err := s.service.PostEquipmentRequirement(ctx, req.CourseId, req.ItemType, int(req.Quantity), int(req.PerStudents))
+	if err != nil {
+		return nil, equipmentError(err)
+	}
+	return &pb.PostEquipmentRequirementResponse{Requirement: equipmentRequirementToProto(e)}, nil
+}
+
+func (s *grpcServer) GetEquipmentRequirements(ctx context.Context, req *pb.GetEquipmentRequirementsRequest) (*pb.GetEquipmentRequirementsResponse, error) {
+	res, err := s.service.GetEquipmentRequirements(ctx, req.CourseId)
+	if err != nil {
+		return nil, err
+	}
+
+	requirements := []*pb.EquipmentRequirement{}
+	for _, e := range res {
+		requirements = append(requirements, equipmentRequirementToProto(e))
+	}
+	return &pb.GetEquipmentRequirementsResponse{Requirements: requirements}, nil
+}
+
+func (s *grpcServer) DeleteEquipmentRequirement(ctx context.Context, req *pb.DeleteEquipmentRequirementRequest) (*pb.DeleteEquipmentRequirementResponse, error) {
+	if err := s.service.DeleteEquipmentRequirementByID(ctx, req.Id); err != nil {
+		return nil, equipmentError(err)
+	}
+	return &pb.DeleteEquipmentRequirementResponse{}, nil
+}
+
+func (s *grpcServer) GetEquipmentNeeds(ctx context.Context, req *pb.GetEquipmentNeedsRequest) (*pb.GetEquipmentNeedsResponse, error) {
+	res, err := s.service.GetEquipmentNeeds(ctx, req.ClassId)
+	if err != nil {
+		return nil, equipmentError(err)
+	}
+
+	needs := []*pb.EquipmentNeed{}
+	for _, n := range res.Needs {
+		needs = append(needs, &pb.EquipmentNeed{ItemType: n.ItemType, Quantity: uint32(n.Quantity)})
+	}
+	return &pb.GetEquipmentNeedsResponse{StudentCount: uint32(res.StudentCount), Needs: needs}, nil
+}
+
+func equipmentError(err error) error {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return status.Error(codes.NotFound, "course, class or equipment requirement not found")
+	case errors.Is(err, ErrInvalidRequirement):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return err
+}
+
+// timetableError is periodError for slots, exceptions and lessons.
+func timetableError(err error) error {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return status.Error(codes.NotFound, "class, lesson slot or exception not found")
+	case errors.Is(err, ErrInvalidSlot), errors.Is(err, ErrInvalidException), errors.Is(err, ErrLessonWindow):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return err
+}
+
+func optionalInt(v *uint32) *int {
+	if v == nil {
+		return nil
+	}
+	i := int(*v)
+	return &i
+}
+
+// periodError gives the validation errors of years and terms a status code
+// the gateway can tell apart from a failure.
+func periodError(err error) error {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return status.Error(codes.NotFound, "academic year or term not found")
+	case errors.Is(err, ErrInvalidPeriod), errors.Is(err, ErrTermOutsideYear), errors.Is(err, ErrRolloverSame):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ErrPeriodOverlap), errors.Is(err, ErrPeriodInUse), errors.Is(err, ErrRolloverTerms):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return err
+}
+
+// --- Conversions ---
+
+func courseToProto(c *Course) *pb.Course {
+	return &pb.Course{
+		Id:        c.ID,
+		Name:      c.Name,
+		CreatedAt: timestamppb.New(c.CreatedAt),
+		UpdatedAt: timestamppb.New(c.UpdatedAt),
+		YearId:    c.YearID,
+	}
+}
+
+func classToProto(c *Class) *pb.Class {
+	return &pb.Class{
+		Id:           c.ID,
+		Name:         c.Name,
+		CourseId:     c.CourseID,
+		Course:       courseToProto(c.Course),
+		CreatedAt:    timestamppb.New(c.CreatedAt),
+		UpdatedAt:    timestamppb.New(c.UpdatedAt),
+		TermId:       c.TermID,
+		StudentCount: uint32(c.StudentCount),
+	}
+}
+
+func academicYearToProto(y *AcademicYear) *pb.AcademicYear {
+	return &pb.AcademicYear{
+		Id:        y.ID,
+		Name:      y.Name,
+		StartsOn:  dateToProto(y.StartsOn),
+		EndsOn:    dateToProto(y.EndsOn),
+		CreatedAt: timestamppb.New(y.CreatedAt),
+		UpdatedAt: timestamppb.New(y.UpdatedAt),
+	}
+}
+
+func termToProto(t *Term) *pb.Term {
+	return &pb.Term{
+		Id:        t.ID,
+		YearId:    t.YearID,
+		Name:      t.Name,
+		StartsOn:  dateToProto(t.StartsOn),
+		EndsOn:    dateToProto(t.EndsOn),
+		CreatedAt: timestamppb.New(t.CreatedAt),
+		UpdatedAt: timestamppb.New(t.UpdatedAt),
+	}
+}
+
+func equipmentRequirementToProto(e *EquipmentRequirement) *pb.EquipmentRequirement {
+	return &pb.EquipmentRequirement{
+		Id:          e.ID,
+		CourseId:    e.CourseID,
+		ItemType:    e.ItemType,
+		Quantity:    uint32(e.Quantity),
+		PerStudents: uint32(e.PerStudents),
+		CreatedAt:   timestamppb.New(e.CreatedAt),
+		UpdatedAt:   timestamppb.New(e.UpdatedAt),
+	}
+}
+
+func lessonSlotToProto(l *LessonSlot) *pb.LessonSlot {
+	return &pb.LessonSlot{
+		Id:          l.ID,
+		ClassId:     l.ClassID,
+		Weekday:     uint32(l.Weekday),
+		StartMinute: uint32(l.StartMinute),
+		EndMinute:   uint32(l.EndMinute),
+		RoomId:      l.RoomID,
+		Teacher:     l.Teacher,
+		CreatedAt:   timestamppb.New(l.CreatedAt),
+		UpdatedAt:   timestamppb.New(l.UpdatedAt),
+	}
+}
+
+func lessonExceptionToProto(e *LessonException) *pb.LessonException {
+	return &pb.LessonException{
+		Id:        e.ID,
+		SlotId:    e.SlotID,
+		Kind:      e.Kind,
+		StartsOn:  dateToProto(e.StartsOn),
+		EndsOn:    dateToProto(e.EndsOn),
+		Note:      e.Note,
+		CreatedAt: timestamppb.New(e.CreatedAt),
+	}
+}
+
+func lessonToProto(l *Lesson) *pb.Lesson {
+	return &pb.Lesson{
+		SlotId:   l.SlotID,
+		ClassId:  l.ClassID,
+		RoomId:   l.RoomID,
+		Teacher:  l.Teacher,
+		StartsAt: timestamppb.New(l.StartsAt),
+		EndsAt:   timestamppb.New(l.EndsAt),
+	}
+}
+
+func lessonsToProto(res []*Lesson) []*pb.Lesson {
+	lessons := []*pb.Lesson{}
+	for _, l := range res {
+		lessons = append(lessons, lessonToProto(l))
+	}
+	return lessons
+}
+
+// dateToProto sends a date as midnight UTC, whatever zone the driver read it
+// in.
+func dateToProto(t time.Time) *timestamppb.Timestamp {
+	y, m, d := t.Date()
+	return timestamppb.New(time.Date(y, m, d, 0, 0, 0, 0, time.UTC))
+}
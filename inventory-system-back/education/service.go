@@ -2,28 +2,86 @@ package education
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"github.com/jochem11/inventory-system-back/internal/config"
 	"github.com/segmentio/ksuid"
-	"log"
+	"log/slog"
+	"strings"
 	"time"
 )
 
+var (
+	ErrInvalidPeriod   = errors.New("a period must end after it starts")
+	ErrPeriodOverlap   = errors.New("period overlaps with an existing one")
+	ErrTermOutsideYear = errors.New("term must lie within its academic year")
+	ErrPeriodMismatch  = errors.New("class term is not in the academic year of its course")
+	ErrRolloverTerms   = errors.New("target year has fewer terms than the source year has terms with classes")
+	ErrRolloverSame    = errors.New("cannot roll a year over into itself")
+
+	ErrInvalidSlot      = errors.New("a lesson slot needs a weekday and must end after it starts, within the day")
+	ErrInvalidException = errors.New("a holiday has no slot, a cancellation exactly one, and neither can end before it starts")
+	ErrLessonWindow     = errors.New("lessons can be listed for up to a year at a time")
+
+	ErrInvalidRequirement = errors.New("an equipment requirement needs an item type and a positive quantity")
+)
+
+const (
+	LessonExceptionHoliday   = "HOLIDAY"
+	LessonExceptionCancelled = "CANCELLED"
+)
+
+// maxLessonWindow bounds how far lessons are expanded in one go.
+const maxLessonWindow = 366 * 24 * time.Hour
+
 type Service interface {
-	PostCourse(ctx context.Context, name string) (*Course, error)
+	PostCourse(ctx context.Context, name string, yearID *string) (*Course, error)
 	GetCourse(ctx context.Context, id string) (*Course, error)
-	GetCourses(ctx context.Context, skip *uint64, take *uint64) ([]*Course, error)
+	GetCourseByName(ctx context.Context, name string) (*Course, error)
+	GetCourses(ctx context.Context, skip *uint64, take *uint64, yearID *string) ([]*Course, error)
+	GetCoursesByIDs(ctx context.Context, ids []string) ([]*Course, error)
 	DeleteCourseByID(ctx context.Context, id string) error
 	UpdateCourse(ctx context.Context, id string, name *string) (*Course, error)
 	LiveCourses(ctx context.Context, skip *uint64, take *uint64) (<-chan []*Course, error)
 
-	PostClass(ctx context.Context, name, courseID string) (*Class, error)
+	PostClass(ctx context.Context, name, courseID string, termID *string) (*Class, error)
 	GetClass(ctx context.Context, id string) (*Class, error)
-	GetClasses(ctx context.Context, skip *uint64, take *uint64) ([]*Class, error)
+	GetClassByName(ctx context.Context, name string) (*Class, error)
+	GetClasses(ctx context.Context, skip *uint64, take *uint64, termID *string) ([]*Class, error)
+	GetClassesByIDs(ctx context.Context, ids []string) ([]*Class, error)
 	DeleteClassByID(ctx context.Context, id string) error
-	UpdateClass(ctx context.Context, id string, name *string, courseID *string) (*Class, error)
+	UpdateClass(ctx context.Context, id string, name *string, courseID *string, termID *string, studentCount *int) (*Class, error)
+
+	PostAcademicYear(ctx context.Context, name string, startsOn, endsOn time.Time) (*AcademicYear, error)
+	GetAcademicYears(ctx context.Context, skip *uint64, take *uint64) ([]*AcademicYear, error)
+	DeleteAcademicYearByID(ctx context.Context, id string) error
+	PostTerm(ctx context.Context, yearID, name string, startsOn, endsOn time.Time) (*Term, error)
+	GetTerms(ctx context.Context, yearID string) ([]*Term, error)
+	GetCurrentTerm(ctx context.Context) (*Term, error)
+	DeleteTermByID(ctx context.Context, id string) error
+	RolloverYear(ctx context.Context, fromYearID, toYearID string) (*Rollover, error)
+
+	PostLessonSlot(ctx context.Context, classID string, weekday time.Weekday, startMinute, endMinute int, roomID *string, teacher string) (*LessonSlot, error)
+	UpdateLessonSlot(ctx context.Context, id string, weekday *time.Weekday, startMinute, endMinute *int, roomID *string, clearRoom bool, teacher *string) (*LessonSlot, error)
+	DeleteLessonSlotByID(ctx context.Context, id string) error
+	GetLessonSlots(ctx context.Context, classID string) ([]*LessonSlot, error)
+	PostLessonException(ctx context.Context, slotID *string, kind string, startsOn, endsOn time.Time, note string) (*LessonException, error)
+	GetLessonExceptions(ctx context.Context, from, to time.Time) ([]*LessonException, error)
+	DeleteLessonExceptionByID(ctx context.Context, id string) error
+	GetLessons(ctx context.Context, classID string, from, to time.Time) ([]*Lesson, error)
+	GetLessonsInRoom(ctx context.Context, roomID string, at time.Time) ([]*Lesson, error)
+	GetNextLesson(ctx context.Context, classID string, after time.Time) (*Lesson, error)
+
+	PostEquipmentRequirement(ctx context.Context, courseID, itemType string, quantity, perStudents int) (*EquipmentRequirement, error)
+	GetEquipmentRequirements(ctx context.Context, courseID string) ([]*EquipmentRequirement, error)
+	DeleteEquipmentRequirementByID(ctx context.Context, id string) error
+	GetEquipmentNeeds(ctx context.Context, classID string) (*EquipmentNeeds, error)
 }
 
-func NewEducationService(r Repository) Service {
-	return &educationService{r}
+// NewEducationService bounds list calls by paging. LiveCourses sends a fresh
+// page every liveInterval. Lesson slots are wall clock times in loc.
+func NewEducationService(r Repository, paging config.Paging, liveInterval time.Duration, loc *time.Location) Service {
+	return &educationService{r, paging, liveInterval, loc}
 }
 
 type Course struct {
@@ -31,43 +89,176 @@ type Course struct {
 	Name      string    `json:"name"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+	YearID    *string   `json:"year_id,omitempty"` // nil for courses from before academic years
 }
 
 type Class struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	CourseID     string    `json:"course_id"`
+	Course       *Course   `json:"course,omitempty"`  // optional: populated when joined
+	TermID       *string   `json:"term_id,omitempty"` // nil for classes from before terms
+	StudentCount int       `json:"student_count"`     // stands in for enrolment until students are kept
+}
+
+// AcademicYear and Term run from StartsOn through EndsOn, both whole days.
+type AcademicYear struct {
 	ID        string    `json:"id"`
 	Name      string    `json:"name"`
+	StartsOn  time.Time `json:"starts_on"`
+	EndsOn    time.Time `json:"ends_on"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
-	CourseID  string    `json:"course_id"`
-	Course    *Course   `json:"course,omitempty"` // optional: populated when joined
 }
 
-type educationService struct {
-	repository Repository
+type Term struct {
+	ID        string    `json:"id"`
+	YearID    string    `json:"year_id"`
+	Name      string    `json:"name"`
+	StartsOn  time.Time `json:"starts_on"`
+	EndsOn    time.Time `json:"ends_on"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// LessonSlot is a lesson a class has every week. StartMinute and EndMinute
+// count from midnight in the school's time zone.
+type LessonSlot struct {
+	ID          string       `json:"id"`
+	ClassID     string       `json:"class_id"`
+	Weekday     time.Weekday `json:"weekday"`
+	StartMinute int          `json:"start_minute"`
+	EndMinute   int          `json:"end_minute"`
+	RoomID      *string      `json:"room_id,omitempty"` // an inventory ROOM location
+	Teacher     string       `json:"teacher"`
+	CreatedAt   time.Time    `json:"created_at"`
+	UpdatedAt   time.Time    `json:"updated_at"`
 }
 
-func (s *educationService) defaultSkipTake(skip *uint64, take *uint64) (*uint64, *uint64) {
-	const defaultSkip uint64 = 0
-	const defaultTake uint64 = 50
+// LessonException drops lessons from StartsOn through EndsOn, those of one
+// slot for a cancellation, all of them for a holiday.
+type LessonException struct {
+	ID        string    `json:"id"`
+	SlotID    *string   `json:"slot_id,omitempty"`
+	Kind      string    `json:"kind"`
+	StartsOn  time.Time `json:"starts_on"`
+	EndsOn    time.Time `json:"ends_on"`
+	Note      string    `json:"note"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Lesson is one occurrence of a slot.
+type Lesson struct {
+	SlotID   string
+	ClassID  string
+	RoomID   *string
+	Teacher  string
+	StartsAt time.Time
+	EndsAt   time.Time
+}
 
+// EquipmentRequirement asks for Quantity items of ItemType for every
+// PerStudents students of a class, or for Quantity in total when PerStudents
+// is 0. ItemType matches the item type of inventory items.
+type EquipmentRequirement struct {
+	ID          string    `json:"id"`
+	CourseID    string    `json:"course_id"`
+	ItemType    string    `json:"item_type"`
+	Quantity    int       `json:"quantity"`
+	PerStudents int       `json:"per_students"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// Needed is how many items a class of students needs, a started group counts
+// as a whole one.
+func (e *EquipmentRequirement) Needed(students int) int {
+	if e.PerStudents == 0 {
+		return e.Quantity
+	}
+	return e.Quantity * ((students + e.PerStudents - 1) / e.PerStudents)
+}
+
+// EquipmentNeeds is what a class needs for each of its lessons.
+type EquipmentNeeds struct {
+	StudentCount int
+	Needs        []*EquipmentNeed
+}
+
+type EquipmentNeed struct {
+	ItemType string
+	Quantity int
+}
+
+// Rollover counts what a rollover copied.
+type Rollover struct {
+	Courses int
+	Classes int
+}
+
+type educationService struct {
+	repository   Repository
+	paging       config.Paging
+	liveInterval time.Duration
+	loc          *time.Location
+}
+
+func (s *educationService) defaultSkipTake(skip *uint64, take *uint64) (*uint64, *uint64, error) {
 	if skip == nil {
 		skip = new(uint64)
-		*skip = defaultSkip
 	}
-	if take == nil {
-		take = new(uint64)
-		*take = defaultTake
+	t, err := s.paging.Take(take)
+	if err != nil {
+		return nil, nil, err
 	}
+	return skip, &t, nil
+}
 
-	return skip, take
+// yearOrCurrent returns yearID, or the current year's when it is nil. It
+// stays nil while there are no academic years.
+func (s *educationService) yearOrCurrent(ctx context.Context, yearID *string) (*string, error) {
+	if yearID != nil {
+		return yearID, nil
+	}
+	y, err := s.repository.CurrentAcademicYear(ctx, time.Now())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &y.ID, nil
+}
+
+// termOrCurrent is yearOrCurrent for terms.
+func (s *educationService) termOrCurrent(ctx context.Context, termID *string) (*string, error) {
+	if termID != nil {
+		return termID, nil
+	}
+	t, err := s.repository.CurrentTerm(ctx, time.Now())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &t.ID, nil
 }
 
-func (s *educationService) PostCourse(ctx context.Context, name string) (*Course, error) {
+func (s *educationService) PostCourse(ctx context.Context, name string, yearID *string) (*Course, error) {
+	yearID, err := s.yearOrCurrent(ctx, yearID)
+	if err != nil {
+		return nil, err
+	}
+
 	c := &Course{
 		ID:        ksuid.New().String(),
 		Name:      name,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
+		YearID:    yearID,
 	}
 
 	if err := s.repository.PutCourse(ctx, c); err != nil {
@@ -80,9 +271,31 @@ func (s *educationService) GetCourse(ctx context.Context, id string) (*Course, e
 	return s.repository.GetCourseByID(ctx, id)
 }
 
-func (s *educationService) GetCourses(ctx context.Context, skip *uint64, take *uint64) ([]*Course, error) {
-	skip, take = s.defaultSkipTake(skip, take)
-	return s.repository.ListCourses(ctx, *skip, *take)
+// GetCourseByName looks the name up in the current year.
+func (s *educationService) GetCourseByName(ctx context.Context, name string) (*Course, error) {
+	yearID, err := s.yearOrCurrent(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.repository.GetCourseByName(ctx, name, yearID)
+}
+
+// GetCourses lists the courses of yearID, or of the current year when it is
+// nil.
+func (s *educationService) GetCourses(ctx context.Context, skip *uint64, take *uint64, yearID *string) ([]*Course, error) {
+	skip, take, err := s.defaultSkipTake(skip, take)
+	if err != nil {
+		return nil, err
+	}
+	yearID, err = s.yearOrCurrent(ctx, yearID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repository.ListCourses(ctx, *skip, *take, yearID)
+}
+
+func (s *educationService) GetCoursesByIDs(ctx context.Context, ids []string) ([]*Course, error) {
+	return s.repository.ListCoursesByIDs(ctx, ids)
 }
 
 func (s *educationService) DeleteCourseByID(ctx context.Context, id string) error {
@@ -109,8 +322,16 @@ func (s *educationService) UpdateCourse(ctx context.Context, id string, name *st
 	return updated, nil
 }
 
+// LiveCourses sends a page of the current year's courses every liveInterval.
 func (s *educationService) LiveCourses(ctx context.Context, skip *uint64, take *uint64) (<-chan []*Course, error) {
-	skip, take = s.defaultSkipTake(skip, take)
+	skip, take, err := s.defaultSkipTake(skip, take)
+	if err != nil {
+		return nil, err
+	}
+	yearID, err := s.yearOrCurrent(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
 
 	// Create a buffered channel to avoid blocking
 	coursesChan := make(chan []*Course)
@@ -119,7 +340,7 @@ func (s *educationService) LiveCourses(ctx context.Context, skip *uint64, take *
 	go func() {
 		defer close(coursesChan)
 
-		ticker := time.NewTicker(5 * time.Second)
+		ticker := time.NewTicker(s.liveInterval)
 		defer ticker.Stop()
 
 		for {
@@ -128,10 +349,10 @@ func (s *educationService) LiveCourses(ctx context.Context, skip *uint64, take *
 				return
 			case <-ticker.C:
 				// Get the current batch of courses
-				courses, err := s.repository.ListCourses(ctx, *skip, *take)
+				courses, err := s.repository.ListCourses(ctx, *skip, *take, yearID)
 				if err != nil {
 					// Log the error but continue the stream
-					log.Printf("Error fetching courses: %v", err)
+					slog.ErrorContext(ctx, "Error fetching courses", "err", err)
 					continue
 				}
 
@@ -148,36 +369,67 @@ func (s *educationService) LiveCourses(ctx context.Context, skip *uint64, take *
 	return coursesChan, nil
 }
 
-func (s *educationService) PostClass(ctx context.Context, name, courseID string) (*Class, error) {
+func (s *educationService) PostClass(ctx context.Context, name, courseID string, termID *string) (*Class, error) {
+	termID, err := s.termOrCurrent(ctx, termID)
+	if err != nil {
+		return nil, err
+	}
+
 	c := &Class{
 		ID:        ksuid.New().String(),
 		Name:      name,
 		CourseID:  courseID,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
+		TermID:    termID,
+	}
+	if err := s.checkClassPeriod(ctx, c); err != nil {
+		return nil, err
 	}
 
 	if err := s.repository.PutClass(ctx, c); err != nil {
 		return nil, err
 	}
 
-	return c, nil
+	return s.repository.GetClassByID(ctx, c.ID)
 }
 
 func (s *educationService) GetClass(ctx context.Context, id string) (*Class, error) {
-	return s.GetClass(ctx, id)
+	return s.repository.GetClassByID(ctx, id)
+}
+
+// GetClassByName looks the name up in the current term.
+func (s *educationService) GetClassByName(ctx context.Context, name string) (*Class, error) {
+	termID, err := s.termOrCurrent(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.repository.GetClassByName(ctx, name, termID)
+}
+
+// GetClasses lists the classes of termID, or of the current term when it is
+// nil.
+func (s *educationService) GetClasses(ctx context.Context, skip *uint64, take *uint64, termID *string) ([]*Class, error) {
+	skip, take, err := s.defaultSkipTake(skip, take)
+	if err != nil {
+		return nil, err
+	}
+	termID, err = s.termOrCurrent(ctx, termID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repository.ListClasses(ctx, *skip, *take, termID)
 }
 
-func (s *educationService) GetClasses(ctx context.Context, skip *uint64, take *uint64) ([]*Class, error) {
-	skip, take = s.defaultSkipTake(skip, take)
-	return s.repository.ListClasses(ctx, *skip, *take)
+func (s *educationService) GetClassesByIDs(ctx context.Context, ids []string) ([]*Class, error) {
+	return s.repository.ListClassesByIDs(ctx, ids)
 }
 
 func (s *educationService) DeleteClassByID(ctx context.Context, id string) error {
 	return s.repository.DeleteClassByID(ctx, id)
 }
 
-func (s *educationService) UpdateClass(ctx context.Context, id string, name *string, courseID *string) (*Class, error) {
+func (s *educationService) UpdateClass(ctx context.Context, id string, name *string, courseID *string, termID *string, studentCount *int) (*Class, error) {
 	existing, err := s.repository.GetClassByID(ctx, id)
 	if err != nil {
 		return nil, err
@@ -189,6 +441,17 @@ func (s *educationService) UpdateClass(ctx context.Context, id string, name *str
 	if courseID != nil {
 		existing.CourseID = *courseID
 	}
+	if termID != nil {
+		existing.TermID = termID
+	}
+	if studentCount != nil {
+		existing.StudentCount = *studentCount
+	}
+	if courseID != nil || termID != nil {
+		if err := s.checkClassPeriod(ctx, existing); err != nil {
+			return nil, err
+		}
+	}
 
 	existing.UpdatedAt = time.Now()
 
@@ -199,3 +462,557 @@ func (s *educationService) UpdateClass(ctx context.Context, id string, name *str
 
 	return updated, nil
 }
+
+// checkClassPeriod makes sure a class's term is in its course's year. Classes
+// or courses without a period are left alone.
+func (s *educationService) checkClassPeriod(ctx context.Context, c *Class) error {
+	if c.TermID == nil {
+		return nil
+	}
+	course, err := s.repository.GetCourseByID(ctx, c.CourseID)
+	if err != nil {
+		return err
+	}
+	if course.YearID == nil {
+		return nil
+	}
+	term, err := s.repository.GetTermByID(ctx, *c.TermID)
+	if err != nil {
+		return err
+	}
+	if term.YearID != *course.YearID {
+		return ErrPeriodMismatch
+	}
+	return nil
+}
+
+func (s *educationService) PostAcademicYear(ctx context.Context, name string, startsOn, endsOn time.Time) (*AcademicYear, error) {
+	startsOn, endsOn = day(startsOn), day(endsOn)
+	if !endsOn.After(startsOn) {
+		return nil, ErrInvalidPeriod
+	}
+	overlaps, err := s.repository.AcademicYearOverlaps(ctx, startsOn, endsOn)
+	if err != nil {
+		return nil, err
+	}
+	if overlaps {
+		return nil, ErrPeriodOverlap
+	}
+
+	y := &AcademicYear{
+		ID:        ksuid.New().String(),
+		Name:      name,
+		StartsOn:  startsOn,
+		EndsOn:    endsOn,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	if err := s.repository.PutAcademicYear(ctx, y); err != nil {
+		return nil, err
+	}
+	return y, nil
+}
+
+func (s *educationService) GetAcademicYears(ctx context.Context, skip *uint64, take *uint64) ([]*AcademicYear, error) {
+	skip, take, err := s.defaultSkipTake(skip, take)
+	if err != nil {
+		return nil, err
+	}
+	return s.repository.ListAcademicYears(ctx, *skip, *take)
+}
+
+// DeleteAcademicYearByID only deletes years without terms or courses, to keep
+// their history the year has to be emptied first.
+func (s *educationService) DeleteAcademicYearByID(ctx context.Context, id string) error {
+	return s.repository.DeleteAcademicYearByID(ctx, id)
+}
+
+func (s *educationService) PostTerm(ctx context.Context, yearID, name string, startsOn, endsOn time.Time) (*Term, error) {
+	startsOn, endsOn = day(startsOn), day(endsOn)
+	if !endsOn.After(startsOn) {
+		return nil, ErrInvalidPeriod
+	}
+	year, err := s.repository.GetAcademicYearByID(ctx, yearID)
+	if err != nil {
+		return nil, err
+	}
+	if startsOn.Before(year.StartsOn) || endsOn.After(year.EndsOn) {
+		return nil, ErrTermOutsideYear
+	}
+	terms, err := s.repository.ListTerms(ctx, yearID)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range terms {
+		if !startsOn.After(t.EndsOn) && !endsOn.Before(t.StartsOn) {
+			return nil, ErrPeriodOverlap
+		}
+	}
+
+	t := &Term{
+		ID:        ksuid.New().String(),
+		YearID:    yearID,
+		Name:      name,
+		StartsOn:  startsOn,
+		EndsOn:    endsOn,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	if err := s.repository.PutTerm(ctx, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (s *educationService) GetTerms(ctx context.Context, yearID string) ([]*Term, error) {
+	return s.repository.ListTerms(ctx, yearID)
+}
+
+// GetCurrentTerm returns sql.ErrNoRows while no term has started yet.
+func (s *educationService) GetCurrentTerm(ctx context.Context) (*Term, error) {
+	return s.repository.CurrentTerm(ctx, time.Now())
+}
+
+func (s *educationService) DeleteTermByID(ctx context.Context, id string) error {
+	return s.repository.DeleteTermByID(ctx, id)
+}
+
+// RolloverYear copies the courses of one year into the next, with their
+// equipment, and the classes of each term into the term at the same position
+// in the next year. Courses and classes the target already has by name are
+// left alone, so a rollover can be run again after more were added to the old
+// year. Copied classes start without students.
+func (s *educationService) RolloverYear(ctx context.Context, fromYearID, toYearID string) (*Rollover, error) {
+	if fromYearID == toYearID {
+		return nil, ErrRolloverSame
+	}
+	if _, err := s.repository.GetAcademicYearByID(ctx, fromYearID); err != nil {
+		return nil, err
+	}
+	if _, err := s.repository.GetAcademicYearByID(ctx, toYearID); err != nil {
+		return nil, err
+	}
+
+	fromCourses, err := s.repository.ListCoursesInYear(ctx, fromYearID)
+	if err != nil {
+		return nil, err
+	}
+	toCourses, err := s.repository.ListCoursesInYear(ctx, toYearID)
+	if err != nil {
+		return nil, err
+	}
+	existingCourses := map[string]string{}
+	for _, c := range toCourses {
+		existingCourses[c.Name] = c.ID
+	}
+
+	now := time.Now()
+	var newCourses []*Course
+	var newEquipment []*EquipmentRequirement
+	courseIDs := map[string]string{} // old course ID to its copy
+	for _, c := range fromCourses {
+		if id, ok := existingCourses[c.Name]; ok {
+			courseIDs[c.ID] = id
+			continue
+		}
+		copied := &Course{
+			ID:        ksuid.New().String(),
+			Name:      c.Name,
+			CreatedAt: now,
+			UpdatedAt: now,
+			YearID:    &toYearID,
+		}
+		courseIDs[c.ID] = copied.ID
+		newCourses = append(newCourses, copied)
+
+		equipment, err := s.repository.ListEquipmentRequirements(ctx, c.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range equipment {
+			newEquipment = append(newEquipment, &EquipmentRequirement{
+				ID:          ksuid.New().String(),
+				CourseID:    copied.ID,
+				ItemType:    e.ItemType,
+				Quantity:    e.Quantity,
+				PerStudents: e.PerStudents,
+				CreatedAt:   now,
+				UpdatedAt:   now,
+			})
+		}
+	}
+
+	fromTerms, err := s.repository.ListTerms(ctx, fromYearID)
+	if err != nil {
+		return nil, err
+	}
+	toTerms, err := s.repository.ListTerms(ctx, toYearID)
+	if err != nil {
+		return nil, err
+	}
+
+	var newClasses []*Class
+	for i, term := range fromTerms {
+		classes, err := s.repository.ListClassesInTerm(ctx, term.ID)
+		if err != nil {
+			return nil, err
+		}
+		if len(classes) == 0 {
+			continue
+		}
+		if i >= len(toTerms) {
+			return nil, ErrRolloverTerms
+		}
+
+		target := toTerms[i]
+		existing, err := s.repository.ListClassesInTerm(ctx, target.ID)
+		if err != nil {
+			return nil, err
+		}
+		existingClasses := map[string]bool{}
+		for _, c := range existing {
+			existingClasses[c.Name] = true
+		}
+
+		for _, c := range classes {
+			if existingClasses[c.Name] {
+				continue
+			}
+			courseID, ok := courseIDs[c.CourseID]
+			if !ok {
+				// The course isn't in the old year, the copy keeps using it.
+				courseID = c.CourseID
+			}
+			newClasses = append(newClasses, &Class{
+				ID:        ksuid.New().String(),
+				Name:      c.Name,
+				CourseID:  courseID,
+				CreatedAt: now,
+				UpdatedAt: now,
+				TermID:    &target.ID,
+			})
+		}
+	}
+
+	if err := s.repository.PutRollover(ctx, newCourses, newClasses, newEquipment); err != nil {
+		return nil, err
+	}
+	slog.InfoContext(ctx, "Rolled over academic year", "from", fromYearID, "to", toYearID,
+		"courses", len(newCourses), "classes", len(newClasses))
+	return &Rollover{Courses: len(newCourses), Classes: len(newClasses)}, nil
+}
+
+func (s *educationService) PostLessonSlot(ctx context.Context, classID string, weekday time.Weekday, startMinute, endMinute int, roomID *string, teacher string) (*LessonSlot, error) {
+	if _, err := s.repository.GetClassByID(ctx, classID); err != nil {
+		return nil, err
+	}
+
+	l := &LessonSlot{
+		ID:          ksuid.New().String(),
+		ClassID:     classID,
+		Weekday:     weekday,
+		StartMinute: startMinute,
+		EndMinute:   endMinute,
+		RoomID:      roomID,
+		Teacher:     teacher,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+	if !l.valid() {
+		return nil, ErrInvalidSlot
+	}
+
+	if err := s.repository.PutLessonSlot(ctx, l); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (s *educationService) UpdateLessonSlot(ctx context.Context, id string, weekday *time.Weekday, startMinute, endMinute *int, roomID *string, clearRoom bool, teacher *string) (*LessonSlot, error) {
+	existing, err := s.repository.GetLessonSlotByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if weekday != nil {
+		existing.Weekday = *weekday
+	}
+	if startMinute != nil {
+		existing.StartMinute = *startMinute
+	}
+	if endMinute != nil {
+		existing.EndMinute = *endMinute
+	}
+	if clearRoom {
+		existing.RoomID = nil
+	} else if roomID != nil {
+		existing.RoomID = roomID
+	}
+	if teacher != nil {
+		existing.Teacher = *teacher
+	}
+	if !existing.valid() {
+		return nil, ErrInvalidSlot
+	}
+
+	existing.UpdatedAt = time.Now()
+
+	return s.repository.UpdateLessonSlot(ctx, existing)
+}
+
+func (l *LessonSlot) valid() bool {
+	return l.Weekday >= time.Sunday && l.Weekday <= time.Saturday &&
+		l.StartMinute >= 0 && l.StartMinute < l.EndMinute && l.EndMinute <= 24*60
+}
+
+func (s *educationService) DeleteLessonSlotByID(ctx context.Context, id string) error {
+	return s.repository.DeleteLessonSlotByID(ctx, id)
+}
+
+func (s *educationService) GetLessonSlots(ctx context.Context, classID string) ([]*LessonSlot, error) {
+	return s.repository.ListLessonSlots(ctx, classID)
+}
+
+func (s *educationService) PostLessonException(ctx context.Context, slotID *string, kind string, startsOn, endsOn time.Time, note string) (*LessonException, error) {
+	startsOn, endsOn = day(startsOn), day(endsOn)
+	if endsOn.Before(startsOn) {
+		return nil, ErrInvalidException
+	}
+	switch {
+	case kind == LessonExceptionHoliday && slotID == nil:
+	case kind == LessonExceptionCancelled && slotID != nil:
+		if _, err := s.repository.GetLessonSlotByID(ctx, *slotID); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, ErrInvalidException
+	}
+
+	e := &LessonException{
+		ID:        ksuid.New().String(),
+		SlotID:    slotID,
+		Kind:      kind,
+		StartsOn:  startsOn,
+		EndsOn:    endsOn,
+		Note:      note,
+		CreatedAt: time.Now(),
+	}
+	if err := s.repository.PutLessonException(ctx, e); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// GetLessonExceptions returns the exceptions that share a day with from
+// through to.
+func (s *educationService) GetLessonExceptions(ctx context.Context, from, to time.Time) ([]*LessonException, error) {
+	return s.repository.ListLessonExceptions(ctx, s.date(from), s.date(to))
+}
+
+func (s *educationService) DeleteLessonExceptionByID(ctx context.Context, id string) error {
+	return s.repository.DeleteLessonExceptionByID(ctx, id)
+}
+
+// GetLessons lists the lessons of a class that overlap from until to.
+func (s *educationService) GetLessons(ctx context.Context, classID string, from, to time.Time) ([]*Lesson, error) {
+	if !to.After(from) || to.Sub(from) > maxLessonWindow {
+		return nil, ErrLessonWindow
+	}
+	class, err := s.repository.GetClassByID(ctx, classID)
+	if err != nil {
+		return nil, err
+	}
+	return s.lessons(ctx, []*Class{class}, nil, from, to)
+}
+
+// GetLessonsInRoom lists the lessons under way in a room at the given time.
+func (s *educationService) GetLessonsInRoom(ctx context.Context, roomID string, at time.Time) ([]*Lesson, error) {
+	slots, err := s.repository.ListLessonSlotsInRoom(ctx, roomID, at.In(s.loc).Weekday())
+	if err != nil {
+		return nil, err
+	}
+	if len(slots) == 0 {
+		return []*Lesson{}, nil
+	}
+
+	seen := map[string]bool{}
+	var classIDs []string
+	for _, l := range slots {
+		if !seen[l.ClassID] {
+			seen[l.ClassID] = true
+			classIDs = append(classIDs, l.ClassID)
+		}
+	}
+	classes, err := s.repository.ListClassesByIDs(ctx, classIDs)
+	if err != nil {
+		return nil, err
+	}
+	// A lesson is under way when it overlaps the nanosecond starting at at.
+	return s.lessons(ctx, classes, slots, at, at.Add(time.Nanosecond))
+}
+
+// GetNextLesson returns the lesson of a class that is under way at after, or
+// else the first one to start after it. It returns nil when there is none
+// within a year or before the class's term ends.
+func (s *educationService) GetNextLesson(ctx context.Context, classID string, after time.Time) (*Lesson, error) {
+	class, err := s.repository.GetClassByID(ctx, classID)
+	if err != nil {
+		return nil, err
+	}
+	lessons, err := s.lessons(ctx, []*Class{class}, nil, after, after.Add(maxLessonWindow))
+	if err != nil {
+		return nil, err
+	}
+	if len(lessons) == 0 {
+		return nil, nil
+	}
+	return lessons[0], nil
+}
+
+// lessons expands the slots of classes into the lessons that overlap from
+// until to. It loads every slot of the classes when slots is nil.
+func (s *educationService) lessons(ctx context.Context, classes []*Class, slots []*LessonSlot, from, to time.Time) ([]*Lesson, error) {
+	terms := map[string]*Term{}
+	loaded := map[string]*Term{}
+	for _, c := range classes {
+		if slots == nil {
+			classSlots, err := s.repository.ListLessonSlots(ctx, c.ID)
+			if err != nil {
+				return nil, err
+			}
+			slots = append(slots, classSlots...)
+		}
+		if c.TermID == nil {
+			continue
+		}
+		t, ok := loaded[*c.TermID]
+		if !ok {
+			var err error
+			if t, err = s.repository.GetTermByID(ctx, *c.TermID); err != nil {
+				return nil, err
+			}
+			loaded[*c.TermID] = t
+		}
+		terms[c.ID] = t
+	}
+	if len(slots) == 0 {
+		return []*Lesson{}, nil
+	}
+
+	exceptions, err := s.repository.ListLessonExceptions(ctx, s.date(from), s.date(to))
+	if err != nil {
+		return nil, err
+	}
+	return expandLessons(slots, terms, exceptions, from, to, s.loc), nil
+}
+
+// expandLessons lists the lessons of slots that overlap from until to, by
+// start. Slots must be ordered by start time. A class only has lessons on the
+// days of its term, looked up in terms by class ID, and none on days an
+// exception covers.
+func expandLessons(slots []*LessonSlot, terms map[string]*Term, exceptions []*LessonException, from, to time.Time, loc *time.Location) []*Lesson {
+	lessons := []*Lesson{}
+	last := day(to.In(loc))
+	for d := day(from.In(loc)); !d.After(last); d = d.AddDate(0, 0, 1) {
+		for _, l := range slots {
+			if l.Weekday != d.Weekday() {
+				continue
+			}
+			if t, ok := terms[l.ClassID]; ok && (d.Before(day(t.StartsOn)) || d.After(day(t.EndsOn))) {
+				continue
+			}
+			if excepted(exceptions, l.ID, d) {
+				continue
+			}
+
+			year, month, date := d.Date()
+			startsAt := time.Date(year, month, date, 0, l.StartMinute, 0, 0, loc)
+			endsAt := time.Date(year, month, date, 0, l.EndMinute, 0, 0, loc)
+			if !startsAt.Before(to) || !endsAt.After(from) {
+				continue
+			}
+			lessons = append(lessons, &Lesson{
+				SlotID:   l.ID,
+				ClassID:  l.ClassID,
+				RoomID:   l.RoomID,
+				Teacher:  l.Teacher,
+				StartsAt: startsAt,
+				EndsAt:   endsAt,
+			})
+		}
+	}
+	return lessons
+}
+
+func excepted(exceptions []*LessonException, slotID string, d time.Time) bool {
+	for _, e := range exceptions {
+		if (e.SlotID == nil || *e.SlotID == slotID) && !d.Before(day(e.StartsOn)) && !d.After(day(e.EndsOn)) {
+			return true
+		}
+	}
+	return false
+}
+
+// date is the day t falls on in the school's time zone.
+func (s *educationService) date(t time.Time) time.Time {
+	return day(t.In(s.loc))
+}
+
+// PostEquipmentRequirement replaces the course's requirement for the same
+// item type.
+func (s *educationService) PostEquipmentRequirement(ctx context.Context, courseID, itemType string, quantity, perStudents int) (*EquipmentRequirement, error) {
+	itemType = strings.TrimSpace(itemType)
+	if itemType == "" || len(itemType) > 50 || quantity < 1 || perStudents < 0 {
+		return nil, ErrInvalidRequirement
+	}
+	if _, err := s.repository.GetCourseByID(ctx, courseID); err != nil {
+		return nil, err
+	}
+
+	e := &EquipmentRequirement{
+		ID:          ksuid.New().String(),
+		CourseID:    courseID,
+		ItemType:    itemType,
+		Quantity:    quantity,
+		PerStudents: perStudents,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+	if err := s.repository.PutEquipmentRequirement(ctx, e); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (s *educationService) GetEquipmentRequirements(ctx context.Context, courseID string) ([]*EquipmentRequirement, error) {
+	return s.repository.ListEquipmentRequirements(ctx, courseID)
+}
+
+func (s *educationService) DeleteEquipmentRequirementByID(ctx context.Context, id string) error {
+	return s.repository.DeleteEquipmentRequirementByID(ctx, id)
+}
+
+// GetEquipmentNeeds works out what a class needs from its course's
+// requirements and its student count.
+func (s *educationService) GetEquipmentNeeds(ctx context.Context, classID string) (*EquipmentNeeds, error) {
+	class, err := s.repository.GetClassByID(ctx, classID)
+	if err != nil {
+		return nil, err
+	}
+	requirements, err := s.repository.ListEquipmentRequirements(ctx, class.CourseID)
+	if err != nil {
+		return nil, err
+	}
+
+	needs := &EquipmentNeeds{StudentCount: class.StudentCount, Needs: []*EquipmentNeed{}}
+	for _, e := range requirements {
+		needs.Needs = append(needs.Needs, &EquipmentNeed{ItemType: e.ItemType, Quantity: e.Needed(class.StudentCount)})
+	}
+	return needs, nil
+}
+
+// day cuts t down to its date.
+func day(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
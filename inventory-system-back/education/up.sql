@@ -1,3 +1,25 @@
+CREATE TABLE IF NOT EXISTS academic_years (
+    id CHAR(27) PRIMARY KEY,
+    name VARCHAR(100) NOT NULL UNIQUE,
+    starts_on DATE NOT NULL,
+    ends_on DATE NOT NULL CHECK (ends_on > starts_on),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS terms (
+    id CHAR(27) PRIMARY KEY,
+    year_id CHAR(27) NOT NULL REFERENCES academic_years(id) ON DELETE RESTRICT,
+    name VARCHAR(100) NOT NULL,
+    starts_on DATE NOT NULL,
+    ends_on DATE NOT NULL CHECK (ends_on > starts_on),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS terms_year_name_key ON terms (year_id, name);
+CREATE INDEX IF NOT EXISTS terms_starts_on_idx ON terms (starts_on);
+
 CREATE TABLE IF NOT EXISTS courses (
     id CHAR(27) PRIMARY KEY,
     name VARCHAR(100) NOT NULL,
@@ -11,4 +33,62 @@ CREATE TABLE IF NOT EXISTS classes (
     created_at TIMESTAMP WITH TIME ZONE NOT NULL,
     updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
     course_id CHAR(27) REFERENCES courses(id) ON DELETE CASCADE
-);
\ No newline at end of file
+);
+
+-- Courses belong to a year and classes to a term. Rows from before years
+-- existed keep a NULL period. Names are only unique within a period, a
+-- rollover copies them into the next one.
+ALTER TABLE courses ADD COLUMN IF NOT EXISTS year_id CHAR(27) REFERENCES academic_years(id) ON DELETE RESTRICT;
+ALTER TABLE classes ADD COLUMN IF NOT EXISTS term_id CHAR(27) REFERENCES terms(id) ON DELETE RESTRICT;
+
+DROP INDEX IF EXISTS courses_name_key;
+DROP INDEX IF EXISTS classes_name_key;
+CREATE UNIQUE INDEX IF NOT EXISTS courses_year_name_key ON courses ((COALESCE(year_id, '')), name);
+CREATE UNIQUE INDEX IF NOT EXISTS classes_term_name_key ON classes ((COALESCE(term_id, '')), name);
+CREATE INDEX IF NOT EXISTS classes_course_idx ON classes (course_id);
+
+-- Weekdays are ISO, 1 is Monday. Times are minutes after midnight in the
+-- school's time zone, so lessons keep their wall clock time across DST.
+CREATE TABLE IF NOT EXISTS lesson_slots (
+    id CHAR(27) PRIMARY KEY,
+    class_id CHAR(27) NOT NULL REFERENCES classes(id) ON DELETE CASCADE,
+    weekday SMALLINT NOT NULL CHECK (weekday BETWEEN 1 AND 7),
+    start_minute SMALLINT NOT NULL CHECK (start_minute BETWEEN 0 AND 1439),
+    end_minute SMALLINT NOT NULL CHECK (end_minute > start_minute AND end_minute <= 1440),
+    room_id CHAR(27),
+    teacher VARCHAR(100) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS lesson_slots_class_idx ON lesson_slots (class_id);
+CREATE INDEX IF NOT EXISTS lesson_slots_room_idx ON lesson_slots (room_id, weekday);
+
+CREATE TABLE IF NOT EXISTS lesson_exceptions (
+    id CHAR(27) PRIMARY KEY,
+    slot_id CHAR(27) REFERENCES lesson_slots(id) ON DELETE CASCADE,
+    kind VARCHAR(20) NOT NULL,
+    starts_on DATE NOT NULL,
+    ends_on DATE NOT NULL CHECK (ends_on >= starts_on),
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS lesson_exceptions_dates_idx ON lesson_exceptions (starts_on, ends_on);
+
+-- Stands in for enrolment until students are kept.
+ALTER TABLE classes ADD COLUMN IF NOT EXISTS student_count INTEGER NOT NULL DEFAULT 0 CHECK (student_count >= 0);
+
+-- A course needs quantity items of item_type for every per_students
+-- students, or quantity in total when per_students is 0. item_type matches
+-- the item_type of inventory items.
+CREATE TABLE IF NOT EXISTS course_equipment (
+    id CHAR(27) PRIMARY KEY,
+    course_id CHAR(27) NOT NULL REFERENCES courses(id) ON DELETE CASCADE,
+    item_type VARCHAR(50) NOT NULL,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    per_students INTEGER NOT NULL DEFAULT 0 CHECK (per_students >= 0),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    UNIQUE (course_id, item_type)
+);
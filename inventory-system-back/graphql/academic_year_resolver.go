@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+
+	"github.com/jochem11/inventory-system-back/graphql/generated"
+)
+
+type academicYearResolver struct {
+	server *Server
+}
+
+// Terms isn't batched, a page of years is short and each has a few terms.
+func (r academicYearResolver) Terms(ctx context.Context, obj *generated.AcademicYear) ([]*generated.Term, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	return r.server.terms(ctx, obj.ID)
+}
+
+func (s *Server) terms(ctx context.Context, yearID string) ([]*generated.Term, error) {
+	res, err := s.educationClient.GetTerms(ctx, yearID)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	terms := []*generated.Term{}
+	for _, t := range res {
+		terms = append(terms, toGraphQLTerm(t))
+	}
+	return terms, nil
+}
@@ -0,0 +1,13 @@
+package main
+
+import "context"
+
+// checkClass makes sure an account is put in a class the education service
+// knows, the account service can't check that itself.
+func (s *Server) checkClass(ctx context.Context, classID *string) error {
+	if classID == nil {
+		return nil
+	}
+	_, err := s.educationClient.GetClass(ctx, *classID)
+	return err
+}
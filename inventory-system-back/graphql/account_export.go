@@ -0,0 +1,128 @@
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jochem11/inventory-system-back/account"
+)
+
+// accountExport is everything the services keep about one account, for a
+// subject access request. Lends, reservations, damage reports and audit
+// entries aren't kept by any service yet; they get a section once one does.
+type accountExport struct {
+	GeneratedAt time.Time        `json:"generatedAt"`
+	Account     *account.Account `json:"account"`
+	Enrolments  []*enrolment     `json:"enrolments"`
+}
+
+// enrolment is a class the account belongs to, as the education service
+// knows it.
+type enrolment struct {
+	ClassID    string  `json:"classId"`
+	ClassName  string  `json:"className"`
+	CourseID   string  `json:"courseId"`
+	CourseName string  `json:"courseName"`
+	TermID     *string `json:"termId,omitempty"`
+}
+
+func (s *Server) exportAccountData(ctx context.Context, accountID string, now time.Time) (*accountExport, error) {
+	a, err := s.accountClient.GetAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	export := &accountExport{GeneratedAt: now, Account: a, Enrolments: []*enrolment{}}
+
+	if a.ClassID != nil {
+		// A class deleted since is simply left out.
+		classes, err := s.educationClient.GetClassesByIDs(ctx, []string{*a.ClassID})
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range classes {
+			e := &enrolment{ClassID: c.ID, ClassName: c.Name, CourseID: c.CourseID, TermID: c.TermID}
+			if c.Course != nil {
+				e.CourseName = c.Course.Name
+			}
+			export.Enrolments = append(export.Enrolments, e)
+		}
+	}
+	return export, nil
+}
+
+// tables lays the export out as one table per section, headers first.
+func (e *accountExport) tables() []exportTable {
+	a := e.Account
+	emailVerifiedAt, totpEnabledAt, anonymisedAt := "", "", ""
+	if a.EmailVerifiedAt != nil {
+		emailVerifiedAt = a.EmailVerifiedAt.Format(time.RFC3339)
+	}
+	if a.TOTPEnabledAt != nil {
+		totpEnabledAt = a.TOTPEnabledAt.Format(time.RFC3339)
+	}
+	if a.AnonymisedAt != nil {
+		anonymisedAt = a.AnonymisedAt.Format(time.RFC3339)
+	}
+	classID := ""
+	if a.ClassID != nil {
+		classID = *a.ClassID
+	}
+
+	enrolments := [][]string{{"class_id", "class_name", "course_id", "course_name", "term_id"}}
+	for _, en := range e.Enrolments {
+		termID := ""
+		if en.TermID != nil {
+			termID = *en.TermID
+		}
+		enrolments = append(enrolments, []string{en.ClassID, en.ClassName, en.CourseID, en.CourseName, termID})
+	}
+
+	return []exportTable{
+		{"account", [][]string{
+			{"id", "first_name", "insertion", "last_name", "email", "card_number", "role", "state", "class_id", "language", "email_verified_at", "totp_enabled_at", "anonymised_at", "created_at", "updated_at"},
+			{a.ID, a.FirstName, a.Insertion, a.LastName, a.Email, a.CardNumber, a.Role, a.State, classID, a.Language, emailVerifiedAt, totpEnabledAt, anonymisedAt,
+				a.CreatedAt.Format(time.RFC3339), a.UpdatedAt.Format(time.RFC3339)},
+		}},
+		{"enrolments", enrolments},
+	}
+}
+
+type exportTable struct {
+	name string
+	rows [][]string
+}
+
+// zip bundles the export as export.json next to a CSV file per section.
+func (e *accountExport) zip(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+
+	w, err := zw.CreateHeader(&zip.FileHeader{Name: "export.json", Method: zip.Deflate, Modified: e.GeneratedAt})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	for _, t := range e.tables() {
+		w, err := zw.CreateHeader(&zip.FileHeader{Name: t.name + ".csv", Method: zip.Deflate, Modified: e.GeneratedAt})
+		if err != nil {
+			return nil, err
+		}
+		if err := writeTable(w, tableCSV, t.name, t.rows); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (e *accountExport) json() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
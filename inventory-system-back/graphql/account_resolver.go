@@ -0,0 +1,31 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jochem11/inventory-system-back/graphql/generated"
+	"github.com/vikstrous/dataloadgen"
+)
+
+type accountResolver struct {
+	server *Server
+}
+
+// Class crosses into the education service; an account whose class has been
+// deleted there resolves to null.
+func (r accountResolver) Class(ctx context.Context, obj *generated.Account) (*generated.Class, error) {
+	if obj.ClassID == nil {
+		return nil, nil
+	}
+
+	c, err := r.server.loaders(ctx).classes.Load(ctx, *obj.ClassID)
+	if errors.Is(err, dataloadgen.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	return toGraphQLClass(c), nil
+}
@@ -0,0 +1,209 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jochem11/inventory-system-back/account"
+	"github.com/jochem11/inventory-system-back/education"
+)
+
+// A feed covers the lessons of the past four weeks and the coming half year,
+// calendar apps keep what they fetched before that themselves.
+const (
+	calendarPast   = 28 * 24 * time.Hour
+	calendarFuture = 182 * 24 * time.Hour
+)
+
+// calendarToken signs a feed path for an account, so a feed URL can be
+// handed to a calendar app without the class IDs becoming guessable URLs.
+// The calendar version of the account is signed too, resetting the feeds of
+// the account bumps it and so ends its URLs.
+func (s *Server) calendarToken(path, accountID string, version int) string {
+	mac := hmac.New(sha256.New, s.calendarSecret)
+	mac.Write([]byte(path + "\x00" + accountID + "\x00" + strconv.Itoa(version)))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// classCalendarPath is the feed of a class for a, relative to the gateway. It
+// is empty while feeds are switched off.
+func (s *Server) classCalendarPath(a *account.Account, classID string) string {
+	if len(s.calendarSecret) == 0 {
+		return ""
+	}
+	path := "/calendar/classes/" + classID + ".ics"
+	return path + "?account=" + a.ID + "&token=" + s.calendarToken(path, a.ID, a.CalendarVersion)
+}
+
+// canFollowClass reports whether a may see the timetable of a class: staff
+// see every class, students their own.
+func canFollowClass(a *account.Account, classID string) bool {
+	if a.Role == account.RoleTeacher || a.Role == account.RoleAdmin {
+		return true
+	}
+	return a.ClassID != nil && *a.ClassID == classID
+}
+
+// calendarHandler serves /calendar/classes/<id>.ics?account=<id>&token=<token>,
+// the timetable of a class as an iCalendar (RFC 5545) feed. The feed stops
+// working once the account is no longer active, leaves the class or resets
+// its feeds.
+func (s *Server) calendarHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		name, ok := strings.CutPrefix(r.URL.Path, "/calendar/classes/")
+		classID, isICS := strings.CutSuffix(name, ".ics")
+		if !ok || !isICS || classID == "" || strings.Contains(classID, "/") {
+			http.NotFound(w, r)
+			return
+		}
+		q := r.URL.Query()
+		accountID := q.Get("account")
+		if accountID == "" {
+			http.Error(w, "invalid token", http.StatusForbidden)
+			return
+		}
+
+		ctx, cancel := s.withTimeout(r.Context())
+		defer cancel()
+
+		a, err := s.accountClient.GetAccount(ctx, accountID)
+		if err != nil && !isNotFound(err) {
+			logError(ctx, err)
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		if err != nil || a.State != account.StateActive || !canFollowClass(a, classID) ||
+			!hmac.Equal([]byte(q.Get("token")), []byte(s.calendarToken(r.URL.Path, a.ID, a.CalendarVersion))) {
+			http.Error(w, "invalid token", http.StatusForbidden)
+			return
+		}
+
+		body, err := s.classCalendar(ctx, classID, time.Now())
+		if isNotFound(err) {
+			http.NotFound(w, r)
+			return
+		}
+		if err != nil {
+			logError(ctx, err)
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+		w.Header().Set("Cache-Control", "private, max-age=900")
+		w.Write(body)
+	})
+}
+
+func (s *Server) classCalendar(ctx context.Context, classID string, now time.Time) ([]byte, error) {
+	class, err := s.educationClient.GetClass(ctx, classID)
+	if err != nil {
+		return nil, err
+	}
+	lessons, err := s.educationClient.GetLessons(ctx, classID, now.Add(-calendarPast), now.Add(calendarFuture))
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	var roomIDs []string
+	for _, l := range lessons {
+		if l.RoomID != nil && !seen[*l.RoomID] {
+			seen[*l.RoomID] = true
+			roomIDs = append(roomIDs, *l.RoomID)
+		}
+	}
+	rooms := map[string]string{} // a deleted room is left out
+	if len(roomIDs) > 0 {
+		locations, err := s.inventoryClient.GetLocationsByIDs(ctx, roomIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range locations {
+			rooms[l.ID] = l.Name
+		}
+	}
+
+	summary := class.Name
+	if class.Course != nil {
+		summary = class.Course.Name + " " + class.Name
+	}
+
+	var cal icalWriter
+	cal.line("BEGIN", "VCALENDAR")
+	cal.line("VERSION", "2.0")
+	cal.line("PRODID", "-//jochem11//inventory-system//EN")
+	cal.line("CALSCALE", "GREGORIAN")
+	cal.line("METHOD", "PUBLISH")
+	cal.text("X-WR-CALNAME", summary)
+	stamp := icalTime(now)
+	for _, l := range lessons {
+		cal.line("BEGIN", "VEVENT")
+		cal.line("UID", lessonUID(l))
+		cal.line("DTSTAMP", stamp)
+		cal.line("DTSTART", icalTime(l.StartsAt))
+		cal.line("DTEND", icalTime(l.EndsAt))
+		cal.text("SUMMARY", summary)
+		if l.RoomID != nil && rooms[*l.RoomID] != "" {
+			cal.text("LOCATION", rooms[*l.RoomID])
+		}
+		if l.Teacher != "" {
+			cal.text("DESCRIPTION", "Teacher: "+l.Teacher)
+		}
+		cal.line("END", "VEVENT")
+	}
+	cal.line("END", "VCALENDAR")
+	return cal.Bytes(), nil
+}
+
+// lessonUID stays the same while a slot keeps its day, so a calendar app
+// replaces a lesson that moved within the day instead of adding a second one.
+func lessonUID(l *education.Lesson) string {
+	return l.SlotID + "-" + l.StartsAt.UTC().Format("20060102") + "@inventory-system"
+}
+
+func icalTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// icalWriter writes content lines, folded at 75 octets and ended by CRLF.
+type icalWriter struct {
+	bytes.Buffer
+}
+
+func (w *icalWriter) line(name, value string) {
+	line := name + ":" + value
+	limit := 75
+	for len(line) > limit {
+		cut := limit
+		for !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		w.WriteString(line[:cut])
+		w.WriteString("\r\n ")
+		line = line[cut:]
+		limit = 74 // the leading space of a continuation counts too
+	}
+	w.WriteString(line)
+	w.WriteString("\r\n")
+}
+
+var icalEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+// text writes a TEXT value, escaped as RFC 5545 section 3.3.11 asks.
+func (w *icalWriter) text(name, value string) {
+	w.line(name, icalEscaper.Replace(value))
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+
+	"github.com/jochem11/inventory-system-back/graphql/generated"
+)
+
+type classResolver struct {
+	server *Server
+}
+
+func (r classResolver) Course(ctx context.Context, obj *generated.Class) (*generated.Course, error) {
+	c, err := r.server.loaders(ctx).courses.Load(ctx, obj.CourseID)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	return toGraphQLCourse(c), nil
+}
+
+func (r classResolver) CalendarPath(ctx context.Context, obj *generated.Class) (*string, error) {
+	v := viewer(ctx)
+	if v == nil || !canFollowClass(v, obj.ID) {
+		return nil, nil
+	}
+	path := r.server.classCalendarPath(v, obj.ID)
+	if path == "" {
+		return nil, nil
+	}
+	return &path, nil
+}
@@ -0,0 +1,266 @@
+package main
+
+import (
+	"github.com/jochem11/inventory-system-back/account"
+	"github.com/jochem11/inventory-system-back/education"
+	"github.com/jochem11/inventory-system-back/graphql/generated"
+	"github.com/jochem11/inventory-system-back/inventory"
+	"strings"
+)
+
+func toGraphQLItem(i *inventory.Item) *generated.Item {
+	return &generated.Item{
+		ID:          i.ID,
+		Name:        i.Name,
+		AssetTag:    i.AssetTag,
+		Description: i.Description,
+		Status:      generated.ItemStatus(i.Status),
+		Lendable:    i.Lendable(),
+		LocationID:  i.LocationID,
+		ItemType:    i.ItemType,
+		CreatedAt:   i.CreatedAt,
+		UpdatedAt:   i.UpdatedAt,
+	}
+}
+
+func toGraphQLItemMove(m *inventory.ItemMove) *generated.ItemMove {
+	return &generated.ItemMove{
+		ID:             m.ID,
+		ItemID:         m.ItemID,
+		FromLocationID: m.FromLocationID,
+		ToLocationID:   m.ToLocationID,
+		Note:           m.Note,
+		MovedAt:        m.MovedAt,
+	}
+}
+
+func toGraphQLLocation(l *inventory.Location) *generated.Location {
+	return &generated.Location{
+		ID:        l.ID,
+		ParentID:  l.ParentID,
+		Kind:      generated.LocationKind(l.Kind),
+		Name:      l.Name,
+		Code:      l.Code,
+		ClassID:   l.ClassID,
+		CreatedAt: l.CreatedAt,
+		UpdatedAt: l.UpdatedAt,
+	}
+}
+
+func toGraphQLMaintenanceTicket(t *inventory.MaintenanceTicket) *generated.MaintenanceTicket {
+	return &generated.MaintenanceTicket{
+		ID:               t.ID,
+		ItemID:           t.ItemID,
+		ScheduleID:       t.ScheduleID,
+		Kind:             generated.MaintenanceKind(t.Kind),
+		Status:           generated.MaintenanceTicketStatus(t.Status),
+		Description:      t.Description,
+		Vendor:           t.Vendor,
+		Technician:       t.Technician,
+		ExpectedReturnAt: t.ExpectedReturnAt,
+		CostCents:        int(t.CostCents),
+		ClosedAt:         t.ClosedAt,
+		CreatedAt:        t.CreatedAt,
+		UpdatedAt:        t.UpdatedAt,
+	}
+}
+
+func toGraphQLMaintenanceSchedule(m *inventory.MaintenanceSchedule) *generated.MaintenanceSchedule {
+	return &generated.MaintenanceSchedule{
+		ID:              m.ID,
+		ItemID:          m.ItemID,
+		Description:     m.Description,
+		IntervalMonths:  int(m.IntervalMonths),
+		NextDueAt:       m.NextDueAt,
+		LastPerformedAt: m.LastPerformedAt,
+		CreatedAt:       m.CreatedAt,
+		UpdatedAt:       m.UpdatedAt,
+	}
+}
+
+func toGraphQLConsumable(c *inventory.Consumable) *generated.Consumable {
+	return &generated.Consumable{
+		ID:                c.ID,
+		Sku:               c.SKU,
+		Name:              c.Name,
+		Unit:              c.Unit,
+		LowStockThreshold: int(c.LowStockThreshold),
+		OnHand:            int(c.OnHand),
+		LowStock:          c.LowStock(),
+		CreatedAt:         c.CreatedAt,
+		UpdatedAt:         c.UpdatedAt,
+	}
+}
+
+func toGraphQLStockLevel(l *inventory.StockLevel) *generated.StockLevel {
+	return &generated.StockLevel{
+		ConsumableID: l.ConsumableID,
+		LocationID:   l.LocationID,
+		Quantity:     int(l.Quantity),
+		UpdatedAt:    l.UpdatedAt,
+	}
+}
+
+func toGraphQLStockMovement(m *inventory.StockMovement) *generated.StockMovement {
+	return &generated.StockMovement{
+		ID:           m.ID,
+		ConsumableID: m.ConsumableID,
+		LocationID:   m.LocationID,
+		Kind:         generated.StockMovementKind(m.Kind),
+		Quantity:     int(m.Quantity),
+		Note:         m.Note,
+		CreatedAt:    m.CreatedAt,
+	}
+}
+
+func toGraphQLCourse(c *education.Course) *generated.Course {
+	return &generated.Course{
+		ID:        c.ID,
+		Name:      c.Name,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+		YearID:    c.YearID,
+	}
+}
+
+func toGraphQLClass(c *education.Class) *generated.Class {
+	return &generated.Class{
+		ID:           c.ID,
+		Name:         c.Name,
+		CourseID:     c.CourseID,
+		CreatedAt:    c.CreatedAt,
+		UpdatedAt:    c.UpdatedAt,
+		TermID:       c.TermID,
+		StudentCount: c.StudentCount,
+	}
+}
+
+func toGraphQLAcademicYear(y *education.AcademicYear) *generated.AcademicYear {
+	return &generated.AcademicYear{
+		ID:        y.ID,
+		Name:      y.Name,
+		StartsOn:  y.StartsOn,
+		EndsOn:    y.EndsOn,
+		CreatedAt: y.CreatedAt,
+		UpdatedAt: y.UpdatedAt,
+	}
+}
+
+func toGraphQLTerm(t *education.Term) *generated.Term {
+	return &generated.Term{
+		ID:        t.ID,
+		YearID:    t.YearID,
+		Name:      t.Name,
+		StartsOn:  t.StartsOn,
+		EndsOn:    t.EndsOn,
+		CreatedAt: t.CreatedAt,
+		UpdatedAt: t.UpdatedAt,
+	}
+}
+
+func toGraphQLLessonSlot(l *education.LessonSlot) *generated.LessonSlot {
+	return &generated.LessonSlot{
+		ID:        l.ID,
+		ClassID:   l.ClassID,
+		Weekday:   toGraphQLWeekday(l.Weekday),
+		StartTime: formatClock(l.StartMinute),
+		EndTime:   formatClock(l.EndMinute),
+		RoomID:    l.RoomID,
+		Teacher:   l.Teacher,
+		CreatedAt: l.CreatedAt,
+		UpdatedAt: l.UpdatedAt,
+	}
+}
+
+func toGraphQLLessonException(e *education.LessonException) *generated.LessonException {
+	return &generated.LessonException{
+		ID:        e.ID,
+		SlotID:    e.SlotID,
+		Kind:      generated.LessonExceptionKind(e.Kind),
+		StartsOn:  e.StartsOn,
+		EndsOn:    e.EndsOn,
+		Note:      e.Note,
+		CreatedAt: e.CreatedAt,
+	}
+}
+
+func toGraphQLLesson(l *education.Lesson) *generated.Lesson {
+	return &generated.Lesson{
+		SlotID:   l.SlotID,
+		ClassID:  l.ClassID,
+		RoomID:   l.RoomID,
+		Teacher:  l.Teacher,
+		StartsAt: l.StartsAt,
+		EndsAt:   l.EndsAt,
+	}
+}
+
+func toGraphQLLessons(res []*education.Lesson) []*generated.Lesson {
+	lessons := []*generated.Lesson{}
+	for _, l := range res {
+		lessons = append(lessons, toGraphQLLesson(l))
+	}
+	return lessons
+}
+
+func toGraphQLEquipmentRequirement(e *education.EquipmentRequirement) *generated.EquipmentRequirement {
+	return &generated.EquipmentRequirement{
+		ID:          e.ID,
+		CourseID:    e.CourseID,
+		ItemType:    e.ItemType,
+		Quantity:    e.Quantity,
+		PerStudents: e.PerStudents,
+		CreatedAt:   e.CreatedAt,
+		UpdatedAt:   e.UpdatedAt,
+	}
+}
+
+func toGraphQLAccount(a *account.Account) *generated.Account {
+	return &generated.Account{
+		ID:                a.ID,
+		FirstName:         a.FirstName,
+		Insertion:         a.Insertion,
+		LastName:          a.LastName,
+		Email:             a.Email,
+		CardNumber:        a.CardNumber,
+		Role:              generated.AccountRole(a.Role),
+		State:             generated.AccountState(a.State),
+		ClassID:           a.ClassID,
+		Language:          generated.AccountLanguage(strings.ToUpper(a.Language)),
+		EmailVerifiedAt:   a.EmailVerifiedAt,
+		TotpEnabledAt:     a.TOTPEnabledAt,
+		RecoveryCodesLeft: a.RecoveryCodesLeft,
+		AnonymisedAt:      a.AnonymisedAt,
+		CreatedAt:         a.CreatedAt,
+		UpdatedAt:         a.UpdatedAt,
+	}
+}
+
+func toGraphQLAccounts(res []*account.Account) []*generated.Account {
+	accounts := []*generated.Account{}
+	for _, a := range res {
+		accounts = append(accounts, toGraphQLAccount(a))
+	}
+	return accounts
+}
+
+func toGraphQLAccountBatch(b *account.BatchResult) *generated.AccountBatchResult {
+	skipped := []*generated.AccountBatchSkip{}
+	for _, s := range b.Skipped {
+		skipped = append(skipped, &generated.AccountBatchSkip{AccountID: s.AccountID, Reason: s.Reason})
+	}
+	return &generated.AccountBatchResult{Changed: toGraphQLAccounts(b.Changed), Skipped: skipped}
+}
+
+func toGraphQLAPIKey(k *account.APIKey) *generated.APIKey {
+	return &generated.APIKey{
+		ID:         k.ID,
+		AccountID:  k.AccountID,
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		Scopes:     k.Scopes,
+		LastUsedAt: k.LastUsedAt,
+		RevokedAt:  k.RevokedAt,
+		CreatedAt:  k.CreatedAt,
+	}
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"context"
+
+	"github.com/jochem11/inventory-system-back/graphql/generated"
+)
+
+type courseResolver struct {
+	server *Server
+}
+
+// Equipment isn't batched, like AcademicYear.terms; a course needs a few
+// item types at most.
+func (r courseResolver) Equipment(ctx context.Context, obj *generated.Course) ([]*generated.EquipmentRequirement, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	res, err := r.server.educationClient.GetEquipmentRequirements(ctx, obj.ID)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	requirements := []*generated.EquipmentRequirement{}
+	for _, e := range res {
+		requirements = append(requirements, toGraphQLEquipmentRequirement(e))
+	}
+	return requirements, nil
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/jochem11/inventory-system-back/education"
+	"github.com/jochem11/inventory-system-back/inventory"
+	"github.com/vikstrous/dataloadgen"
+)
+
+// loaderWait is how long a loader collects keys before it sends one batch RPC.
+const loaderWait = 2 * time.Millisecond
+
+// loaders batch and cache lookups by ID for the lifetime of a single request,
+// so nested fields cost one RPC per service and level instead of one per row.
+type loaders struct {
+	courses   *dataloadgen.Loader[string, *education.Course]
+	classes   *dataloadgen.Loader[string, *education.Class]
+	items     *dataloadgen.Loader[string, *inventory.Item]
+	locations *dataloadgen.Loader[string, *inventory.Location]
+}
+
+type loadersKey struct{}
+
+func newLoaders(s *Server) *loaders {
+	return &loaders{
+		courses:   mappedLoader(s.rpcTimeout, s.educationClient.GetCoursesByIDs, func(c *education.Course) string { return c.ID }),
+		classes:   mappedLoader(s.rpcTimeout, s.educationClient.GetClassesByIDs, func(c *education.Class) string { return c.ID }),
+		items:     mappedLoader(s.rpcTimeout, s.inventoryClient.GetItemsByIDs, func(i *inventory.Item) string { return i.ID }),
+		locations: mappedLoader(s.rpcTimeout, s.inventoryClient.GetLocationsByIDs, func(l *inventory.Location) string { return l.ID }),
+	}
+}
+
+func mappedLoader[V any](timeout time.Duration, fetch func(ctx context.Context, ids []string) ([]V, error), id func(V) string) *dataloadgen.Loader[string, V] {
+	return dataloadgen.NewMappedLoader(func(ctx context.Context, ids []string) (map[string]V, error) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		values, err := fetch(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+
+		byID := make(map[string]V, len(values))
+		for _, v := range values {
+			byID[id(v)] = v
+		}
+		return byID, nil
+	}, dataloadgen.WithWait(loaderWait))
+}
+
+// withLoaders gives every request its own set of loaders.
+func (s *Server) withLoaders(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), loadersKey{}, newLoaders(s))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func (s *Server) loaders(ctx context.Context) *loaders {
+	if l, ok := ctx.Value(loadersKey{}).(*loaders); ok {
+		return l
+	}
+	return newLoaders(s)
+}
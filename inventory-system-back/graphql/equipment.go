@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jochem11/inventory-system-back/graphql/generated"
+)
+
+var errNegativeCount = errors.New("counts and quantities can't be negative")
+
+// equipmentPlan sets what a class needs against what the inventory has.
+// Lendable counts what is available now, not at the lesson: nothing is
+// reserved ahead of time yet.
+func (s *Server) equipmentPlan(ctx context.Context, classID string) (*generated.EquipmentPlan, error) {
+	needs, err := s.educationClient.GetEquipmentNeeds(ctx, classID)
+	if err != nil {
+		return nil, err
+	}
+	next, err := s.educationClient.GetNextLesson(ctx, classID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &generated.EquipmentPlan{
+		ClassID:      classID,
+		StudentCount: needs.StudentCount,
+		Needs:        []*generated.EquipmentNeed{},
+	}
+	if next != nil {
+		plan.NextLesson = toGraphQLLesson(next)
+	}
+	if len(needs.Needs) == 0 {
+		return plan, nil
+	}
+
+	itemTypes := make([]string, len(needs.Needs))
+	for i, n := range needs.Needs {
+		itemTypes[i] = n.ItemType
+	}
+	counts, err := s.inventoryClient.GetItemTypeCounts(ctx, itemTypes)
+	if err != nil {
+		return nil, err
+	}
+	byType := map[string]int{}
+	for i, c := range counts {
+		byType[c.ItemType] = i
+	}
+
+	for _, n := range needs.Needs {
+		need := &generated.EquipmentNeed{ItemType: n.ItemType, Needed: n.Quantity}
+		if i, ok := byType[n.ItemType]; ok {
+			need.Total = counts[i].Total
+			need.Lendable = counts[i].Lendable
+		}
+		need.Shortage = max(0, need.Needed-need.Lendable)
+		plan.Short = plan.Short || need.Shortage > 0
+		plan.Needs = append(plan.Needs, need)
+	}
+	return plan, nil
+}
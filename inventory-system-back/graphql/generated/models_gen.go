@@ -3,16 +3,117 @@
 package generated
 
 import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
 	"time"
 )
 
+// Academic years and terms run from startsOn through endsOn, only the date counts.
+type AcademicYear struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	StartsOn  time.Time `json:"startsOn"`
+	EndsOn    time.Time `json:"endsOn"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type Account struct {
+	ID         string          `json:"id"`
+	FirstName  string          `json:"firstName"`
+	Insertion  string          `json:"insertion"`
+	LastName   string          `json:"lastName"`
+	Email      string          `json:"email"`
+	CardNumber string          `json:"cardNumber"`
+	Role       AccountRole     `json:"role"`
+	State      AccountState    `json:"state"`
+	ClassID    *string         `json:"classId,omitempty"`
+	Language   AccountLanguage `json:"language"`
+	// Cleared again when the email changes.
+	EmailVerifiedAt *time.Time `json:"emailVerifiedAt,omitempty"`
+	// Set while two-factor authentication is on.
+	TotpEnabledAt     *time.Time `json:"totpEnabledAt,omitempty"`
+	RecoveryCodesLeft int        `json:"recoveryCodesLeft"`
+	// Set once the personal details have been scrubbed, they are blank from then on.
+	AnonymisedAt *time.Time `json:"anonymisedAt,omitempty"`
+	CreatedAt    time.Time  `json:"createdAt"`
+	UpdatedAt    time.Time  `json:"updatedAt"`
+}
+
+// The accounts of a class a batch changed and the ones it skipped. Accounts it had nothing to do for are in neither.
+type AccountBatchResult struct {
+	Changed []*Account          `json:"changed"`
+	Skipped []*AccountBatchSkip `json:"skipped"`
+}
+
+type AccountBatchSkip struct {
+	AccountID string `json:"accountId"`
+	Reason    string `json:"reason"`
+}
+
+// Everything the services keep about an account, for a GDPR subject access request.
+type AccountDataExport struct {
+	AccountID   string    `json:"accountId"`
+	GeneratedAt time.Time `json:"generatedAt"`
+	JSON        string    `json:"json"`
+	// Base64 ZIP holding export.json and a CSV file per section. Null unless asked for.
+	Zip *string `json:"zip,omitempty"`
+}
+
+type AnonymiseAccountInput struct {
+	ID string `json:"id"`
+}
+
+type AnonymiseClassInput struct {
+	ClassID string `json:"classId"`
+}
+
+// Lets a SERVICE account use the API: send the key along as Authorization:
+// Bearer <key>, like a session token. Scopes look like inventory:read or
+// lend:write and cover the queries and mutations of that part of the API, write
+// includes read.
+type APIKey struct {
+	ID        string `json:"id"`
+	AccountID string `json:"accountId"`
+	Name      string `json:"name"`
+	// The start of the key, to tell keys apart by.
+	Prefix string   `json:"prefix"`
+	Scopes []string `json:"scopes"`
+	// Updated at most once a minute.
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	RevokedAt  *time.Time `json:"revokedAt,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
+type ChangePasswordInput struct {
+	CurrentPassword string `json:"currentPassword"`
+	NewPassword     string `json:"newPassword"`
+}
+
 type Class struct {
 	ID        string    `json:"id"`
 	Name      string    `json:"name"`
 	CreatedAt time.Time `json:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt"`
 	CourseID  string    `json:"courseId"`
-	Course    *Course   `json:"course"`
+	// The term, null for classes from before terms were kept.
+	TermID *string `json:"termId,omitempty"`
+	// Stands in for enrolment until students are kept.
+	StudentCount int `json:"studentCount"`
+}
+
+type Consumable struct {
+	ID                string    `json:"id"`
+	Sku               string    `json:"sku"`
+	Name              string    `json:"name"`
+	Unit              string    `json:"unit"`
+	LowStockThreshold int       `json:"lowStockThreshold"`
+	OnHand            int       `json:"onHand"`
+	LowStock          bool      `json:"lowStock"`
+	CreatedAt         time.Time `json:"createdAt"`
+	UpdatedAt         time.Time `json:"updatedAt"`
 }
 
 type Course struct {
@@ -20,28 +121,335 @@ type Course struct {
 	Name      string    `json:"name"`
 	CreatedAt time.Time `json:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt"`
+	// The academic year, null for courses from before years were kept.
+	YearID *string `json:"yearId,omitempty"`
+}
+
+type CreateAcademicYearInput struct {
+	Name     string    `json:"name"`
+	StartsOn time.Time `json:"startsOn"`
+	EndsOn   time.Time `json:"endsOn"`
+}
+
+type CreateAccountInput struct {
+	FirstName string  `json:"firstName"`
+	Insertion *string `json:"insertion,omitempty"`
+	// Empty for SERVICE accounts, like email.
+	LastName   string      `json:"lastName"`
+	Email      string      `json:"email"`
+	CardNumber *string     `json:"cardNumber,omitempty"`
+	Role       AccountRole `json:"role"`
+	ClassID    *string     `json:"classId,omitempty"`
+	// Defaults to NL.
+	Language *AccountLanguage `json:"language,omitempty"`
+}
+
+type CreateAPIKeyInput struct {
+	AccountID string   `json:"accountId"`
+	Name      string   `json:"name"`
+	Scopes    []string `json:"scopes"`
 }
 
 type CreateClassInput struct {
 	Name     string `json:"name"`
 	CourseID string `json:"courseId"`
+	// Defaults to the current term.
+	TermID *string `json:"termId,omitempty"`
+}
+
+type CreateConsumableInput struct {
+	Sku               string `json:"sku"`
+	Name              string `json:"name"`
+	Unit              string `json:"unit"`
+	LowStockThreshold *int   `json:"lowStockThreshold,omitempty"`
 }
 
 type CreateCourseInput struct {
 	Name string `json:"name"`
+	// Defaults to the current academic year.
+	YearID *string `json:"yearId,omitempty"`
+}
+
+type CreateEquipmentRequirementInput struct {
+	CourseID string `json:"courseId"`
+	ItemType string `json:"itemType"`
+	Quantity int    `json:"quantity"`
+	// Leave out or 0 for a fixed quantity.
+	PerStudents *int `json:"perStudents,omitempty"`
+}
+
+type CreateItemInput struct {
+	Name        string  `json:"name"`
+	AssetTag    string  `json:"assetTag"`
+	Description *string `json:"description,omitempty"`
+	LocationID  *string `json:"locationId,omitempty"`
+	ItemType    *string `json:"itemType,omitempty"`
+}
+
+type CreateLessonExceptionInput struct {
+	// Required for CANCELLED, must be left out for HOLIDAY.
+	SlotID   *string             `json:"slotId,omitempty"`
+	Kind     LessonExceptionKind `json:"kind"`
+	StartsOn time.Time           `json:"startsOn"`
+	EndsOn   time.Time           `json:"endsOn"`
+	Note     *string             `json:"note,omitempty"`
+}
+
+type CreateLessonSlotInput struct {
+	ClassID string  `json:"classId"`
+	Weekday Weekday `json:"weekday"`
+	// HH:MM
+	StartTime string `json:"startTime"`
+	// HH:MM, 24:00 for midnight.
+	EndTime string  `json:"endTime"`
+	RoomID  *string `json:"roomId,omitempty"`
+	Teacher *string `json:"teacher,omitempty"`
+}
+
+type CreateLocationInput struct {
+	ParentID *string      `json:"parentId,omitempty"`
+	Kind     LocationKind `json:"kind"`
+	Name     string       `json:"name"`
+	Code     string       `json:"code"`
+	ClassID  *string      `json:"classId,omitempty"`
+}
+
+type CreateMaintenanceScheduleInput struct {
+	ItemID         string     `json:"itemId"`
+	Description    string     `json:"description"`
+	IntervalMonths int        `json:"intervalMonths"`
+	FirstDueAt     *time.Time `json:"firstDueAt,omitempty"`
+}
+
+type CreateTermInput struct {
+	YearID   string    `json:"yearId"`
+	Name     string    `json:"name"`
+	StartsOn time.Time `json:"startsOn"`
+	EndsOn   time.Time `json:"endsOn"`
+}
+
+type CreatedAPIKey struct {
+	// Shown this once, only a hash of it is kept.
+	Key    string  `json:"key"`
+	APIKey *APIKey `json:"apiKey"`
+}
+
+type DeleteByIDAcademicYearInput struct {
+	ID string `json:"id"`
 }
 
 type DeleteByIDClassInput struct {
 	ID string `json:"id"`
 }
 
+type DeleteByIDConsumableInput struct {
+	ID string `json:"id"`
+}
+
 type DeleteByIDCourseInput struct {
 	ID string `json:"id"`
 }
 
+type DeleteByIDEquipmentRequirementInput struct {
+	ID string `json:"id"`
+}
+
+type DeleteByIDItemInput struct {
+	ID string `json:"id"`
+}
+
+type DeleteByIDLessonExceptionInput struct {
+	ID string `json:"id"`
+}
+
+type DeleteByIDLessonSlotInput struct {
+	ID string `json:"id"`
+}
+
+type DeleteByIDLocationInput struct {
+	ID string `json:"id"`
+}
+
+type DeleteByIDMaintenanceScheduleInput struct {
+	ID string `json:"id"`
+}
+
+type DeleteByIDTermInput struct {
+	ID string `json:"id"`
+}
+
+type DisableTotpInput struct {
+	// A code from the authenticator app or a recovery code.
+	Code string `json:"code"`
+}
+
+type EnableTotpInput struct {
+	Code string `json:"code"`
+}
+
+type EquipmentNeed struct {
+	ItemType string `json:"itemType"`
+	Needed   int    `json:"needed"`
+	// Items of the type in the inventory.
+	Total int `json:"total"`
+	// Of those, the ones that can be lent out right now.
+	Lendable int `json:"lendable"`
+	// How many lendable items are missing.
+	Shortage int `json:"shortage"`
+}
+
+// What a class needs for its lessons, checked against the inventory.
+type EquipmentPlan struct {
+	ClassID      string `json:"classId"`
+	StudentCount int    `json:"studentCount"`
+	// The lesson the equipment is wanted for next, null when none is coming up.
+	NextLesson *Lesson          `json:"nextLesson,omitempty"`
+	Needs      []*EquipmentNeed `json:"needs"`
+	// Whether any need is short.
+	Short bool `json:"short"`
+}
+
+// Equipment a course needs: quantity items of itemType for every perStudents
+// students of a class, or quantity in total when perStudents is 0.
+type EquipmentRequirement struct {
+	ID          string    `json:"id"`
+	CourseID    string    `json:"courseId"`
+	ItemType    string    `json:"itemType"`
+	Quantity    int       `json:"quantity"`
+	PerStudents int       `json:"perStudents"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+type ExportAccountDataInput struct {
+	ID string `json:"id"`
+	// Also bundle the export as a ZIP of CSV files.
+	Zip *bool `json:"zip,omitempty"`
+}
+
+type Item struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	AssetTag    string     `json:"assetTag"`
+	Description string     `json:"description"`
+	Status      ItemStatus `json:"status"`
+	Lendable    bool       `json:"lendable"`
+	LocationID  *string    `json:"locationId,omitempty"`
+	// Groups interchangeable items, such as "camera kit". Empty for items that aren't.
+	ItemType  string    `json:"itemType"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type ItemMove struct {
+	ID             string    `json:"id"`
+	ItemID         string    `json:"itemId"`
+	FromLocationID *string   `json:"fromLocationId,omitempty"`
+	ToLocationID   *string   `json:"toLocationId,omitempty"`
+	Note           string    `json:"note"`
+	MovedAt        time.Time `json:"movedAt"`
+}
+
+// One occurrence of a lesson slot.
+type Lesson struct {
+	SlotID   string    `json:"slotId"`
+	ClassID  string    `json:"classId"`
+	RoomID   *string   `json:"roomId,omitempty"`
+	Teacher  string    `json:"teacher"`
+	StartsAt time.Time `json:"startsAt"`
+	EndsAt   time.Time `json:"endsAt"`
+}
+
+// Drops lessons from startsOn through endsOn, only the date counts.
+type LessonException struct {
+	ID string `json:"id"`
+	// Set for CANCELLED, null for HOLIDAY.
+	SlotID    *string             `json:"slotId,omitempty"`
+	Kind      LessonExceptionKind `json:"kind"`
+	StartsOn  time.Time           `json:"startsOn"`
+	EndsOn    time.Time           `json:"endsOn"`
+	Note      string              `json:"note"`
+	CreatedAt time.Time           `json:"createdAt"`
+}
+
+// A lesson a class has every week. Times are HH:MM in the school's time zone.
+type LessonSlot struct {
+	ID        string  `json:"id"`
+	ClassID   string  `json:"classId"`
+	Weekday   Weekday `json:"weekday"`
+	StartTime string  `json:"startTime"`
+	EndTime   string  `json:"endTime"`
+	// A ROOM location.
+	RoomID    *string   `json:"roomId,omitempty"`
+	Teacher   string    `json:"teacher"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type Location struct {
+	ID        string       `json:"id"`
+	ParentID  *string      `json:"parentId,omitempty"`
+	Kind      LocationKind `json:"kind"`
+	Name      string       `json:"name"`
+	Code      string       `json:"code"`
+	ClassID   *string      `json:"classId,omitempty"`
+	CreatedAt time.Time    `json:"createdAt"`
+	UpdatedAt time.Time    `json:"updatedAt"`
+}
+
+type LoginInput struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+type MaintenanceSchedule struct {
+	ID              string     `json:"id"`
+	ItemID          string     `json:"itemId"`
+	Description     string     `json:"description"`
+	IntervalMonths  int        `json:"intervalMonths"`
+	NextDueAt       time.Time  `json:"nextDueAt"`
+	LastPerformedAt *time.Time `json:"lastPerformedAt,omitempty"`
+	CreatedAt       time.Time  `json:"createdAt"`
+	UpdatedAt       time.Time  `json:"updatedAt"`
+}
+
+type MaintenanceTicket struct {
+	ID               string                  `json:"id"`
+	ItemID           string                  `json:"itemId"`
+	ScheduleID       *string                 `json:"scheduleId,omitempty"`
+	Kind             MaintenanceKind         `json:"kind"`
+	Status           MaintenanceTicketStatus `json:"status"`
+	Description      string                  `json:"description"`
+	Vendor           string                  `json:"vendor"`
+	Technician       string                  `json:"technician"`
+	ExpectedReturnAt *time.Time              `json:"expectedReturnAt,omitempty"`
+	CostCents        int                     `json:"costCents"`
+	ClosedAt         *time.Time              `json:"closedAt,omitempty"`
+	CreatedAt        time.Time               `json:"createdAt"`
+	UpdatedAt        time.Time               `json:"updatedAt"`
+}
+
+type MoveItemInput struct {
+	ItemID string `json:"itemId"`
+	// Leave empty to take the item out of any location.
+	LocationID *string `json:"locationId,omitempty"`
+	Note       *string `json:"note,omitempty"`
+}
+
 type Mutation struct {
 }
 
+type OpenMaintenanceTicketInput struct {
+	ItemID           string          `json:"itemId"`
+	ScheduleID       *string         `json:"scheduleId,omitempty"`
+	Kind             MaintenanceKind `json:"kind"`
+	Description      string          `json:"description"`
+	Vendor           *string         `json:"vendor,omitempty"`
+	Technician       *string         `json:"technician,omitempty"`
+	ExpectedReturnAt *time.Time      `json:"expectedReturnAt,omitempty"`
+	CostCents        *int            `json:"costCents,omitempty"`
+}
+
 type PaginationInput struct {
 	Skip *int `json:"skip,omitempty"`
 	Take *int `json:"take,omitempty"`
@@ -50,16 +458,840 @@ type PaginationInput struct {
 type Query struct {
 }
 
+type RecordStockMovementInput struct {
+	ConsumableID string            `json:"consumableId"`
+	LocationID   string            `json:"locationId"`
+	Kind         StockMovementKind `json:"kind"`
+	// Positive amount for receive, issue and write-off; signed change for adjust.
+	Quantity int     `json:"quantity"`
+	Note     *string `json:"note,omitempty"`
+}
+
+type RequestPasswordResetInput struct {
+	Email string `json:"email"`
+}
+
+type ResetAccountTotpInput struct {
+	ID string `json:"id"`
+}
+
+type ResetPasswordInput struct {
+	Token    string `json:"token"`
+	Password string `json:"password"`
+}
+
+type RevokeAPIKeyInput struct {
+	ID string `json:"id"`
+}
+
+type RolloverResult struct {
+	// Courses copied into the new year.
+	Courses int `json:"courses"`
+	// Classes copied into the terms of the new year.
+	Classes int `json:"classes"`
+}
+
+type RolloverYearInput struct {
+	FromYearID string `json:"fromYearId"`
+	ToYearID   string `json:"toYearId"`
+}
+
+type SendVerificationEmailInput struct {
+	ID string `json:"id"`
+}
+
+// A logged in session. Send the token along as Authorization: Bearer <token>.
+type Session struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	Step      LoginStep `json:"step"`
+	Account   *Account  `json:"account"`
+}
+
+type SetAccountPasswordInput struct {
+	ID       string `json:"id"`
+	Password string `json:"password"`
+}
+
+type SetAccountStateInput struct {
+	ID    string       `json:"id"`
+	State AccountState `json:"state"`
+}
+
+type SetClassAccountStateInput struct {
+	ClassID string       `json:"classId"`
+	State   AccountState `json:"state"`
+}
+
+type StockLevel struct {
+	ConsumableID string    `json:"consumableId"`
+	LocationID   string    `json:"locationId"`
+	Quantity     int       `json:"quantity"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+type StockMovement struct {
+	ID           string            `json:"id"`
+	ConsumableID string            `json:"consumableId"`
+	LocationID   string            `json:"locationId"`
+	Kind         StockMovementKind `json:"kind"`
+	Quantity     int               `json:"quantity"`
+	Note         string            `json:"note"`
+	CreatedAt    time.Time         `json:"createdAt"`
+}
+
 type Subscription struct {
 }
 
+type Term struct {
+	ID        string    `json:"id"`
+	YearID    string    `json:"yearId"`
+	Name      string    `json:"name"`
+	StartsOn  time.Time `json:"startsOn"`
+	EndsOn    time.Time `json:"endsOn"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type TotpEnabled struct {
+	// Shown this once, each works once instead of a code.
+	RecoveryCodes []string `json:"recoveryCodes"`
+	// A DONE session, when enabled from an ENROL_TOTP session.
+	Session *Session `json:"session,omitempty"`
+}
+
+type TotpSetup struct {
+	Secret string `json:"secret"`
+	// otpauth:// URI to show as a QR code for authenticator apps to scan.
+	URI string `json:"uri"`
+}
+
+type UpdateAccountInput struct {
+	ID         string           `json:"id"`
+	FirstName  *string          `json:"firstName,omitempty"`
+	Insertion  *string          `json:"insertion,omitempty"`
+	LastName   *string          `json:"lastName,omitempty"`
+	Email      *string          `json:"email,omitempty"`
+	CardNumber *string          `json:"cardNumber,omitempty"`
+	Role       *AccountRole     `json:"role,omitempty"`
+	Language   *AccountLanguage `json:"language,omitempty"`
+	ClassID    *string          `json:"classId,omitempty"`
+	// Takes the account out of its class, classId is ignored then.
+	ClearClass *bool `json:"clearClass,omitempty"`
+}
+
 type UpdateClassInput struct {
-	ID       string  `json:"id"`
-	Name     *string `json:"name,omitempty"`
-	CourseID *string `json:"courseId,omitempty"`
+	ID           string  `json:"id"`
+	Name         *string `json:"name,omitempty"`
+	CourseID     *string `json:"courseId,omitempty"`
+	TermID       *string `json:"termId,omitempty"`
+	StudentCount *int    `json:"studentCount,omitempty"`
+}
+
+type UpdateConsumableInput struct {
+	ID                string  `json:"id"`
+	Sku               *string `json:"sku,omitempty"`
+	Name              *string `json:"name,omitempty"`
+	Unit              *string `json:"unit,omitempty"`
+	LowStockThreshold *int    `json:"lowStockThreshold,omitempty"`
 }
 
 type UpdateCourseInput struct {
 	ID   string  `json:"id"`
 	Name *string `json:"name,omitempty"`
 }
+
+type UpdateItemInput struct {
+	ID          string  `json:"id"`
+	Name        *string `json:"name,omitempty"`
+	AssetTag    *string `json:"assetTag,omitempty"`
+	Description *string `json:"description,omitempty"`
+	ItemType    *string `json:"itemType,omitempty"`
+}
+
+type UpdateLessonSlotInput struct {
+	ID        string   `json:"id"`
+	Weekday   *Weekday `json:"weekday,omitempty"`
+	StartTime *string  `json:"startTime,omitempty"`
+	EndTime   *string  `json:"endTime,omitempty"`
+	RoomID    *string  `json:"roomId,omitempty"`
+	// Takes the slot out of its room, roomId is ignored then.
+	ClearRoom *bool   `json:"clearRoom,omitempty"`
+	Teacher   *string `json:"teacher,omitempty"`
+}
+
+type UpdateLocationInput struct {
+	ID         string  `json:"id"`
+	Name       *string `json:"name,omitempty"`
+	Code       *string `json:"code,omitempty"`
+	ClassID    *string `json:"classId,omitempty"`
+	ClearClass *bool   `json:"clearClass,omitempty"`
+}
+
+type UpdateMaintenanceTicketInput struct {
+	ID               string                   `json:"id"`
+	Status           *MaintenanceTicketStatus `json:"status,omitempty"`
+	Vendor           *string                  `json:"vendor,omitempty"`
+	Technician       *string                  `json:"technician,omitempty"`
+	ExpectedReturnAt *time.Time               `json:"expectedReturnAt,omitempty"`
+	CostCents        *int                     `json:"costCents,omitempty"`
+}
+
+type VerifyEmailInput struct {
+	Token string `json:"token"`
+	// Required to accept an invitation for an account without a password.
+	Password *string `json:"password,omitempty"`
+}
+
+type VerifyTotpInput struct {
+	// A code from the authenticator app or a recovery code.
+	Code string `json:"code"`
+}
+
+// The language of the emails an account gets.
+type AccountLanguage string
+
+const (
+	AccountLanguageNl AccountLanguage = "NL"
+	AccountLanguageEn AccountLanguage = "EN"
+)
+
+var AllAccountLanguage = []AccountLanguage{
+	AccountLanguageNl,
+	AccountLanguageEn,
+}
+
+func (e AccountLanguage) IsValid() bool {
+	switch e {
+	case AccountLanguageNl, AccountLanguageEn:
+		return true
+	}
+	return false
+}
+
+func (e AccountLanguage) String() string {
+	return string(e)
+}
+
+func (e *AccountLanguage) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AccountLanguage(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AccountLanguage", str)
+	}
+	return nil
+}
+
+func (e AccountLanguage) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *AccountLanguage) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e AccountLanguage) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type AccountRole string
+
+const (
+	AccountRoleStudent AccountRole = "STUDENT"
+	AccountRoleTeacher AccountRole = "TEACHER"
+	AccountRoleAdmin   AccountRole = "ADMIN"
+	// Kiosks and integrations. They only have a first name, no email or card number, and use API keys instead of logging in.
+	AccountRoleService AccountRole = "SERVICE"
+)
+
+var AllAccountRole = []AccountRole{
+	AccountRoleStudent,
+	AccountRoleTeacher,
+	AccountRoleAdmin,
+	AccountRoleService,
+}
+
+func (e AccountRole) IsValid() bool {
+	switch e {
+	case AccountRoleStudent, AccountRoleTeacher, AccountRoleAdmin, AccountRoleService:
+		return true
+	}
+	return false
+}
+
+func (e AccountRole) String() string {
+	return string(e)
+}
+
+func (e *AccountRole) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AccountRole(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AccountRole", str)
+	}
+	return nil
+}
+
+func (e AccountRole) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *AccountRole) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e AccountRole) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// INVITED accounts become ACTIVE, ACTIVE ones can be SUSPENDED and back, and
+// students leave as GRADUATED. DELETED is final.
+type AccountState string
+
+const (
+	AccountStateInvited   AccountState = "INVITED"
+	AccountStateActive    AccountState = "ACTIVE"
+	AccountStateSuspended AccountState = "SUSPENDED"
+	AccountStateGraduated AccountState = "GRADUATED"
+	AccountStateDeleted   AccountState = "DELETED"
+)
+
+var AllAccountState = []AccountState{
+	AccountStateInvited,
+	AccountStateActive,
+	AccountStateSuspended,
+	AccountStateGraduated,
+	AccountStateDeleted,
+}
+
+func (e AccountState) IsValid() bool {
+	switch e {
+	case AccountStateInvited, AccountStateActive, AccountStateSuspended, AccountStateGraduated, AccountStateDeleted:
+		return true
+	}
+	return false
+}
+
+func (e AccountState) String() string {
+	return string(e)
+}
+
+func (e *AccountState) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AccountState(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AccountState", str)
+	}
+	return nil
+}
+
+func (e AccountState) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *AccountState) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e AccountState) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ItemStatus string
+
+const (
+	ItemStatusAvailable     ItemStatus = "AVAILABLE"
+	ItemStatusInMaintenance ItemStatus = "IN_MAINTENANCE"
+)
+
+var AllItemStatus = []ItemStatus{
+	ItemStatusAvailable,
+	ItemStatusInMaintenance,
+}
+
+func (e ItemStatus) IsValid() bool {
+	switch e {
+	case ItemStatusAvailable, ItemStatusInMaintenance:
+		return true
+	}
+	return false
+}
+
+func (e ItemStatus) String() string {
+	return string(e)
+}
+
+func (e *ItemStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ItemStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ItemStatus", str)
+	}
+	return nil
+}
+
+func (e ItemStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ItemStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ItemStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type LessonExceptionKind string
+
+const (
+	// No lessons at all, for instance a school holiday.
+	LessonExceptionKindHoliday LessonExceptionKind = "HOLIDAY"
+	// One slot's lessons are off.
+	LessonExceptionKindCancelled LessonExceptionKind = "CANCELLED"
+)
+
+var AllLessonExceptionKind = []LessonExceptionKind{
+	LessonExceptionKindHoliday,
+	LessonExceptionKindCancelled,
+}
+
+func (e LessonExceptionKind) IsValid() bool {
+	switch e {
+	case LessonExceptionKindHoliday, LessonExceptionKindCancelled:
+		return true
+	}
+	return false
+}
+
+func (e LessonExceptionKind) String() string {
+	return string(e)
+}
+
+func (e *LessonExceptionKind) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LessonExceptionKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LessonExceptionKind", str)
+	}
+	return nil
+}
+
+func (e LessonExceptionKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *LessonExceptionKind) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e LessonExceptionKind) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type LocationKind string
+
+const (
+	LocationKindBuilding LocationKind = "BUILDING"
+	LocationKindRoom     LocationKind = "ROOM"
+	LocationKindCabinet  LocationKind = "CABINET"
+	LocationKindShelf    LocationKind = "SHELF"
+)
+
+var AllLocationKind = []LocationKind{
+	LocationKindBuilding,
+	LocationKindRoom,
+	LocationKindCabinet,
+	LocationKindShelf,
+}
+
+func (e LocationKind) IsValid() bool {
+	switch e {
+	case LocationKindBuilding, LocationKindRoom, LocationKindCabinet, LocationKindShelf:
+		return true
+	}
+	return false
+}
+
+func (e LocationKind) String() string {
+	return string(e)
+}
+
+func (e *LocationKind) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LocationKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LocationKind", str)
+	}
+	return nil
+}
+
+func (e LocationKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *LocationKind) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e LocationKind) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// What a login still needs. DONE sessions can be used for everything. TOTP and
+// ENROL_TOTP sessions last a few minutes and are only good for verifyTotp, or
+// for beginTotp and enableTotp respectively.
+type LoginStep string
+
+const (
+	LoginStepDone      LoginStep = "DONE"
+	LoginStepTotp      LoginStep = "TOTP"
+	LoginStepEnrolTotp LoginStep = "ENROL_TOTP"
+)
+
+var AllLoginStep = []LoginStep{
+	LoginStepDone,
+	LoginStepTotp,
+	LoginStepEnrolTotp,
+}
+
+func (e LoginStep) IsValid() bool {
+	switch e {
+	case LoginStepDone, LoginStepTotp, LoginStepEnrolTotp:
+		return true
+	}
+	return false
+}
+
+func (e LoginStep) String() string {
+	return string(e)
+}
+
+func (e *LoginStep) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LoginStep(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LoginStep", str)
+	}
+	return nil
+}
+
+func (e LoginStep) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *LoginStep) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e LoginStep) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type MaintenanceKind string
+
+const (
+	MaintenanceKindRepair     MaintenanceKind = "REPAIR"
+	MaintenanceKindPreventive MaintenanceKind = "PREVENTIVE"
+)
+
+var AllMaintenanceKind = []MaintenanceKind{
+	MaintenanceKindRepair,
+	MaintenanceKindPreventive,
+}
+
+func (e MaintenanceKind) IsValid() bool {
+	switch e {
+	case MaintenanceKindRepair, MaintenanceKindPreventive:
+		return true
+	}
+	return false
+}
+
+func (e MaintenanceKind) String() string {
+	return string(e)
+}
+
+func (e *MaintenanceKind) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MaintenanceKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MaintenanceKind", str)
+	}
+	return nil
+}
+
+func (e MaintenanceKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *MaintenanceKind) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e MaintenanceKind) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type MaintenanceTicketStatus string
+
+const (
+	MaintenanceTicketStatusOpen       MaintenanceTicketStatus = "OPEN"
+	MaintenanceTicketStatusInProgress MaintenanceTicketStatus = "IN_PROGRESS"
+	MaintenanceTicketStatusCompleted  MaintenanceTicketStatus = "COMPLETED"
+	MaintenanceTicketStatusCancelled  MaintenanceTicketStatus = "CANCELLED"
+)
+
+var AllMaintenanceTicketStatus = []MaintenanceTicketStatus{
+	MaintenanceTicketStatusOpen,
+	MaintenanceTicketStatusInProgress,
+	MaintenanceTicketStatusCompleted,
+	MaintenanceTicketStatusCancelled,
+}
+
+func (e MaintenanceTicketStatus) IsValid() bool {
+	switch e {
+	case MaintenanceTicketStatusOpen, MaintenanceTicketStatusInProgress, MaintenanceTicketStatusCompleted, MaintenanceTicketStatusCancelled:
+		return true
+	}
+	return false
+}
+
+func (e MaintenanceTicketStatus) String() string {
+	return string(e)
+}
+
+func (e *MaintenanceTicketStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MaintenanceTicketStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MaintenanceTicketStatus", str)
+	}
+	return nil
+}
+
+func (e MaintenanceTicketStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *MaintenanceTicketStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e MaintenanceTicketStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type StockMovementKind string
+
+const (
+	StockMovementKindReceive  StockMovementKind = "RECEIVE"
+	StockMovementKindIssue    StockMovementKind = "ISSUE"
+	StockMovementKindAdjust   StockMovementKind = "ADJUST"
+	StockMovementKindWriteOff StockMovementKind = "WRITE_OFF"
+)
+
+var AllStockMovementKind = []StockMovementKind{
+	StockMovementKindReceive,
+	StockMovementKindIssue,
+	StockMovementKindAdjust,
+	StockMovementKindWriteOff,
+}
+
+func (e StockMovementKind) IsValid() bool {
+	switch e {
+	case StockMovementKindReceive, StockMovementKindIssue, StockMovementKindAdjust, StockMovementKindWriteOff:
+		return true
+	}
+	return false
+}
+
+func (e StockMovementKind) String() string {
+	return string(e)
+}
+
+func (e *StockMovementKind) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = StockMovementKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid StockMovementKind", str)
+	}
+	return nil
+}
+
+func (e StockMovementKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *StockMovementKind) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e StockMovementKind) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type Weekday string
+
+const (
+	WeekdayMonday    Weekday = "MONDAY"
+	WeekdayTuesday   Weekday = "TUESDAY"
+	WeekdayWednesday Weekday = "WEDNESDAY"
+	WeekdayThursday  Weekday = "THURSDAY"
+	WeekdayFriday    Weekday = "FRIDAY"
+	WeekdaySaturday  Weekday = "SATURDAY"
+	WeekdaySunday    Weekday = "SUNDAY"
+)
+
+var AllWeekday = []Weekday{
+	WeekdayMonday,
+	WeekdayTuesday,
+	WeekdayWednesday,
+	WeekdayThursday,
+	WeekdayFriday,
+	WeekdaySaturday,
+	WeekdaySunday,
+}
+
+func (e Weekday) IsValid() bool {
+	switch e {
+	case WeekdayMonday, WeekdayTuesday, WeekdayWednesday, WeekdayThursday, WeekdayFriday, WeekdaySaturday, WeekdaySunday:
+		return true
+	}
+	return false
+}
+
+func (e Weekday) String() string {
+	return string(e)
+}
+
+func (e *Weekday) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Weekday(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Weekday", str)
+	}
+	return nil
+}
+
+func (e Weekday) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *Weekday) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e Weekday) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
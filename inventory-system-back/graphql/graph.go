@@ -1,13 +1,99 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/jochem11/inventory-system-back/account"
 	"github.com/jochem11/inventory-system-back/education"
 	"github.com/jochem11/inventory-system-back/graphql/generated"
+	"github.com/jochem11/inventory-system-back/internal/config"
+	"github.com/jochem11/inventory-system-back/internal/oidc"
+	"github.com/jochem11/inventory-system-back/inventory"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"google.golang.org/grpc/credentials"
 )
 
 type Server struct {
 	educationClient *education.Client
+	inventoryClient *inventory.Client
+	accountClient   *account.Client
+
+	rpcTimeout    time.Duration
+	exportTimeout time.Duration
+	paging        config.Paging
+	limits        *limits
+
+	calendarSecret []byte
+	sessionSecret  []byte
+	sessionTTL     time.Duration
+
+	// oidc is nil while single sign-on is off.
+	oidc            *oidc.Provider
+	oidcCallback    string
+	oidcRoleClaim   string
+	oidcRoles       map[string]string
+	oidcLinkDomains []string
+	oidcDoneURL     string
+}
+
+func (s *Server) Account() generated.AccountResolver {
+	return &accountResolver{
+		server: s,
+	}
+}
+
+func (s *Server) Class() generated.ClassResolver {
+	return &classResolver{
+		server: s,
+	}
+}
+
+func (s *Server) Course() generated.CourseResolver {
+	return &courseResolver{
+		server: s,
+	}
+}
+
+func (s *Server) Item() generated.ItemResolver {
+	return &itemResolver{
+		server: s,
+	}
+}
+
+func (s *Server) AcademicYear() generated.AcademicYearResolver {
+	return &academicYearResolver{
+		server: s,
+	}
+}
+
+func (s *Server) Lesson() generated.LessonResolver {
+	return &lessonResolver{
+		server: s,
+	}
+}
+
+func (s *Server) LessonSlot() generated.LessonSlotResolver {
+	return &lessonSlotResolver{
+		server: s,
+	}
+}
+
+func (s *Server) Location() generated.LocationResolver {
+	return &locationResolver{
+		server: s,
+	}
+}
+
+func (s *Server) MaintenanceTicket() generated.MaintenanceTicketResolver {
+	return &maintenanceTicketResolver{
+		server: s,
+	}
 }
 
 func (s *Server) Mutation() generated.MutationResolver {
@@ -28,19 +114,105 @@ func (s *Server) Subscription() generated.SubscriptionResolver {
 	}
 }
 
-func NewGraphQLServer(educationURL string) (*Server, error) {
-	educationClient, err := education.NewClient(educationURL)
+func NewGraphQLServer(cfg AppConfig, creds credentials.TransportCredentials) (*Server, error) {
+	educationClient, err := education.NewClient(cfg.EducationURL, creds, cfg.Resilience)
+	if err != nil {
+		return nil, err
+	}
+
+	inventoryClient, err := inventory.NewClient(cfg.InventoryURL, creds, cfg.Resilience)
+	if err != nil {
+		educationClient.Close()
+		return nil, err
+	}
+
+	accountClient, err := account.NewClient(cfg.AccountURL, creds, cfg.Resilience)
 	if err != nil {
+		educationClient.Close()
+		inventoryClient.Close()
 		return nil, err
 	}
 
+	var provider *oidc.Provider
+	if cfg.OIDC.Enabled() {
+		provider = oidc.NewProvider(cfg.OIDC, &http.Client{Timeout: cfg.RPCTimeout})
+	}
+
 	return &Server{
-		educationClient,
+		educationClient: educationClient,
+		inventoryClient: inventoryClient,
+		accountClient:   accountClient,
+		rpcTimeout:      cfg.RPCTimeout,
+		exportTimeout:   cfg.ExportTimeout,
+		paging:          cfg.Page,
+		limits:          newLimits(cfg.RateLimit),
+		calendarSecret:  []byte(cfg.CalendarSecret),
+		sessionSecret:   []byte(cfg.SessionSecret),
+		sessionTTL:      cfg.SessionTTL,
+		oidc:            provider,
+		oidcCallback:    cfg.OIDC.RedirectURL,
+		oidcRoleClaim:   cfg.OIDCRoleClaim,
+		oidcRoles:       cfg.OIDCRoles,
+		oidcLinkDomains: cfg.OIDCLinkDomains,
+		oidcDoneURL:     cfg.OIDCDoneURL,
 	}, nil
 }
 
+func (s *Server) Close() {
+	s.educationClient.Close()
+	s.inventoryClient.Close()
+	s.accountClient.Close()
+}
+
+// withTimeout bounds the service calls made for a single resolver or row.
+func (s *Server) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, s.rpcTimeout)
+}
+
+const errPageSize = "PAGE_SIZE_EXCEEDED"
+
+// pagination applies the default page size and rejects pages above the
+// maximum before they reach a service.
+func (s *Server) pagination(p *generated.PaginationInput) (uint64, uint64, error) {
+	var skip uint64
+	var take *uint64
+	if p != nil {
+		if p.Skip != nil {
+			if *p.Skip < 0 {
+				return 0, 0, errors.New("skip can't be negative")
+			}
+			skip = uint64(*p.Skip)
+		}
+		if p.Take != nil {
+			if *p.Take < 0 {
+				return 0, 0, errors.New("take can't be negative")
+			}
+			t := uint64(*p.Take)
+			take = &t
+		}
+	}
+
+	t, err := s.paging.Take(take)
+	if err != nil {
+		gqlErr := gqlerror.Wrap(err)
+		errcode.Set(gqlErr, errPageSize)
+		return 0, 0, gqlErr
+	}
+	return skip, t, nil
+}
+
 func (s *Server) ToExecutableSchema() graphql.ExecutableSchema {
 	return generated.NewExecutableSchema(generated.Config{
 		Resolvers: s,
 	})
 }
+
+// logError logs a failed call together with the GraphQL path it was made
+// for. The request ID comes along through the context.
+func logError(ctx context.Context, err error) {
+	attrs := []any{"err", err}
+	if fc := graphql.GetFieldContext(ctx); fc != nil {
+		attrs = append(attrs, "path", fc.Path().String())
+	}
+	slog.ErrorContext(ctx, "Request failed", attrs...)
+}
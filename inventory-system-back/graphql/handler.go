@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/gorilla/websocket"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// newGraphQLHandler serves queries and mutations over GET and POST, and
+// subscriptions over websockets (graphql-ws and graphql-transport-ws) or
+// server-sent events.
+func (s *Server) newGraphQLHandler(cfg AppConfig) http.Handler {
+	srv := handler.New(s.ToExecutableSchema())
+
+	srv.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+		Upgrader: websocket.Upgrader{
+			CheckOrigin: checkOrigin(cfg.WebsocketOrigins),
+		},
+		InitFunc: s.authenticateInit,
+	})
+	// SSE has to come before POST, it picks up POST requests that accept
+	// text/event-stream.
+	srv.AddTransport(transport.SSE{
+		KeepAlivePingInterval: 10 * time.Second,
+	})
+	srv.AddTransport(transport.Options{})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.MultipartForm{
+		MaxUploadSize: cfg.MaxBodyBytes,
+		MaxMemory:     cfg.MaxBodyBytes,
+	})
+
+	srv.SetQueryCache(lru.New[*ast.QueryDocument](1000))
+
+	if cfg.Introspection {
+		srv.Use(extension.Introspection{})
+	}
+	srv.Use(extension.AutomaticPersistedQuery{
+		Cache: lru.New[string](cfg.APQCacheSize),
+	})
+	srv.Use(extension.FixedComplexityLimit(cfg.ComplexityLimit))
+	srv.Use(depthLimit{max: cfg.MaxDepth})
+	srv.Use(scopeCheck{})
+	srv.Use(rateLimiter{s.limits})
+	srv.Use(metricsTracer{})
+	srv.Use(otelTracer{})
+
+	return limitBody(s.withLoaders(srv), cfg.MaxBodyBytes)
+}
+
+// checkOrigin lets websockets be opened from origins and by clients that
+// aren't browsers, which send no Origin.
+func checkOrigin(origins []string) func(*http.Request) bool {
+	allowed := make([]string, len(origins))
+	for i, o := range origins {
+		allowed[i] = strings.ToLower(strings.TrimSuffix(o, "/"))
+	}
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		return origin == "" || slices.Contains(allowed, strings.ToLower(origin))
+	}
+}
+
+// authenticateInit does what authenticate does for the token in the
+// connection_init payload, browsers can't set headers on websockets. A
+// payload without one keeps the session of the upgrade request, if any.
+func (s *Server) authenticateInit(ctx context.Context, payload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+	sess, err := s.lookupSession(ctx, payload.Authorization())
+	if err != nil {
+		if !errors.As(err, new(credentialError)) {
+			logError(ctx, err)
+		}
+		return ctx, nil, err
+	}
+	if sess == nil {
+		return ctx, nil, nil
+	}
+	ctx = context.WithValue(ctx, sessionKey{}, sess)
+	if c, ok := clientFrom(ctx); ok {
+		c.key = sessionBucket(sess)
+		ctx = context.WithValue(ctx, clientKey{}, c)
+	}
+	return ctx, nil, nil
+}
+
+// limitBody turns requests that announce a body over max away with a 413.
+// Bodies without a length are cut off at max while they are read.
+func limitBody(next http.Handler, max int64) http.Handler {
+	next = http.MaxBytesHandler(next, max)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ContentLength > max {
+			writeGraphQLError(w, http.StatusRequestEntityTooLarge, fmt.Sprintf("request body is larger than %d bytes", max), errRequestTooLarge)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
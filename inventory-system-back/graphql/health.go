@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// healthzHandler reports that the gateway process is up. It never looks at
+// the backends, so a slow service does not get the gateway restarted.
+func (s *Server) healthzHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Write([]byte("ok\n"))
+	})
+}
+
+// readyzHandler checks the grpc.health.v1 status of every backend and answers
+// 503 unless all of them are serving.
+func (s *Server) readyzHandler() http.Handler {
+	checks := map[string]func(ctx context.Context) error{
+		"education": s.educationClient.Ready,
+		"inventory": s.inventoryClient.Ready,
+		"account":   s.accountClient.Ready,
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), 2*time.Second)
+		defer cancel()
+
+		var mu sync.Mutex
+		var wg sync.WaitGroup
+		statuses := map[string]string{}
+		ready := true
+
+		for name, check := range checks {
+			wg.Add(1)
+			go func(name string, check func(ctx context.Context) error) {
+				defer wg.Done()
+				status := "ok"
+				if err := check(ctx); err != nil {
+					status = err.Error()
+				}
+
+				mu.Lock()
+				defer mu.Unlock()
+				statuses[name] = status
+				if status != "ok" {
+					ready = false
+				}
+			}(name, check)
+		}
+		wg.Wait()
+
+		code := http.StatusOK
+		if !ready {
+			code = http.StatusServiceUnavailable
+		}
+		writeJSON(w, code, statuses)
+	})
+}
+
+// probe backs `app healthcheck`, the container health check.
+func probe(url string) int {
+	client := http.Client{Timeout: 3 * time.Second}
+	res, err := client.Get(url)
+	if err != nil {
+		log.Println(err)
+		return 1
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return 1
+	}
+	return 0
+}
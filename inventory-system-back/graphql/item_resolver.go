@@ -0,0 +1,29 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jochem11/inventory-system-back/graphql/generated"
+	"github.com/vikstrous/dataloadgen"
+)
+
+type itemResolver struct {
+	server *Server
+}
+
+func (r itemResolver) Location(ctx context.Context, obj *generated.Item) (*generated.Location, error) {
+	if obj.LocationID == nil {
+		return nil, nil
+	}
+
+	l, err := r.server.loaders(ctx).locations.Load(ctx, *obj.LocationID)
+	if errors.Is(err, dataloadgen.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	return toGraphQLLocation(l), nil
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/jochem11/inventory-system-back/account"
+	"github.com/jochem11/inventory-system-back/inventory"
+	"github.com/jochem11/inventory-system-back/inventory/label"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxLabelsPerRequest = 240
+
+// labelHandler serves /labels?items=<id>,<id>&format=pdf|png|svg&symbology=qr|code128.
+// PDF sheets take any number of items, PNG and SVG render a single code.
+func (s *Server) labelHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		if !allowScoped(w, r, "inventory", account.ScopeRead, account.RoleTeacher, account.RoleAdmin) {
+			return
+		}
+
+		q := r.URL.Query()
+		var ids []string
+		for _, v := range q["items"] {
+			for _, id := range strings.Split(v, ",") {
+				if id = strings.TrimSpace(id); id != "" {
+					ids = append(ids, id)
+				}
+			}
+		}
+		if len(ids) == 0 {
+			http.Error(w, "items is required", http.StatusBadRequest)
+			return
+		}
+		if len(ids) > maxLabelsPerRequest {
+			http.Error(w, "too many items", http.StatusBadRequest)
+			return
+		}
+
+		format := label.PDF
+		if v := q.Get("format"); v != "" {
+			f, err := label.ParseFormat(v)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			format = f
+		}
+
+		symbology := label.QR
+		if v := q.Get("symbology"); v != "" {
+			sym, err := label.ParseSymbology(v)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			symbology = sym
+		}
+
+		if format != label.PDF && len(ids) != 1 {
+			http.Error(w, "png and svg take exactly one item, use pdf for sheets", http.StatusBadRequest)
+			return
+		}
+
+		ctx, cancel := s.withTimeout(r.Context())
+		defer cancel()
+
+		items, err := s.inventoryClient.GetItemsByIDs(ctx, ids)
+		if err != nil {
+			logError(ctx, err)
+			http.Error(w, err.Error(), labelStatus(err))
+			return
+		}
+		byID := make(map[string]*inventory.Item, len(items))
+		for _, item := range items {
+			byID[item.ID] = item
+		}
+		labels := make([]label.Label, 0, len(ids))
+		var missing []string
+		for _, id := range ids {
+			item, ok := byID[id]
+			if !ok {
+				missing = append(missing, id)
+				continue
+			}
+			labels = append(labels, label.Label{Name: item.Name, AssetTag: item.AssetTag})
+		}
+		if len(missing) > 0 {
+			http.Error(w, "items not found: "+strings.Join(missing, ","), http.StatusNotFound)
+			return
+		}
+
+		var buf bytes.Buffer
+		switch format {
+		case label.PNG:
+			err = label.WritePNG(&buf, symbology, labels[0].AssetTag)
+		case label.SVG:
+			err = label.WriteSVG(&buf, symbology, labels[0].AssetTag)
+		default:
+			err = label.WriteSheet(&buf, labels)
+		}
+		if err != nil {
+			logError(ctx, err)
+			http.Error(w, err.Error(), labelStatus(err))
+			return
+		}
+
+		w.Header().Set("Content-Type", label.ContentType(format))
+		w.Write(buf.Bytes())
+	})
+}
+
+// labelStatus is the HTTP status for an error of rendering, or of the
+// inventory service.
+func labelStatus(err error) int {
+	if errors.Is(err, label.ErrEmptyContent) {
+		return http.StatusUnprocessableEntity
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return http.StatusInternalServerError
+	}
+	switch st.Code() {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusBadGateway
+	}
+}
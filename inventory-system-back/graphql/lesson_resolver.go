@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jochem11/inventory-system-back/graphql/generated"
+	"github.com/vikstrous/dataloadgen"
+)
+
+type lessonResolver struct {
+	server *Server
+}
+
+func (r lessonResolver) Class(ctx context.Context, obj *generated.Lesson) (*generated.Class, error) {
+	c, err := r.server.loaders(ctx).classes.Load(ctx, obj.ClassID)
+	if errors.Is(err, dataloadgen.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	return toGraphQLClass(c), nil
+}
+
+func (r lessonResolver) Room(ctx context.Context, obj *generated.Lesson) (*generated.Location, error) {
+	return r.server.room(ctx, obj.RoomID)
+}
+
+// room crosses into the inventory service; a room that has been deleted
+// there resolves to null.
+func (s *Server) room(ctx context.Context, roomID *string) (*generated.Location, error) {
+	if roomID == nil {
+		return nil, nil
+	}
+
+	l, err := s.loaders(ctx).locations.Load(ctx, *roomID)
+	if errors.Is(err, dataloadgen.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	return toGraphQLLocation(l), nil
+}
@@ -0,0 +1,15 @@
+package main
+
+import (
+	"context"
+
+	"github.com/jochem11/inventory-system-back/graphql/generated"
+)
+
+type lessonSlotResolver struct {
+	server *Server
+}
+
+func (r lessonSlotResolver) Room(ctx context.Context, obj *generated.LessonSlot) (*generated.Location, error) {
+	return r.server.room(ctx, obj.RoomID)
+}
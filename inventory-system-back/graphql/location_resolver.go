@@ -0,0 +1,44 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jochem11/inventory-system-back/graphql/generated"
+	"github.com/vikstrous/dataloadgen"
+)
+
+type locationResolver struct {
+	server *Server
+}
+
+func (r locationResolver) Parent(ctx context.Context, obj *generated.Location) (*generated.Location, error) {
+	if obj.ParentID == nil {
+		return nil, nil
+	}
+
+	l, err := r.server.loaders(ctx).locations.Load(ctx, *obj.ParentID)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	return toGraphQLLocation(l), nil
+}
+
+// Class crosses into the education service; a homeroom whose class has been
+// deleted there resolves to null.
+func (r locationResolver) Class(ctx context.Context, obj *generated.Location) (*generated.Class, error) {
+	if obj.ClassID == nil {
+		return nil, nil
+	}
+
+	c, err := r.server.loaders(ctx).classes.Load(ctx, *obj.ClassID)
+	if errors.Is(err, dataloadgen.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	return toGraphQLClass(c), nil
+}
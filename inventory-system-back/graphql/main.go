@@ -3,31 +3,218 @@
 package main
 
 import (
-	"github.com/99designs/gqlgen/handler"
+	"context"
+	"errors"
+	"fmt"
 	"log"
+	"log/slog"
 	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"time"
 
-	"github.com/kelseyhightower/envconfig"
+	"github.com/99designs/gqlgen/graphql/playground"
+	"github.com/jochem11/inventory-system-back/internal/config"
+	"github.com/jochem11/inventory-system-back/internal/grpctls"
+	"github.com/jochem11/inventory-system-back/internal/logging"
+	"github.com/jochem11/inventory-system-back/internal/metrics"
+	"github.com/jochem11/inventory-system-back/internal/oidc"
+	"github.com/jochem11/inventory-system-back/internal/resilience"
+	"github.com/jochem11/inventory-system-back/internal/serve"
+	"github.com/jochem11/inventory-system-back/internal/tracing"
 )
 
 type AppConfig struct {
-	EducationUDL string `envconfig:"EDUCATION_SERVICE_URL"`
+	Port         int           `envconfig:"PORT" default:"8080"`
+	MetricsPort  int           `envconfig:"METRICS_PORT" default:"9090"`
+	EducationURL string        `envconfig:"EDUCATION_SERVICE_URL" required:"true"`
+	InventoryURL string        `envconfig:"INVENTORY_SERVICE_URL" required:"true"`
+	AccountURL   string        `envconfig:"ACCOUNT_SERVICE_URL" required:"true"`
+	DrainTimeout time.Duration `envconfig:"DRAIN_TIMEOUT" default:"15s"`
+	LogLevel     string        `envconfig:"LOG_LEVEL" default:"info"`
+
+	// RPCTimeout bounds each call to a service, ExportTimeout a whole
+	// /export download.
+	RPCTimeout    time.Duration `envconfig:"RPC_TIMEOUT" default:"3s"`
+	ExportTimeout time.Duration `envconfig:"EXPORT_TIMEOUT" default:"30s"`
+	// Page should match the services, exports read pages of Page.Max.
+	Page config.Paging `envconfig:"PAGE_SIZE"`
+	// Resilience sets the retries and circuit breaking of the service
+	// clients.
+	Resilience resilience.Config `envconfig:"RPC"`
+
+	// TracingExporter is otlp, stdout or none.
+	TracingExporter string `envconfig:"TRACING_EXPORTER" default:"none"`
+
+	// TLS applies to the connections to the services, the gateway itself
+	// is expected to sit behind a TLS-terminating proxy.
+	TLS grpctls.Config `envconfig:"TLS"`
+
+	// MaxBodyBytes caps GraphQL requests, imports have their own limit.
+	MaxBodyBytes int64           `envconfig:"MAX_BODY_BYTES" default:"1048576"`
+	RateLimit    RateLimitConfig `envconfig:"RATE_LIMIT"`
+
+	// CalendarSecret signs the URLs of the iCalendar feeds, which are off
+	// while it is empty. Changing it invalidates every URL handed out.
+	CalendarSecret string `envconfig:"CALENDAR_SECRET" secret:"true"`
+
+	// SessionSecret signs login sessions, logging in is off while it is
+	// empty. Changing it logs everyone out.
+	SessionSecret string        `envconfig:"SESSION_SECRET" secret:"true"`
+	SessionTTL    time.Duration `envconfig:"SESSION_TTL" default:"12h"`
+
+	// OIDC logs people in with the school's identity provider, single
+	// sign-on is off while OIDC_ISSUER is empty. It needs SESSION_SECRET.
+	OIDC oidc.Config `envconfig:"OIDC"`
+	// OIDCRoles maps values of the OIDCRoleClaim claim to the role of
+	// accounts created on first login, e.g. teacher:TEACHER,it:ADMIN.
+	// Everyone else starts as a student.
+	OIDCRoleClaim string            `envconfig:"OIDC_ROLE_CLAIM" default:"roles"`
+	OIDCRoles     map[string]string `envconfig:"OIDC_ROLES"`
+	// OIDCLinkDomains are the email domains the provider is trusted for,
+	// e.g. school.example. Logins with those emails may take over existing
+	// accounts with a password or a staff role, provided the hd claim names
+	// the same domain when the provider sends one. Elsewhere single sign-on
+	// only links to students without a password.
+	OIDCLinkDomains []string `envconfig:"OIDC_LINK_DOMAINS"`
+	// OIDCDoneURL is the front end page logins return to, with the session
+	// or an error in the fragment.
+	OIDCDoneURL string `envconfig:"OIDC_DONE_URL" default:"http://localhost:3000/login/sso"`
+
+	// Turn introspection, and with it the playground, off in production.
+	Introspection   bool `envconfig:"GRAPHQL_INTROSPECTION" default:"true"`
+	ComplexityLimit int  `envconfig:"GRAPHQL_COMPLEXITY_LIMIT" default:"1000"`
+	MaxDepth        int  `envconfig:"GRAPHQL_MAX_DEPTH" default:"10"`
+	APQCacheSize    int  `envconfig:"GRAPHQL_APQ_CACHE_SIZE" default:"100"`
+	// WebsocketOrigins are the pages that may open subscriptions from a
+	// browser, e.g. https://inventory.example.com. Clients that send no
+	// Origin, which browsers always do, aren't held to it.
+	WebsocketOrigins []string `envconfig:"GRAPHQL_WEBSOCKET_ORIGINS" default:"http://localhost:3000"`
+}
+
+func (c AppConfig) Validate() error {
+	var errs []error
+	errs = append(errs,
+		config.Port("PORT", c.Port),
+		config.Port("METRICS_PORT", c.MetricsPort),
+	)
+	if c.Port == c.MetricsPort {
+		errs = append(errs, errors.New("PORT and METRICS_PORT must differ"))
+	}
+	if _, err := logging.ParseLevel(c.LogLevel); err != nil {
+		errs = append(errs, fmt.Errorf("LOG_LEVEL: %w", err))
+	}
+	if c.DrainTimeout <= 0 || c.RPCTimeout <= 0 || c.ExportTimeout <= 0 {
+		errs = append(errs, errors.New("DRAIN_TIMEOUT, RPC_TIMEOUT and EXPORT_TIMEOUT must be positive"))
+	}
+	if c.MaxBodyBytes < 1 {
+		errs = append(errs, errors.New("MAX_BODY_BYTES must be positive"))
+	}
+	if c.CalendarSecret != "" && len(c.CalendarSecret) < 32 {
+		errs = append(errs, errors.New("CALENDAR_SECRET must be at least 32 bytes"))
+	}
+	if c.SessionSecret != "" && len(c.SessionSecret) < 32 {
+		errs = append(errs, errors.New("SESSION_SECRET must be at least 32 bytes"))
+	}
+	if c.SessionTTL <= 0 {
+		errs = append(errs, errors.New("SESSION_TTL must be positive"))
+	}
+	if c.OIDC.Enabled() {
+		if c.SessionSecret == "" {
+			errs = append(errs, errors.New("OIDC_ISSUER needs SESSION_SECRET"))
+		}
+		if u, err := url.Parse(c.OIDCDoneURL); err != nil || !u.IsAbs() {
+			errs = append(errs, fmt.Errorf("OIDC_DONE_URL %q must be an absolute URL", c.OIDCDoneURL))
+		}
+		for value, role := range c.OIDCRoles {
+			if !slices.Contains(roleRanks, role) {
+				errs = append(errs, fmt.Errorf("OIDC_ROLES: %s maps to %q, which is not a role", value, role))
+			}
+		}
+	}
+	if c.ComplexityLimit < 1 || c.MaxDepth < 1 || c.APQCacheSize < 1 {
+		errs = append(errs, errors.New("GRAPHQL_COMPLEXITY_LIMIT, GRAPHQL_MAX_DEPTH and GRAPHQL_APQ_CACHE_SIZE must be positive"))
+	}
+	for _, origin := range c.WebsocketOrigins {
+		if u, err := url.Parse(origin); err != nil || !u.IsAbs() || u.Host == "" || (u.Path != "" && u.Path != "/") {
+			errs = append(errs, fmt.Errorf("GRAPHQL_WEBSOCKET_ORIGINS: %q must be a scheme and host", origin))
+		}
+	}
+	errs = append(errs,
+		tracing.CheckExporter(c.TracingExporter),
+		c.Page.Validate(),
+		c.Resilience.Validate(),
+		c.RateLimit.Validate(),
+		c.TLS.Validate(),
+		c.OIDC.Validate(),
+	)
+	return errors.Join(errs...)
 }
 
 func main() {
 	var cfg AppConfig
-	err := envconfig.Process("", &cfg)
+	args, err := config.Load(&cfg)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	s, err := NewGraphQLServer(cfg.EducationUDL)
+	if len(args) > 0 && args[0] == "healthcheck" {
+		os.Exit(probe(fmt.Sprintf("http://localhost:%d/healthz", cfg.Port)))
+	}
+
+	if err := logging.Setup(cfg.LogLevel); err != nil {
+		log.Fatal(err)
+	}
+	shutdownTracing, err := tracing.Setup(context.Background(), "graphql", cfg.TracingExporter)
 	if err != nil {
 		log.Fatal(err)
 	}
+	defer shutdownTracing(context.Background())
+
+	ctx, stop := serve.SignalContext()
+	defer stop()
 
-	http.Handle("/graphql", handler.GraphQL(s.ToExecutableSchema()))
-	http.Handle("/playground", handler.Playground("jochem11", "/graphql"))
+	creds, err := cfg.TLS.Client("graphql")
+	if err != nil {
+		log.Fatal(err)
+	}
 
-	log.Fatal(http.ListenAndServe(":8080", nil))
+	s, err := NewGraphQLServer(cfg, creds)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer s.Close()
+
+	mux := http.NewServeMux()
+	limit := s.limits.middleware
+	mux.Handle("/graphql", s.authenticate(limit(s.newGraphQLHandler(cfg))))
+	if s.oidc != nil {
+		mux.Handle("/auth/oidc/login", limit(s.ssoLoginHandler()))
+		mux.Handle("/auth/oidc/callback", limit(s.ssoCallbackHandler()))
+	}
+	mux.Handle("/labels", s.authenticate(limit(s.labelHandler())))
+	mux.Handle("/import/", s.authenticate(limit(s.importHandler())))
+	mux.Handle("/export/", s.authenticate(limit(s.exportHandler())))
+	if cfg.CalendarSecret != "" {
+		mux.Handle("/calendar/", limit(s.calendarHandler()))
+	}
+	mux.Handle("/healthz", s.healthzHandler())
+	mux.Handle("/readyz", s.readyzHandler())
+	if cfg.Introspection {
+		mux.Handle("/playground", playground.Handler("jochem11", "/graphql"))
+	}
+
+	go func() {
+		if err := metrics.Serve(ctx, cfg.MetricsPort, cfg.DrainTimeout); err != nil {
+			slog.Error("Serving metrics", "err", err)
+		}
+	}()
+
+	slog.Info("Listening", "port", cfg.Port, "metrics_port", cfg.MetricsPort)
+	if err := serve.HTTP(ctx, &http.Server{Addr: fmt.Sprintf(":%d", cfg.Port), Handler: tracing.HTTPMiddleware(logging.Middleware(mux))}, cfg.DrainTimeout); err != nil {
+		s.Close()
+		log.Fatal(err)
+	}
+	slog.Info("Stopped")
 }
@@ -0,0 +1,20 @@
+package main
+
+import (
+	"context"
+
+	"github.com/jochem11/inventory-system-back/graphql/generated"
+)
+
+type maintenanceTicketResolver struct {
+	server *Server
+}
+
+func (r maintenanceTicketResolver) Item(ctx context.Context, obj *generated.MaintenanceTicket) (*generated.Item, error) {
+	i, err := r.server.loaders(ctx).items.Load(ctx, obj.ItemID)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	return toGraphQLItem(i), nil
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+var (
+	operationDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "graphql_operation_duration_seconds",
+		Help:    "Time from receiving a query or mutation to writing its response.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"type", "name"})
+
+	operationErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "graphql_operation_errors_total",
+		Help: "Responses, including subscription events, that carried errors.",
+	}, []string{"type", "name"})
+
+	fieldDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "graphql_field_duration_seconds",
+		Help:    "Time spent in field resolvers.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"object", "field"})
+
+	fieldErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "graphql_field_errors_total",
+		Help: "Field resolvers that returned an error.",
+	}, []string{"object", "field"})
+
+	activeSubscriptions = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "graphql_active_subscriptions",
+		Help: "Subscriptions currently open over websockets or SSE.",
+	})
+)
+
+// metricsTracer records operation and resolver timings. Fields bound straight
+// to a struct member are left out, they cost nothing and would only add
+// series.
+type metricsTracer struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationInterceptor
+	graphql.ResponseInterceptor
+	graphql.FieldInterceptor
+} = metricsTracer{}
+
+func (metricsTracer) ExtensionName() string {
+	return "Metrics"
+}
+
+func (metricsTracer) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (metricsTracer) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	if opType, _ := operationLabels(ctx); opType == string(ast.Subscription) {
+		// The transports cancel the context once the subscription ends, for
+		// whatever reason.
+		activeSubscriptions.Inc()
+		go func() {
+			<-ctx.Done()
+			activeSubscriptions.Dec()
+		}()
+	}
+	return next(ctx)
+}
+
+func (metricsTracer) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	res := next(ctx)
+	if res == nil {
+		return nil
+	}
+
+	opType, name := operationLabels(ctx)
+	if len(res.Errors) > 0 {
+		operationErrors.WithLabelValues(opType, name).Inc()
+	}
+	// A subscription responds once per event, its duration says nothing.
+	if opType != string(ast.Subscription) {
+		start := graphql.GetOperationContext(ctx).Stats.OperationStart
+		operationDuration.WithLabelValues(opType, name).Observe(time.Since(start).Seconds())
+	}
+	return res
+}
+
+func (metricsTracer) InterceptField(ctx context.Context, next graphql.Resolver) (any, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || !fc.IsResolver {
+		return next(ctx)
+	}
+
+	start := time.Now()
+	res, err := next(ctx)
+	fieldDuration.WithLabelValues(fc.Object, fc.Field.Name).Observe(time.Since(start).Seconds())
+	if err != nil {
+		fieldErrors.WithLabelValues(fc.Object, fc.Field.Name).Inc()
+	}
+	return res, err
+}
+
+// Operation names come from clients, so only the first maxOperationNames
+// names are kept as labels, and only reasonably short ones. Any other
+// operation is counted as otherOperation, which bounds the number of series
+// however many names clients make up.
+const (
+	maxOperationNames  = 200
+	maxOperationLength = 64
+	otherOperation     = "other"
+)
+
+var operationNames = struct {
+	sync.Mutex
+	seen map[string]struct{}
+}{seen: map[string]struct{}{}}
+
+// operationLabel is name, or otherOperation for a name past the limits.
+func operationLabel(name string) string {
+	if len(name) > maxOperationLength {
+		return otherOperation
+	}
+	operationNames.Lock()
+	defer operationNames.Unlock()
+	if _, ok := operationNames.seen[name]; ok {
+		return name
+	}
+	if len(operationNames.seen) >= maxOperationNames {
+		return otherOperation
+	}
+	operationNames.seen[name] = struct{}{}
+	return name
+}
+
+func operationLabels(ctx context.Context) (string, string) {
+	if !graphql.HasOperationContext(ctx) {
+		return "", ""
+	}
+	oc := graphql.GetOperationContext(ctx)
+	if oc.Operation == nil {
+		return "", ""
+	}
+	name := oc.Operation.Name
+	if name == "" {
+		return string(oc.Operation.Operation), "anonymous"
+	}
+	return string(oc.Operation.Operation), operationLabel(name)
+}
@@ -2,8 +2,13 @@ package main
 
 import (
 	"context"
+	"encoding/base64"
 	"github.com/jochem11/inventory-system-back/graphql/generated"
-	"log"
+	"github.com/jochem11/inventory-system-back/inventory"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log/slog"
+	"strings"
 	"time"
 )
 
@@ -13,48 +18,50 @@ type mutationResolver struct {
 
 // Courses
 func (r mutationResolver) CreateCourse(ctx context.Context, course generated.CreateCourseInput) (*generated.Course, error) {
-	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	ctx, cancel := r.server.withTimeout(ctx)
 	defer cancel()
 
-	c, err := r.server.educationClient.PostCourse(ctx, course.Name)
+	if _, err := requireStaffOrKey(ctx); err != nil {
+		return nil, err
+	}
+
+	c, err := r.server.educationClient.PostCourse(ctx, course.Name, course.YearID)
 	if err != nil {
-		log.Println(err)
+		logError(ctx, err)
 		return nil, err
 	}
 
-	return &generated.Course{
-		ID:        c.ID,
-		Name:      c.Name,
-		UpdatedAt: c.UpdatedAt,
-		CreatedAt: c.CreatedAt,
-	}, nil
+	return toGraphQLCourse(c), nil
 }
 
 func (r mutationResolver) UpdateCourse(ctx context.Context, course generated.UpdateCourseInput) (*generated.Course, error) {
-	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	ctx, cancel := r.server.withTimeout(ctx)
 	defer cancel()
 
+	if _, err := requireStaffOrKey(ctx); err != nil {
+		return nil, err
+	}
+
 	c, err := r.server.educationClient.UpdateCourse(ctx, course.ID, course.Name)
 	if err != nil {
-		log.Println(err)
+		logError(ctx, err)
 		return nil, err
 	}
 
-	return &generated.Course{
-		ID:        c.ID,
-		Name:      c.Name,
-		UpdatedAt: c.UpdatedAt,
-		CreatedAt: c.CreatedAt,
-	}, nil
+	return toGraphQLCourse(c), nil
 }
 
 func (r mutationResolver) DeleteCourse(ctx context.Context, course generated.DeleteByIDCourseInput) (bool, error) {
-	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	ctx, cancel := r.server.withTimeout(ctx)
 	defer cancel()
 
+	if _, err := requireAdmin(ctx); err != nil {
+		return false, err
+	}
+
 	err := r.server.educationClient.DeleteCourse(ctx, course.ID)
 	if err != nil {
-		log.Println(err)
+		logError(ctx, err)
 		return false, err
 	}
 	return true, nil
@@ -62,63 +69,1059 @@ func (r mutationResolver) DeleteCourse(ctx context.Context, course generated.Del
 
 // Classes
 func (r mutationResolver) CreateClass(ctx context.Context, class generated.CreateClassInput) (*generated.Class, error) {
-	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	ctx, cancel := r.server.withTimeout(ctx)
 	defer cancel()
 
-	c, err := r.server.educationClient.PostClass(ctx, class.Name, class.CourseID)
+	if _, err := requireStaffOrKey(ctx); err != nil {
+		return nil, err
+	}
+
+	c, err := r.server.educationClient.PostClass(ctx, class.CourseID, class.Name, class.TermID)
 	if err != nil {
-		log.Println(err)
+		logError(ctx, err)
 		return nil, err
 	}
 
-	return &generated.Class{
-		ID:       c.ID,
-		Name:     c.Name,
-		CourseID: c.CourseID,
-		Course: &generated.Course{
-			ID:        c.Course.ID,
-			Name:      c.Course.Name,
-			UpdatedAt: c.Course.UpdatedAt,
-			CreatedAt: c.Course.CreatedAt,
-		},
-		UpdatedAt: c.UpdatedAt,
-		CreatedAt: c.CreatedAt,
-	}, nil
+	return toGraphQLClass(c), nil
 }
 
 func (r mutationResolver) UpdateClass(ctx context.Context, class generated.UpdateClassInput) (*generated.Class, error) {
-	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	ctx, cancel := r.server.withTimeout(ctx)
 	defer cancel()
 
-	c, err := r.server.educationClient.UpdateClass(ctx, class.ID, class.Name, class.CourseID)
+	if _, err := requireStaffOrKey(ctx); err != nil {
+		return nil, err
+	}
+
+	if class.StudentCount != nil && *class.StudentCount < 0 {
+		return nil, errNegativeCount
+	}
+
+	c, err := r.server.educationClient.UpdateClass(ctx, class.ID, class.Name, class.CourseID, class.TermID, class.StudentCount)
 	if err != nil {
-		log.Println(err)
+		logError(ctx, err)
 		return nil, err
 	}
 
-	return &generated.Class{
-		ID:       c.ID,
-		Name:     c.Name,
-		CourseID: c.CourseID,
-		Course: &generated.Course{
-			ID:        c.Course.ID,
-			Name:      c.Course.Name,
-			UpdatedAt: c.Course.UpdatedAt,
-			CreatedAt: c.Course.CreatedAt,
-		},
-		UpdatedAt: c.UpdatedAt,
-		CreatedAt: c.CreatedAt,
-	}, nil
+	return toGraphQLClass(c), nil
 }
 
 func (r mutationResolver) DeleteClass(ctx context.Context, class generated.DeleteByIDClassInput) (bool, error) {
-	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	ctx, cancel := r.server.withTimeout(ctx)
 	defer cancel()
 
+	if _, err := requireAdmin(ctx); err != nil {
+		return false, err
+	}
+
 	err := r.server.educationClient.DeleteClass(ctx, class.ID)
 	if err != nil {
-		log.Println(err)
+		logError(ctx, err)
+		return false, err
+	}
+	return true, nil
+}
+
+// Academic years and terms
+func (r mutationResolver) CreateAcademicYear(ctx context.Context, year generated.CreateAcademicYearInput) (*generated.AcademicYear, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := requireStaffOrKey(ctx); err != nil {
+		return nil, err
+	}
+
+	y, err := r.server.educationClient.PostAcademicYear(ctx, year.Name, year.StartsOn, year.EndsOn)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	return toGraphQLAcademicYear(y), nil
+}
+
+func (r mutationResolver) DeleteAcademicYear(ctx context.Context, year generated.DeleteByIDAcademicYearInput) (bool, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := requireAdmin(ctx); err != nil {
+		return false, err
+	}
+
+	if err := r.server.educationClient.DeleteAcademicYear(ctx, year.ID); err != nil {
+		logError(ctx, err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (r mutationResolver) CreateTerm(ctx context.Context, term generated.CreateTermInput) (*generated.Term, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := requireStaffOrKey(ctx); err != nil {
+		return nil, err
+	}
+
+	t, err := r.server.educationClient.PostTerm(ctx, term.YearID, term.Name, term.StartsOn, term.EndsOn)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	return toGraphQLTerm(t), nil
+}
+
+func (r mutationResolver) DeleteTerm(ctx context.Context, term generated.DeleteByIDTermInput) (bool, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := requireAdmin(ctx); err != nil {
+		return false, err
+	}
+
+	if err := r.server.educationClient.DeleteTerm(ctx, term.ID); err != nil {
+		logError(ctx, err)
+		return false, err
+	}
+	return true, nil
+}
+
+// RolloverYear gets the export timeout, a year is copied in one go.
+func (r mutationResolver) RolloverYear(ctx context.Context, rollover generated.RolloverYearInput) (*generated.RolloverResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.server.exportTimeout)
+	defer cancel()
+
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	res, err := r.server.educationClient.RolloverYear(ctx, rollover.FromYearID, rollover.ToYearID)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	return &generated.RolloverResult{Courses: res.Courses, Classes: res.Classes}, nil
+}
+
+// Timetable
+func (r mutationResolver) CreateLessonSlot(ctx context.Context, slot generated.CreateLessonSlotInput) (*generated.LessonSlot, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := requireStaffOrKey(ctx); err != nil {
+		return nil, err
+	}
+
+	startMinute, err := parseClock(slot.StartTime)
+	if err != nil {
+		return nil, err
+	}
+	endMinute, err := parseClock(slot.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	if slot.RoomID != nil {
+		if err := r.server.checkRoom(ctx, *slot.RoomID); err != nil {
+			logError(ctx, err)
+			return nil, err
+		}
+	}
+
+	teacher := ""
+	if slot.Teacher != nil {
+		teacher = *slot.Teacher
+	}
+
+	l, err := r.server.educationClient.PostLessonSlot(ctx, slot.ClassID, weekdays[slot.Weekday], startMinute, endMinute, slot.RoomID, teacher)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	return toGraphQLLessonSlot(l), nil
+}
+
+func (r mutationResolver) UpdateLessonSlot(ctx context.Context, slot generated.UpdateLessonSlotInput) (*generated.LessonSlot, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := requireStaffOrKey(ctx); err != nil {
+		return nil, err
+	}
+
+	startMinute, err := optionalClock(slot.StartTime)
+	if err != nil {
+		return nil, err
+	}
+	endMinute, err := optionalClock(slot.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	var weekday *time.Weekday
+	if slot.Weekday != nil {
+		d := weekdays[*slot.Weekday]
+		weekday = &d
+	}
+
+	clearRoom := slot.ClearRoom != nil && *slot.ClearRoom
+	if slot.RoomID != nil && !clearRoom {
+		if err := r.server.checkRoom(ctx, *slot.RoomID); err != nil {
+			logError(ctx, err)
+			return nil, err
+		}
+	}
+
+	l, err := r.server.educationClient.UpdateLessonSlot(ctx, slot.ID, weekday, startMinute, endMinute, slot.RoomID, clearRoom, slot.Teacher)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	return toGraphQLLessonSlot(l), nil
+}
+
+func (r mutationResolver) DeleteLessonSlot(ctx context.Context, slot generated.DeleteByIDLessonSlotInput) (bool, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := requireStaffOrKey(ctx); err != nil {
+		return false, err
+	}
+
+	if err := r.server.educationClient.DeleteLessonSlot(ctx, slot.ID); err != nil {
+		logError(ctx, err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (r mutationResolver) CreateLessonException(ctx context.Context, exception generated.CreateLessonExceptionInput) (*generated.LessonException, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := requireStaffOrKey(ctx); err != nil {
+		return nil, err
+	}
+
+	note := ""
+	if exception.Note != nil {
+		note = *exception.Note
+	}
+
+	e, err := r.server.educationClient.PostLessonException(ctx, exception.SlotID, string(exception.Kind), exception.StartsOn, exception.EndsOn, note)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	return toGraphQLLessonException(e), nil
+}
+
+func (r mutationResolver) DeleteLessonException(ctx context.Context, exception generated.DeleteByIDLessonExceptionInput) (bool, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := requireStaffOrKey(ctx); err != nil {
+		return false, err
+	}
+
+	if err := r.server.educationClient.DeleteLessonException(ctx, exception.ID); err != nil {
+		logError(ctx, err)
+		return false, err
+	}
+	return true, nil
+}
+
+// Equipment
+func (r mutationResolver) CreateEquipmentRequirement(ctx context.Context, requirement generated.CreateEquipmentRequirementInput) (*generated.EquipmentRequirement, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := requireStaffOrKey(ctx); err != nil {
+		return nil, err
+	}
+
+	perStudents := 0
+	if requirement.PerStudents != nil {
+		perStudents = *requirement.PerStudents
+	}
+	if requirement.Quantity < 0 || perStudents < 0 {
+		return nil, errNegativeCount
+	}
+
+	e, err := r.server.educationClient.PostEquipmentRequirement(ctx, requirement.CourseID, requirement.ItemType, requirement.Quantity, perStudents)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	return toGraphQLEquipmentRequirement(e), nil
+}
+
+func (r mutationResolver) DeleteEquipmentRequirement(ctx context.Context, requirement generated.DeleteByIDEquipmentRequirementInput) (bool, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := requireStaffOrKey(ctx); err != nil {
+		return false, err
+	}
+
+	if err := r.server.educationClient.DeleteEquipmentRequirement(ctx, requirement.ID); err != nil {
+		logError(ctx, err)
+		return false, err
+	}
+	return true, nil
+}
+
+// Items
+func (r mutationResolver) CreateItem(ctx context.Context, item generated.CreateItemInput) (*generated.Item, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := requireStaffOrKey(ctx); err != nil {
+		return nil, err
+	}
+
+	description := ""
+	if item.Description != nil {
+		description = *item.Description
+	}
+	itemType := ""
+	if item.ItemType != nil {
+		itemType = *item.ItemType
+	}
+
+	i, err := r.server.inventoryClient.PostItem(ctx, item.Name, item.AssetTag, description, itemType, item.LocationID)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return toGraphQLItem(i), nil
+}
+
+func (r mutationResolver) UpdateItem(ctx context.Context, item generated.UpdateItemInput) (*generated.Item, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := requireStaffOrKey(ctx); err != nil {
+		return nil, err
+	}
+
+	i, err := r.server.inventoryClient.UpdateItem(ctx, item.ID, item.Name, item.AssetTag, item.Description, item.ItemType)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return toGraphQLItem(i), nil
+}
+
+func (r mutationResolver) DeleteItem(ctx context.Context, item generated.DeleteByIDItemInput) (bool, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := requireStaffOrKey(ctx); err != nil {
+		return false, err
+	}
+
+	err := r.server.inventoryClient.DeleteItem(ctx, item.ID)
+	if err != nil {
+		logError(ctx, err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (r mutationResolver) MoveItem(ctx context.Context, move generated.MoveItemInput) (*generated.Item, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := requireStaffOrKey(ctx); err != nil {
+		return nil, err
+	}
+
+	note := ""
+	if move.Note != nil {
+		note = *move.Note
+	}
+
+	i, _, err := r.server.inventoryClient.MoveItem(ctx, move.ItemID, move.LocationID, note)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return toGraphQLItem(i), nil
+}
+
+// Locations
+func (r mutationResolver) CreateLocation(ctx context.Context, location generated.CreateLocationInput) (*generated.Location, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := requireStaffOrKey(ctx); err != nil {
+		return nil, err
+	}
+
+	if location.ClassID != nil {
+		if _, err := r.server.educationClient.GetClass(ctx, *location.ClassID); err != nil {
+			logError(ctx, err)
+			return nil, err
+		}
+	}
+
+	l, err := r.server.inventoryClient.PostLocation(ctx, location.ParentID, string(location.Kind), location.Name, location.Code, location.ClassID)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return toGraphQLLocation(l), nil
+}
+
+func (r mutationResolver) UpdateLocation(ctx context.Context, location generated.UpdateLocationInput) (*generated.Location, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := requireStaffOrKey(ctx); err != nil {
+		return nil, err
+	}
+
+	if location.ClassID != nil {
+		if _, err := r.server.educationClient.GetClass(ctx, *location.ClassID); err != nil {
+			logError(ctx, err)
+			return nil, err
+		}
+	}
+
+	clearClass := location.ClearClass != nil && *location.ClearClass
+
+	l, err := r.server.inventoryClient.UpdateLocation(ctx, location.ID, location.Name, location.Code, location.ClassID, clearClass)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return toGraphQLLocation(l), nil
+}
+
+func (r mutationResolver) DeleteLocation(ctx context.Context, location generated.DeleteByIDLocationInput) (bool, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := requireStaffOrKey(ctx); err != nil {
+		return false, err
+	}
+
+	err := r.server.inventoryClient.DeleteLocation(ctx, location.ID)
+	if err != nil {
+		logError(ctx, err)
 		return false, err
 	}
 	return true, nil
 }
+
+// Maintenance
+func (r mutationResolver) OpenMaintenanceTicket(ctx context.Context, ticket generated.OpenMaintenanceTicketInput) (*generated.MaintenanceTicket, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := requireStaffOrKey(ctx); err != nil {
+		return nil, err
+	}
+
+	t := &inventory.MaintenanceTicket{
+		ItemID:           ticket.ItemID,
+		ScheduleID:       ticket.ScheduleID,
+		Kind:             string(ticket.Kind),
+		Description:      ticket.Description,
+		ExpectedReturnAt: ticket.ExpectedReturnAt,
+	}
+	if ticket.Vendor != nil {
+		t.Vendor = *ticket.Vendor
+	}
+	if ticket.Technician != nil {
+		t.Technician = *ticket.Technician
+	}
+	if ticket.CostCents != nil {
+		t.CostCents = int64(*ticket.CostCents)
+	}
+
+	t, err := r.server.inventoryClient.OpenMaintenanceTicket(ctx, t)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return toGraphQLMaintenanceTicket(t), nil
+}
+
+func (r mutationResolver) UpdateMaintenanceTicket(ctx context.Context, ticket generated.UpdateMaintenanceTicketInput) (*generated.MaintenanceTicket, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := requireStaffOrKey(ctx); err != nil {
+		return nil, err
+	}
+
+	var status *string
+	if ticket.Status != nil {
+		s := string(*ticket.Status)
+		status = &s
+	}
+	var costCents *int64
+	if ticket.CostCents != nil {
+		c := int64(*ticket.CostCents)
+		costCents = &c
+	}
+
+	t, err := r.server.inventoryClient.UpdateMaintenanceTicket(ctx, ticket.ID, status, ticket.Vendor, ticket.Technician, ticket.ExpectedReturnAt, costCents)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return toGraphQLMaintenanceTicket(t), nil
+}
+
+func (r mutationResolver) CreateMaintenanceSchedule(ctx context.Context, schedule generated.CreateMaintenanceScheduleInput) (*generated.MaintenanceSchedule, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := requireStaffOrKey(ctx); err != nil {
+		return nil, err
+	}
+
+	if schedule.IntervalMonths <= 0 {
+		return nil, inventory.ErrInvalidInterval
+	}
+
+	m, err := r.server.inventoryClient.PostMaintenanceSchedule(ctx, schedule.ItemID, schedule.Description, uint32(schedule.IntervalMonths), schedule.FirstDueAt)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return toGraphQLMaintenanceSchedule(m), nil
+}
+
+func (r mutationResolver) DeleteMaintenanceSchedule(ctx context.Context, schedule generated.DeleteByIDMaintenanceScheduleInput) (bool, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := requireStaffOrKey(ctx); err != nil {
+		return false, err
+	}
+
+	err := r.server.inventoryClient.DeleteMaintenanceSchedule(ctx, schedule.ID)
+	if err != nil {
+		logError(ctx, err)
+		return false, err
+	}
+	return true, nil
+}
+
+// Consumables
+func (r mutationResolver) CreateConsumable(ctx context.Context, consumable generated.CreateConsumableInput) (*generated.Consumable, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := requireStaffOrKey(ctx); err != nil {
+		return nil, err
+	}
+
+	var threshold int64
+	if consumable.LowStockThreshold != nil {
+		threshold = int64(*consumable.LowStockThreshold)
+	}
+
+	c, err := r.server.inventoryClient.PostConsumable(ctx, consumable.Sku, consumable.Name, consumable.Unit, threshold)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return toGraphQLConsumable(c), nil
+}
+
+func (r mutationResolver) UpdateConsumable(ctx context.Context, consumable generated.UpdateConsumableInput) (*generated.Consumable, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := requireStaffOrKey(ctx); err != nil {
+		return nil, err
+	}
+
+	var threshold *int64
+	if consumable.LowStockThreshold != nil {
+		t := int64(*consumable.LowStockThreshold)
+		threshold = &t
+	}
+
+	c, err := r.server.inventoryClient.UpdateConsumable(ctx, consumable.ID, consumable.Sku, consumable.Name, consumable.Unit, threshold)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return toGraphQLConsumable(c), nil
+}
+
+func (r mutationResolver) DeleteConsumable(ctx context.Context, consumable generated.DeleteByIDConsumableInput) (bool, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := requireStaffOrKey(ctx); err != nil {
+		return false, err
+	}
+
+	err := r.server.inventoryClient.DeleteConsumable(ctx, consumable.ID)
+	if err != nil {
+		logError(ctx, err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (r mutationResolver) RecordStockMovement(ctx context.Context, movement generated.RecordStockMovementInput) (*generated.StockLevel, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := requireStaffOrKey(ctx); err != nil {
+		return nil, err
+	}
+
+	note := ""
+	if movement.Note != nil {
+		note = *movement.Note
+	}
+
+	_, l, err := r.server.inventoryClient.RecordStockMovement(ctx, movement.ConsumableID, movement.LocationID, string(movement.Kind), int64(movement.Quantity), note)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return toGraphQLStockLevel(l), nil
+}
+
+// Accounts
+func (r mutationResolver) CreateAccount(ctx context.Context, account generated.CreateAccountInput) (*generated.Account, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := r.server.checkClass(ctx, account.ClassID); err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	var insertion, cardNumber, language string
+	if account.Insertion != nil {
+		insertion = *account.Insertion
+	}
+	if account.CardNumber != nil {
+		cardNumber = *account.CardNumber
+	}
+	if account.Language != nil {
+		language = strings.ToLower(string(*account.Language))
+	}
+
+	a, err := r.server.accountClient.PostAccount(ctx, account.FirstName, insertion, account.LastName, account.Email, cardNumber, string(account.Role), language, account.ClassID)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	return toGraphQLAccount(a), nil
+}
+
+func (r mutationResolver) UpdateAccount(ctx context.Context, account generated.UpdateAccountInput) (*generated.Account, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	clearClass := account.ClearClass != nil && *account.ClearClass
+	if !clearClass {
+		if err := r.server.checkClass(ctx, account.ClassID); err != nil {
+			logError(ctx, err)
+			return nil, err
+		}
+	}
+
+	var role, language *string
+	if account.Role != nil {
+		s := string(*account.Role)
+		role = &s
+	}
+	if account.Language != nil {
+		s := strings.ToLower(string(*account.Language))
+		language = &s
+	}
+
+	a, err := r.server.accountClient.UpdateAccount(ctx, account.ID, account.FirstName, account.Insertion, account.LastName, account.Email, account.CardNumber, role, language, account.ClassID, clearClass)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	return toGraphQLAccount(a), nil
+}
+
+func (r mutationResolver) SetAccountState(ctx context.Context, account generated.SetAccountStateInput) (*generated.Account, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	a, err := r.server.accountClient.SetAccountState(ctx, account.ID, string(account.State))
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	return toGraphQLAccount(a), nil
+}
+
+func (r mutationResolver) AnonymiseAccount(ctx context.Context, account generated.AnonymiseAccountInput) (*generated.Account, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	a, err := r.server.accountClient.AnonymiseAccount(ctx, account.ID)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	return toGraphQLAccount(a), nil
+}
+
+func (r mutationResolver) SetClassAccountState(ctx context.Context, class generated.SetClassAccountStateInput) (*generated.AccountBatchResult, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	b, err := r.server.accountClient.SetClassAccountState(ctx, class.ClassID, string(class.State))
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	return toGraphQLAccountBatch(b), nil
+}
+
+func (r mutationResolver) AnonymiseClass(ctx context.Context, class generated.AnonymiseClassInput) (*generated.AccountBatchResult, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	b, err := r.server.accountClient.AnonymiseClass(ctx, class.ClassID)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	return toGraphQLAccountBatch(b), nil
+}
+
+// Login and passwords
+func (r mutationResolver) Login(ctx context.Context, credentials generated.LoginInput) (*generated.Session, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if len(r.server.sessionSecret) == 0 {
+		return nil, errLoginsOff
+	}
+
+	a, secondFactor, err := r.server.accountClient.Authenticate(ctx, credentials.Email, credentials.Password)
+	if status.Code(err) == codes.Unauthenticated {
+		return nil, codedError("wrong email or password", errUnauthenticated)
+	}
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	return r.server.newSession(a, sessionStages[secondFactor]), nil
+}
+
+func (r mutationResolver) ChangePassword(ctx context.Context, password generated.ChangePasswordInput) (bool, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	v := viewer(ctx)
+	if v == nil {
+		return false, codedError("log in first", errUnauthenticated)
+	}
+	_, _, err := r.server.accountClient.Authenticate(ctx, v.Email, password.CurrentPassword)
+	if status.Code(err) == codes.Unauthenticated {
+		return false, codedError("wrong current password", errUnauthenticated)
+	}
+	if err != nil {
+		logError(ctx, err)
+		return false, err
+	}
+
+	if err := r.server.accountClient.SetPassword(ctx, v.ID, password.NewPassword); err != nil {
+		logError(ctx, err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (r mutationResolver) SetAccountPassword(ctx context.Context, account generated.SetAccountPasswordInput) (bool, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := requireAdmin(ctx); err != nil {
+		return false, err
+	}
+
+	if err := r.server.accountClient.SetPassword(ctx, account.ID, account.Password); err != nil {
+		logError(ctx, err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (r mutationResolver) RequestPasswordReset(ctx context.Context, request generated.RequestPasswordResetInput) (bool, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if err := r.server.accountClient.RequestPasswordReset(ctx, request.Email); err != nil {
+		logError(ctx, err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (r mutationResolver) ResetPassword(ctx context.Context, reset generated.ResetPasswordInput) (bool, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if err := r.server.accountClient.ResetPassword(ctx, reset.Token, reset.Password); err != nil {
+		logError(ctx, err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (r mutationResolver) VerifyEmail(ctx context.Context, verification generated.VerifyEmailInput) (*generated.Account, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	a, err := r.server.accountClient.VerifyEmail(ctx, verification.Token, verification.Password)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	return toGraphQLAccount(a), nil
+}
+
+func (r mutationResolver) SendVerificationEmail(ctx context.Context, account generated.SendVerificationEmailInput) (bool, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := requireAdmin(ctx); err != nil {
+		return false, err
+	}
+
+	if err := r.server.accountClient.SendVerification(ctx, account.ID); err != nil {
+		logError(ctx, err)
+		return false, err
+	}
+	return true, nil
+}
+
+// Two-factor authentication
+func (r mutationResolver) VerifyTotp(ctx context.Context, code generated.VerifyTotpInput) (*generated.Session, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	pending := sessionAccount(ctx, stageTOTP)
+	if pending == nil {
+		return nil, codedError("log in with a password first", errUnauthenticated)
+	}
+	a, err := r.server.accountClient.VerifyTOTP(ctx, pending.ID, code.Code)
+	if status.Code(err) == codes.Unauthenticated {
+		return nil, codedError("wrong or already used code", errUnauthenticated)
+	}
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	return r.server.newSession(a, stageFull), nil
+}
+
+func (r mutationResolver) BeginTotp(ctx context.Context) (*generated.TotpSetup, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	a := sessionAccount(ctx, stageFull, stageEnrol)
+	if a == nil {
+		return nil, codedError("log in first", errUnauthenticated)
+	}
+	setup, err := r.server.accountClient.BeginTOTP(ctx, a.ID)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	return &generated.TotpSetup{Secret: setup.Secret, URI: setup.URI}, nil
+}
+
+// EnableTotp finishes an ENROL_TOTP login as well, the code just proved the
+// second factor.
+func (r mutationResolver) EnableTotp(ctx context.Context, totp generated.EnableTotpInput) (*generated.TotpEnabled, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	a := sessionAccount(ctx, stageFull, stageEnrol)
+	if a == nil {
+		return nil, codedError("log in first", errUnauthenticated)
+	}
+	recoveryCodes, err := r.server.accountClient.EnableTOTP(ctx, a.ID, totp.Code)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	res := &generated.TotpEnabled{RecoveryCodes: recoveryCodes}
+	if viewer(ctx) == nil {
+		updated, err := r.server.accountClient.GetAccount(ctx, a.ID)
+		if err != nil {
+			logError(ctx, err)
+			return nil, err
+		}
+		res.Session = r.server.newSession(updated, stageFull)
+	}
+	return res, nil
+}
+
+func (r mutationResolver) DisableTotp(ctx context.Context, totp generated.DisableTotpInput) (*generated.Account, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	v := viewer(ctx)
+	if v == nil {
+		return nil, codedError("log in first", errUnauthenticated)
+	}
+	a, err := r.server.accountClient.DisableTOTP(ctx, v.ID, totp.Code)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	return toGraphQLAccount(a), nil
+}
+
+func (r mutationResolver) ResetCalendarFeeds(ctx context.Context) (*generated.Account, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	v := viewer(ctx)
+	if v == nil {
+		return nil, codedError("log in first", errUnauthenticated)
+	}
+	a, err := r.server.accountClient.ResetCalendarFeeds(ctx, v.ID)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	return toGraphQLAccount(a), nil
+}
+
+func (r mutationResolver) ResetAccountTotp(ctx context.Context, account generated.ResetAccountTotpInput) (*generated.Account, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	admin, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	a, err := r.server.accountClient.ResetTOTP(ctx, account.ID)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	slog.InfoContext(ctx, "Reset two-factor authentication", "account", account.ID, "by", admin.ID)
+	return toGraphQLAccount(a), nil
+}
+
+func (r mutationResolver) CreateAPIKey(ctx context.Context, apiKey generated.CreateAPIKeyInput) (*generated.CreatedAPIKey, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	admin, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	k, key, err := r.server.accountClient.CreateAPIKey(ctx, apiKey.AccountID, apiKey.Name, apiKey.Scopes)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	slog.InfoContext(ctx, "Created API key", "account", apiKey.AccountID, "key", k.ID, "by", admin.ID)
+	return &generated.CreatedAPIKey{Key: key, APIKey: toGraphQLAPIKey(k)}, nil
+}
+
+func (r mutationResolver) RevokeAPIKey(ctx context.Context, apiKey generated.RevokeAPIKeyInput) (*generated.APIKey, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	admin, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	k, err := r.server.accountClient.RevokeAPIKey(ctx, apiKey.ID)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	slog.InfoContext(ctx, "Revoked API key", "account", k.AccountID, "key", k.ID, "by", admin.ID)
+	return toGraphQLAPIKey(k), nil
+}
+
+// ExportAccountData answers a subject access request. Who asked for whose
+// data is logged, the export itself isn't kept.
+func (r mutationResolver) ExportAccountData(ctx context.Context, account generated.ExportAccountDataInput) (*generated.AccountDataExport, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	admin, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	export, err := r.server.exportAccountData(ctx, account.ID, time.Now())
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	data, err := export.json()
+	if err != nil {
+		return nil, err
+	}
+
+	res := &generated.AccountDataExport{
+		AccountID:   account.ID,
+		GeneratedAt: export.GeneratedAt,
+		JSON:        string(data),
+	}
+	if account.Zip != nil && *account.Zip {
+		z, err := export.zip(data)
+		if err != nil {
+			return nil, err
+		}
+		encoded := base64.StdEncoding.EncodeToString(z)
+		res.Zip = &encoded
+	}
+
+	slog.InfoContext(ctx, "Exported account data", "account", account.ID, "by", admin.ID)
+	return res, nil
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jochem11/inventory-system-back/account"
+	"github.com/jochem11/inventory-system-back/graphql/generated"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// TestMutationRoles only covers the refusals, which are answered before any
+// service is called.
+func TestMutationRoles(t *testing.T) {
+	r := mutationResolver{&Server{rpcTimeout: time.Second, exportTimeout: time.Second}}
+	person := func(role string) *session {
+		return &session{account: &account.Account{ID: "a", Role: role, State: account.StateActive}, stage: stageFull}
+	}
+	pending := func(stage string) *session {
+		return &session{account: &account.Account{ID: "a", Role: account.RoleAdmin, State: account.StateActive}, stage: stage}
+	}
+	key := &session{account: &account.Account{ID: "k", Role: account.RoleService}, stage: stageFull, scopes: []string{"education:write", "inventory:write"}, keyID: "key"}
+
+	mutations := map[string]func(context.Context) error{
+		"createCourse": func(ctx context.Context) error {
+			_, err := r.CreateCourse(ctx, generated.CreateCourseInput{Name: "Biology"})
+			return err
+		},
+		"deleteCourse": func(ctx context.Context) error {
+			_, err := r.DeleteCourse(ctx, generated.DeleteByIDCourseInput{ID: "c"})
+			return err
+		},
+		"deleteClass": func(ctx context.Context) error {
+			_, err := r.DeleteClass(ctx, generated.DeleteByIDClassInput{ID: "c"})
+			return err
+		},
+		"rolloverYear": func(ctx context.Context) error {
+			_, err := r.RolloverYear(ctx, generated.RolloverYearInput{FromYearID: "a", ToYearID: "b"})
+			return err
+		},
+		"deleteAcademicYear": func(ctx context.Context) error {
+			_, err := r.DeleteAcademicYear(ctx, generated.DeleteByIDAcademicYearInput{ID: "y"})
+			return err
+		},
+		"deleteTerm": func(ctx context.Context) error {
+			_, err := r.DeleteTerm(ctx, generated.DeleteByIDTermInput{ID: "t"})
+			return err
+		},
+		"deleteItem": func(ctx context.Context) error {
+			_, err := r.DeleteItem(ctx, generated.DeleteByIDItemInput{ID: "i"})
+			return err
+		},
+		"recordStockMovement": func(ctx context.Context) error {
+			_, err := r.RecordStockMovement(ctx, generated.RecordStockMovementInput{ConsumableID: "c", LocationID: "l", Kind: generated.StockMovementKindIssue, Quantity: 1})
+			return err
+		},
+	}
+
+	tests := []struct {
+		name     string
+		session  *session
+		mutation string
+		want     string
+	}{
+		{"anonymous createCourse", nil, "createCourse", errUnauthenticated},
+		{"anonymous deleteItem", nil, "deleteItem", errUnauthenticated},
+		{"student createCourse", person(account.RoleStudent), "createCourse", errForbidden},
+		{"student deleteItem", person(account.RoleStudent), "deleteItem", errForbidden},
+		{"student recordStockMovement", person(account.RoleStudent), "recordStockMovement", errForbidden},
+		{"student deleteCourse", person(account.RoleStudent), "deleteCourse", errForbidden},
+		{"teacher deleteCourse", person(account.RoleTeacher), "deleteCourse", errForbidden},
+		{"teacher deleteClass", person(account.RoleTeacher), "deleteClass", errForbidden},
+		{"teacher rolloverYear", person(account.RoleTeacher), "rolloverYear", errForbidden},
+		{"teacher deleteAcademicYear", person(account.RoleTeacher), "deleteAcademicYear", errForbidden},
+		{"teacher deleteTerm", person(account.RoleTeacher), "deleteTerm", errForbidden},
+		{"API key deleteCourse", key, "deleteCourse", errForbidden},
+		{"admin before the TOTP step deleteCourse", pending(stageTOTP), "deleteCourse", errUnauthenticated},
+		{"admin before enrolling deleteCourse", pending(stageEnrol), "deleteCourse", errUnauthenticated},
+		{"admin before the TOTP step deleteItem", pending(stageTOTP), "deleteItem", errUnauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.session != nil {
+				ctx = context.WithValue(ctx, sessionKey{}, tt.session)
+			}
+			err := mutations[tt.mutation](ctx)
+			var gqlErr *gqlerror.Error
+			if !errors.As(err, &gqlErr) || gqlErr.Extensions["code"] != tt.want {
+				t.Errorf("%s = %v, want a %s error", tt.mutation, err, tt.want)
+			}
+		})
+	}
+}
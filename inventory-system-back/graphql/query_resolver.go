@@ -2,8 +2,9 @@ package main
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"github.com/jochem11/inventory-system-back/graphql/generated"
-	"log"
 	"time"
 )
 
@@ -11,107 +12,544 @@ type queryResolver struct {
 	server *Server
 }
 
-func (r queryResolver) Courses(ctx context.Context, pagination *generated.PaginationInput, id *string) ([]*generated.Course, error) {
-	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+func (r queryResolver) Courses(ctx context.Context, pagination *generated.PaginationInput, id *string, yearID *string) ([]*generated.Course, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
 	defer cancel()
 
 	if id != nil {
 		r, err := r.server.educationClient.GetCourse(ctx, *id)
 		if err != nil {
-			log.Println(err)
+			logError(ctx, err)
 			return nil, err
 		}
-		return []*generated.Course{{
-			ID:        r.ID,
-			Name:      r.Name,
-			UpdatedAt: r.UpdatedAt,
-			CreatedAt: r.CreatedAt,
-		}}, nil
+		return []*generated.Course{toGraphQLCourse(r)}, nil
 	}
 
-	skip, take := getPaginationBounds(pagination)
+	skip, take, err := r.server.pagination(pagination)
+	if err != nil {
+		return nil, err
+	}
 
-	coursesList, err := r.server.educationClient.GetCourses(ctx, skip, take)
+	coursesList, err := r.server.educationClient.GetCourses(ctx, skip, take, yearID)
 	if err != nil {
-		log.Println(err)
+		logError(ctx, err)
 		return nil, err
 	}
 
 	var courses []*generated.Course
 	for _, course := range coursesList {
-		courses = append(courses, &generated.Course{
-			ID:        course.ID,
-			Name:      course.Name,
-			UpdatedAt: course.UpdatedAt,
-			CreatedAt: course.CreatedAt,
-		})
+		courses = append(courses, toGraphQLCourse(course))
 	}
 	return courses, nil
 }
 
-func (r queryResolver) Classes(ctx context.Context, pagination *generated.PaginationInput, id *string) ([]*generated.Class, error) {
-	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+func (r queryResolver) Classes(ctx context.Context, pagination *generated.PaginationInput, id *string, termID *string) ([]*generated.Class, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
 	defer cancel()
 
 	if id != nil {
 		r, err := r.server.educationClient.GetClass(ctx, *id)
 		if err != nil {
-			log.Println(err)
+			logError(ctx, err)
 			return nil, err
 		}
 
-		return []*generated.Class{{
-			ID:       r.ID,
-			Name:     r.Name,
-			CourseID: r.CourseID,
-			Course: &generated.Course{
-				ID:        r.Course.ID,
-				Name:      r.Course.Name,
-				UpdatedAt: r.Course.UpdatedAt,
-				CreatedAt: r.Course.CreatedAt,
-			},
-			UpdatedAt: r.UpdatedAt,
-			CreatedAt: r.CreatedAt,
-		}}, nil
+		return []*generated.Class{toGraphQLClass(r)}, nil
 	}
 
-	skip, take := getPaginationBounds(pagination)
+	skip, take, err := r.server.pagination(pagination)
+	if err != nil {
+		return nil, err
+	}
 
-	classesList, err := r.server.educationClient.GetClasses(ctx, skip, take)
+	classesList, err := r.server.educationClient.GetClasses(ctx, skip, take, termID)
 	if err != nil {
-		log.Println(err)
+		logError(ctx, err)
 		return nil, err
 	}
 
 	var classes []*generated.Class
 	for _, class := range classesList {
-		classes = append(classes, &generated.Class{
-			ID:       class.ID,
-			Name:     class.Name,
-			CourseID: class.CourseID,
-			Course: &generated.Course{
-				ID:        class.Course.ID,
-				Name:      class.Course.Name,
-				UpdatedAt: class.Course.UpdatedAt,
-				CreatedAt: class.Course.CreatedAt,
-			},
-			UpdatedAt: class.UpdatedAt,
-			CreatedAt: class.CreatedAt,
-		})
+		classes = append(classes, toGraphQLClass(class))
 	}
 	return classes, nil
 }
 
-func getPaginationBounds(p *generated.PaginationInput) (uint64, uint64) {
-	skipValue := uint64(0)
-	takeValue := uint64(100)
-	if p != nil {
-		if p.Skip != nil {
-			skipValue = uint64(*p.Skip)
+func (r queryResolver) AcademicYears(ctx context.Context, pagination *generated.PaginationInput) ([]*generated.AcademicYear, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	skip, take, err := r.server.pagination(pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := r.server.educationClient.GetAcademicYears(ctx, skip, take)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	years := []*generated.AcademicYear{}
+	for _, y := range res {
+		years = append(years, toGraphQLAcademicYear(y))
+	}
+	return years, nil
+}
+
+func (r queryResolver) Terms(ctx context.Context, yearID string) ([]*generated.Term, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	return r.server.terms(ctx, yearID)
+}
+
+func (r queryResolver) CurrentTerm(ctx context.Context) (*generated.Term, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	t, err := r.server.educationClient.GetCurrentTerm(ctx)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	if t == nil {
+		return nil, nil
+	}
+	return toGraphQLTerm(t), nil
+}
+
+func (r queryResolver) LessonSlots(ctx context.Context, classID string) ([]*generated.LessonSlot, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	res, err := r.server.educationClient.GetLessonSlots(ctx, classID)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	slots := []*generated.LessonSlot{}
+	for _, l := range res {
+		slots = append(slots, toGraphQLLessonSlot(l))
+	}
+	return slots, nil
+}
+
+func (r queryResolver) LessonExceptions(ctx context.Context, from time.Time, to time.Time) ([]*generated.LessonException, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	res, err := r.server.educationClient.GetLessonExceptions(ctx, from, to)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	exceptions := []*generated.LessonException{}
+	for _, e := range res {
+		exceptions = append(exceptions, toGraphQLLessonException(e))
+	}
+	return exceptions, nil
+}
+
+func (r queryResolver) Lessons(ctx context.Context, classID string, from time.Time, to time.Time) ([]*generated.Lesson, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	res, err := r.server.educationClient.GetLessons(ctx, classID, from, to)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	return toGraphQLLessons(res), nil
+}
+
+func (r queryResolver) LessonsInRoom(ctx context.Context, roomID string, at *time.Time) ([]*generated.Lesson, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	when := time.Now()
+	if at != nil {
+		when = *at
+	}
+
+	res, err := r.server.educationClient.GetLessonsInRoom(ctx, roomID, when)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	return toGraphQLLessons(res), nil
+}
+
+func (r queryResolver) NextLesson(ctx context.Context, classID string, after *time.Time) (*generated.Lesson, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	when := time.Now()
+	if after != nil {
+		when = *after
+	}
+
+	l, err := r.server.educationClient.GetNextLesson(ctx, classID, when)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	if l == nil {
+		return nil, nil
+	}
+	return toGraphQLLesson(l), nil
+}
+
+func (r queryResolver) EquipmentPlan(ctx context.Context, classID string) (*generated.EquipmentPlan, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	plan, err := r.server.equipmentPlan(ctx, classID)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	return plan, nil
+}
+
+func (r queryResolver) Items(ctx context.Context, pagination *generated.PaginationInput, id *string) ([]*generated.Item, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if id != nil {
+		i, err := r.server.inventoryClient.GetItem(ctx, *id)
+		if err != nil {
+			logError(ctx, err)
+			return nil, err
+		}
+		return []*generated.Item{toGraphQLItem(i)}, nil
+	}
+
+	skip, take, err := r.server.pagination(pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	itemsList, err := r.server.inventoryClient.GetItems(ctx, skip, take)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	var items []*generated.Item
+	for _, i := range itemsList {
+		items = append(items, toGraphQLItem(i))
+	}
+	return items, nil
+}
+
+func (r queryResolver) ItemMoves(ctx context.Context, pagination *generated.PaginationInput, itemID string) ([]*generated.ItemMove, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	skip, take, err := r.server.pagination(pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	movesList, err := r.server.inventoryClient.GetItemMoves(ctx, skip, take, itemID)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	var moves []*generated.ItemMove
+	for _, m := range movesList {
+		moves = append(moves, toGraphQLItemMove(m))
+	}
+	return moves, nil
+}
+
+func (r queryResolver) ItemsInLocation(ctx context.Context, pagination *generated.PaginationInput, locationID *string, code *string, includeSubLocations *bool) ([]*generated.Item, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if locationID == nil {
+		if code == nil {
+			return nil, errors.New("either locationId or code is required")
 		}
-		if p.Take != nil {
-			takeValue = uint64(*p.Take)
+		locations, err := r.server.inventoryClient.GetLocations(ctx, 0, 1, nil, code, nil)
+		if err != nil {
+			logError(ctx, err)
+			return nil, err
 		}
+		if len(locations) == 0 {
+			return nil, fmt.Errorf("no location with code %q", *code)
+		}
+		locationID = &locations[0].ID
+	}
+
+	skip, take, err := r.server.pagination(pagination)
+	if err != nil {
+		return nil, err
+	}
+	recursive := includeSubLocations == nil || *includeSubLocations
+
+	itemsList, err := r.server.inventoryClient.GetItemsInLocation(ctx, skip, take, *locationID, recursive)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	var items []*generated.Item
+	for _, i := range itemsList {
+		items = append(items, toGraphQLItem(i))
+	}
+	return items, nil
+}
+
+func (r queryResolver) ClassEquipment(ctx context.Context, pagination *generated.PaginationInput, classID string) ([]*generated.Item, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	skip, take, err := r.server.pagination(pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	itemsList, err := r.server.inventoryClient.GetItemsByClass(ctx, skip, take, classID)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	var items []*generated.Item
+	for _, i := range itemsList {
+		items = append(items, toGraphQLItem(i))
+	}
+	return items, nil
+}
+
+func (r queryResolver) Locations(ctx context.Context, pagination *generated.PaginationInput, id *string, parentID *string, code *string, classID *string) ([]*generated.Location, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if id != nil {
+		l, err := r.server.inventoryClient.GetLocation(ctx, *id)
+		if err != nil {
+			logError(ctx, err)
+			return nil, err
+		}
+		return []*generated.Location{toGraphQLLocation(l)}, nil
+	}
+
+	skip, take, err := r.server.pagination(pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	locationsList, err := r.server.inventoryClient.GetLocations(ctx, skip, take, parentID, code, classID)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	var locations []*generated.Location
+	for _, l := range locationsList {
+		locations = append(locations, toGraphQLLocation(l))
+	}
+	return locations, nil
+}
+
+func (r queryResolver) OpenMaintenanceTickets(ctx context.Context, pagination *generated.PaginationInput, itemID *string) ([]*generated.MaintenanceTicket, error) {
+	return r.maintenanceTickets(ctx, pagination, itemID, true)
+}
+
+func (r queryResolver) MaintenanceTickets(ctx context.Context, pagination *generated.PaginationInput, itemID *string) ([]*generated.MaintenanceTicket, error) {
+	return r.maintenanceTickets(ctx, pagination, itemID, false)
+}
+
+func (r queryResolver) maintenanceTickets(ctx context.Context, pagination *generated.PaginationInput, itemID *string, openOnly bool) ([]*generated.MaintenanceTicket, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	skip, take, err := r.server.pagination(pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	ticketsList, err := r.server.inventoryClient.GetMaintenanceTickets(ctx, skip, take, itemID, openOnly)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	var tickets []*generated.MaintenanceTicket
+	for _, t := range ticketsList {
+		tickets = append(tickets, toGraphQLMaintenanceTicket(t))
+	}
+	return tickets, nil
+}
+
+func (r queryResolver) MaintenanceSchedules(ctx context.Context, pagination *generated.PaginationInput, dueBefore *time.Time) ([]*generated.MaintenanceSchedule, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	skip, take, err := r.server.pagination(pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	schedulesList, err := r.server.inventoryClient.GetMaintenanceSchedules(ctx, skip, take, dueBefore)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	var schedules []*generated.MaintenanceSchedule
+	for _, m := range schedulesList {
+		schedules = append(schedules, toGraphQLMaintenanceSchedule(m))
+	}
+	return schedules, nil
+}
+
+func (r queryResolver) Consumables(ctx context.Context, pagination *generated.PaginationInput, id *string) ([]*generated.Consumable, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if id != nil {
+		c, err := r.server.inventoryClient.GetConsumable(ctx, *id)
+		if err != nil {
+			logError(ctx, err)
+			return nil, err
+		}
+		return []*generated.Consumable{toGraphQLConsumable(c)}, nil
+	}
+
+	return r.consumables(ctx, pagination, false)
+}
+
+func (r queryResolver) LowStockConsumables(ctx context.Context, pagination *generated.PaginationInput) ([]*generated.Consumable, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	return r.consumables(ctx, pagination, true)
+}
+
+func (r queryResolver) consumables(ctx context.Context, pagination *generated.PaginationInput, lowStockOnly bool) ([]*generated.Consumable, error) {
+	skip, take, err := r.server.pagination(pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	consumablesList, err := r.server.inventoryClient.GetConsumables(ctx, skip, take, lowStockOnly)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	var consumables []*generated.Consumable
+	for _, c := range consumablesList {
+		consumables = append(consumables, toGraphQLConsumable(c))
+	}
+	return consumables, nil
+}
+
+func (r queryResolver) StockLevels(ctx context.Context, consumableID string) ([]*generated.StockLevel, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	levelsList, err := r.server.inventoryClient.GetStockLevels(ctx, consumableID)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	var levels []*generated.StockLevel
+	for _, l := range levelsList {
+		levels = append(levels, toGraphQLStockLevel(l))
+	}
+	return levels, nil
+}
+
+func (r queryResolver) StockMovements(ctx context.Context, pagination *generated.PaginationInput, consumableID string) ([]*generated.StockMovement, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	skip, take, err := r.server.pagination(pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	movementsList, err := r.server.inventoryClient.GetStockMovements(ctx, skip, take, consumableID)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	var movements []*generated.StockMovement
+	for _, m := range movementsList {
+		movements = append(movements, toGraphQLStockMovement(m))
+	}
+	return movements, nil
+}
+
+func (r queryResolver) Accounts(ctx context.Context, pagination *generated.PaginationInput, id *string, classID *string, state *generated.AccountState) ([]*generated.Account, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := requireStaff(ctx); err != nil {
+		return nil, err
+	}
+
+	if id != nil {
+		a, err := r.server.accountClient.GetAccount(ctx, *id)
+		if err != nil {
+			logError(ctx, err)
+			return nil, err
+		}
+		return []*generated.Account{toGraphQLAccount(a)}, nil
+	}
+
+	skip, take, err := r.server.pagination(pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	var s *string
+	if state != nil {
+		v := string(*state)
+		s = &v
+	}
+
+	accounts, err := r.server.accountClient.GetAccounts(ctx, skip, take, classID, s)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	return toGraphQLAccounts(accounts), nil
+}
+
+func (r queryResolver) Me(ctx context.Context) (*generated.Account, error) {
+	v := viewer(ctx)
+	if v == nil {
+		return nil, nil
+	}
+	return toGraphQLAccount(v), nil
+}
+
+func (r queryResolver) APIKeys(ctx context.Context, accountID string) ([]*generated.APIKey, error) {
+	ctx, cancel := r.server.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	keys, err := r.server.accountClient.GetAPIKeys(ctx, accountID)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	res := []*generated.APIKey{}
+	for _, k := range keys {
+		res = append(res, toGraphQLAPIKey(k))
 	}
-	return skipValue, takeValue
+	return res, nil
 }
@@ -0,0 +1,312 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"golang.org/x/time/rate"
+)
+
+const (
+	errRateLimited          = "RATE_LIMITED"
+	errTooManySubscriptions = "TOO_MANY_SUBSCRIPTIONS"
+	errRequestTooLarge      = "REQUEST_TOO_LARGE"
+)
+
+// Buckets of clients that were quiet this long are dropped, they would be
+// full again anyway.
+const bucketIdleTTL = 10 * time.Minute
+
+type RateLimitConfig struct {
+	// RPS and Burst size the token bucket every request of a client draws
+	// from.
+	RPS   float64 `envconfig:"RPS" default:"10"`
+	Burst int     `envconfig:"BURST" default:"20"`
+	// Mutations also draw from a second, smaller bucket. A MutationRPS of 0
+	// switches it off.
+	MutationRPS   float64 `envconfig:"MUTATION_RPS" default:"2"`
+	MutationBurst int     `envconfig:"MUTATION_BURST" default:"5"`
+	// MaxSubscriptions caps the subscriptions a client has open at once.
+	MaxSubscriptions int `envconfig:"MAX_SUBSCRIPTIONS" default:"5"`
+	// TrustedProxies is how many proxies in front of the gateway append to
+	// X-Forwarded-For. The client address is the hop the outermost of them
+	// added, anything left of it is up to the client. Leave it at 0 when the
+	// gateway is reached directly.
+	TrustedProxies int `envconfig:"TRUSTED_PROXIES" default:"0"`
+}
+
+func (c RateLimitConfig) Validate() error {
+	if c.RPS <= 0 || c.Burst < 1 {
+		return errors.New("RATE_LIMIT_RPS and RATE_LIMIT_BURST must be positive")
+	}
+	if c.MutationRPS < 0 || (c.MutationRPS > 0 && c.MutationBurst < 1) {
+		return errors.New("RATE_LIMIT_MUTATION_RPS can't be negative and needs a positive RATE_LIMIT_MUTATION_BURST")
+	}
+	if c.MaxSubscriptions < 1 {
+		return errors.New("RATE_LIMIT_MAX_SUBSCRIPTIONS must be positive")
+	}
+	if c.TrustedProxies < 0 {
+		return errors.New("RATE_LIMIT_TRUSTED_PROXIES can't be negative")
+	}
+	return nil
+}
+
+// limits keeps the per-client state. Requests made with a session or API key
+// are charged to the account or key, anonymous ones to their address.
+type limits struct {
+	cfg           RateLimitConfig
+	requests      *buckets
+	mutations     *buckets
+	subscriptions *counter
+}
+
+func newLimits(cfg RateLimitConfig) *limits {
+	l := &limits{
+		cfg:           cfg,
+		requests:      newBuckets(cfg.RPS, cfg.Burst),
+		subscriptions: &counter{n: map[string]int{}},
+	}
+	if cfg.MutationRPS > 0 {
+		l.mutations = newBuckets(cfg.MutationRPS, cfg.MutationBurst)
+	}
+	return l
+}
+
+type clientKey struct{}
+
+// client is what the middleware hands down to the GraphQL extension.
+type client struct {
+	key    string
+	header http.Header
+}
+
+func clientFrom(ctx context.Context) (client, bool) {
+	c, ok := ctx.Value(clientKey{}).(client)
+	return c, ok
+}
+
+// middleware charges every request to its client and answers 429 once the
+// bucket is empty. The X-RateLimit headers go out on every response. It goes
+// after authenticate, so it knows who is asking.
+func (l *limits) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := l.clientKey(r)
+		ok, remaining, wait := l.requests.take(key)
+		setRateHeaders(w.Header(), l.requests.burst, remaining, wait)
+		if !ok {
+			writeGraphQLError(w, http.StatusTooManyRequests, "too many requests, slow down", errRateLimited)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), clientKey{}, client{key, w.Header()})
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// clientKey names the bucket of r: its API key, its account or, for
+// anonymous requests, its address.
+func (l *limits) clientKey(r *http.Request) string {
+	if s, _ := r.Context().Value(sessionKey{}).(*session); s != nil {
+		return sessionBucket(s)
+	}
+	return "addr:" + l.clientAddr(r)
+}
+
+func sessionBucket(s *session) string {
+	if s.keyID != "" {
+		return "key:" + s.keyID
+	}
+	return "account:" + s.account.ID
+}
+
+// clientAddr is the address of the client, counting TrustedProxies hops
+// back from the right of X-Forwarded-For.
+func (l *limits) clientAddr(r *http.Request) string {
+	if n := l.cfg.TrustedProxies; n > 0 {
+		var hops []string
+		for _, v := range r.Header.Values("X-Forwarded-For") {
+			for _, hop := range strings.Split(v, ",") {
+				if hop = strings.TrimSpace(hop); hop != "" {
+					hops = append(hops, hop)
+				}
+			}
+		}
+		if len(hops) > 0 {
+			// Fewer hops than proxies means the request skipped the outer
+			// ones, the leftmost hop is then the furthest a proxy saw.
+			return hops[max(len(hops)-n, 0)]
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func setRateHeaders(h http.Header, limit, remaining int, wait time.Duration) {
+	h.Set("X-RateLimit-Limit", strconv.Itoa(limit))
+	h.Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+	if wait > 0 {
+		h.Set("Retry-After", strconv.Itoa(retryAfter(wait)))
+	}
+}
+
+// retryAfter rounds wait up to whole seconds, as Retry-After wants them.
+func retryAfter(wait time.Duration) int {
+	return int(math.Ceil(wait.Seconds()))
+}
+
+func writeGraphQLError(w http.ResponseWriter, status int, msg, code string) {
+	err := gqlerror.Errorf("%s", msg)
+	errcode.Set(err, code)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(graphql.Response{Errors: gqlerror.List{err}})
+}
+
+// rateLimiter applies the limits that depend on the operation: the mutation
+// budget and the subscription cap.
+type rateLimiter struct {
+	*limits
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+	graphql.OperationInterceptor
+} = rateLimiter{}
+
+func (rateLimiter) ExtensionName() string {
+	return "RateLimit"
+}
+
+func (rateLimiter) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (l rateLimiter) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	c, ok := clientFrom(ctx)
+	if !ok || l.mutations == nil || opCtx.Operation == nil || opCtx.Operation.Operation != ast.Mutation {
+		return nil
+	}
+
+	allowed, remaining, wait := l.mutations.take(c.key)
+	if !allowed {
+		setRateHeaders(c.header, l.mutations.burst, remaining, wait)
+		err := gqlerror.Errorf("too many mutations, retry in %ds", retryAfter(wait))
+		errcode.Set(err, errRateLimited)
+		return err
+	}
+	return nil
+}
+
+func (l rateLimiter) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	c, ok := clientFrom(ctx)
+	oc := graphql.GetOperationContext(ctx)
+	if !ok || oc.Operation == nil || oc.Operation.Operation != ast.Subscription {
+		return next(ctx)
+	}
+
+	if !l.subscriptions.acquire(c.key, l.cfg.MaxSubscriptions) {
+		err := gqlerror.Errorf("at most %d subscriptions can be open at once", l.cfg.MaxSubscriptions)
+		errcode.Set(err, errTooManySubscriptions)
+		return graphql.OneShot(&graphql.Response{Errors: gqlerror.List{err}})
+	}
+	go func() {
+		<-ctx.Done()
+		l.subscriptions.release(c.key)
+	}()
+	return next(ctx)
+}
+
+// buckets holds a token bucket per client.
+type buckets struct {
+	limit rate.Limit
+	burst int
+
+	mu      sync.Mutex
+	clients map[string]*bucket
+	swept   time.Time
+}
+
+type bucket struct {
+	limiter *rate.Limiter
+	seen    time.Time
+}
+
+func newBuckets(rps float64, burst int) *buckets {
+	return &buckets{
+		limit:   rate.Limit(rps),
+		burst:   burst,
+		clients: map[string]*bucket{},
+		swept:   time.Now(),
+	}
+}
+
+// take draws a token for key. It returns whether there was one, how many are
+// left and, when there wasn't, how long until there is.
+func (b *buckets) take(key string) (bool, int, time.Duration) {
+	now := time.Now()
+
+	b.mu.Lock()
+	if now.Sub(b.swept) > bucketIdleTTL {
+		for k, c := range b.clients {
+			if now.Sub(c.seen) > bucketIdleTTL {
+				delete(b.clients, k)
+			}
+		}
+		b.swept = now
+	}
+	c, ok := b.clients[key]
+	if !ok {
+		c = &bucket{limiter: rate.NewLimiter(b.limit, b.burst)}
+		b.clients[key] = c
+	}
+	c.seen = now
+	b.mu.Unlock()
+
+	res := c.limiter.ReserveN(now, 1)
+	if delay := res.DelayFrom(now); delay > 0 {
+		res.CancelAt(now)
+		return false, 0, delay
+	}
+	return true, int(c.limiter.TokensAt(now)), 0
+}
+
+type counter struct {
+	mu sync.Mutex
+	n  map[string]int
+}
+
+func (c *counter) acquire(key string, max int) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.n[key] >= max {
+		return false
+	}
+	c.n[key]++
+	return true
+}
+
+func (c *counter) release(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.n[key] <= 1 {
+		delete(c.n, key)
+		return
+	}
+	c.n[key]--
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/jochem11/inventory-system-back/account"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// scopeCheck turns away operations of API key sessions that use a root
+// field outside the key's scopes. A root field belongs to the domain of the
+// schema file it is declared in, so every field in inventory.graphql needs
+// inventory:read, or inventory:write for mutations. Only root fields are
+// checked, a key that may read accounts may also read the class of one.
+//
+// Operations without a full session may only use openFields. Logged in
+// people get through, the resolvers check their role.
+type scopeCheck struct{}
+
+// openFields are the root fields that need no full session, by the stage of
+// the session: the ones that log in or reset a password, and the ones that
+// finish a login.
+var openFields = map[string][]string{
+	"":         {"login", "requestPasswordReset", "resetPassword", "verifyEmail", "me"},
+	stageTOTP:  {"login", "requestPasswordReset", "resetPassword", "verifyEmail", "me", "verifyTotp"},
+	stageEnrol: {"login", "requestPasswordReset", "resetPassword", "verifyEmail", "me", "beginTotp", "enableTotp"},
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.HandlerExtension
+} = scopeCheck{}
+
+func (scopeCheck) ExtensionName() string {
+	return "ScopeCheck"
+}
+
+func (scopeCheck) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (scopeCheck) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	op := opCtx.Doc.Operations.ForName(opCtx.OperationName)
+	if op == nil {
+		return nil
+	}
+	s, _ := ctx.Value(sessionKey{}).(*session)
+	if s == nil || s.stage != stageFull {
+		var stage string
+		if s != nil {
+			stage = s.stage
+		}
+		for _, f := range rootFields(op.SelectionSet) {
+			if !slices.Contains(openFields[stage], f.Name) {
+				err := gqlerror.Errorf("%s needs you to log in first", f.Name)
+				errcode.Set(err, errUnauthenticated)
+				return err
+			}
+		}
+		return nil
+	}
+	if s.scopes == nil {
+		return nil
+	}
+
+	access := account.ScopeRead
+	if op.Operation == ast.Mutation {
+		access = account.ScopeWrite
+	}
+	for _, f := range rootFields(op.SelectionSet) {
+		domain := fieldDomain(f)
+		if !account.HasScope(s.scopes, domain, access) {
+			err := gqlerror.Errorf("%s needs the %s:%s scope", f.Name, domain, access)
+			errcode.Set(err, errForbidden)
+			return err
+		}
+	}
+	return nil
+}
+
+func rootFields(set ast.SelectionSet) []*ast.Field {
+	var fields []*ast.Field
+	for _, sel := range set {
+		switch s := sel.(type) {
+		case *ast.Field:
+			// __typename and introspection belong to no domain.
+			if !strings.HasPrefix(s.Name, "__") {
+				fields = append(fields, s)
+			}
+		case *ast.InlineFragment:
+			fields = append(fields, rootFields(s.SelectionSet)...)
+		case *ast.FragmentSpread:
+			if s.Definition != nil {
+				fields = append(fields, rootFields(s.Definition.SelectionSet)...)
+			}
+		}
+	}
+	return fields
+}
+
+// fieldDomain is the base name of the schema file that declares f, e.g.
+// inventory for schemas/inventory.graphql.
+func fieldDomain(f *ast.Field) string {
+	if f.Definition == nil || f.Definition.Position == nil || f.Definition.Position.Src == nil {
+		return ""
+	}
+	return strings.TrimSuffix(path.Base(f.Definition.Position.Src.Name), ".graphql")
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/jochem11/inventory-system-back/account"
+	"github.com/jochem11/inventory-system-back/graphql/generated"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestScopeCheck(t *testing.T) {
+	schema := generated.NewExecutableSchema(generated.Config{}).Schema()
+	// payroll stands in for a schema file that isn't a scope domain.
+	payroll := gqlparser.MustLoadSchema(&ast.Source{Name: "../schemas/payroll.graphql", Input: "type Query { salaries: [Int!]! }"})
+
+	key := func(scopes ...string) *session {
+		return &session{account: &account.Account{ID: "k", Role: account.RoleService}, stage: stageFull, scopes: scopes, keyID: "key"}
+	}
+	person := func(stage string) *session {
+		return &session{account: &account.Account{ID: "a", Role: account.RoleAdmin}, stage: stage}
+	}
+	const (
+		deleteItem   = `mutation { deleteItem(item: {id: "1"}) }`
+		deleteCourse = `mutation { deleteCourse(course: {id: "1"}) }`
+	)
+
+	tests := []struct {
+		name   string
+		schema *ast.Schema
+		query  string
+		// session is nil for an anonymous request.
+		session *session
+		// want is the start of the error, empty when the operation may go on.
+		want string
+		code string
+	}{
+		{"read query", schema, "{ items { id } }", key("inventory:read"), "", ""},
+		{"write query", schema, "{ items { id } }", key("inventory:write"), "", ""},
+		{"mutation with read", schema, deleteItem, key("inventory:read"), "deleteItem needs the inventory:write scope", errForbidden},
+		{"mutation with write", schema, deleteItem, key("inventory:write"), "", ""},
+		{"subscription with read", schema, "subscription { liveCourses { id } }", key("education:read"), "", ""},
+		{"other domain", schema, "{ items { id } }", key("education:write"), "items needs the inventory:read scope", errForbidden},
+		{"one field outside", schema, "{ items { id } courses { id } }", key("inventory:read"), "courses needs the education:read scope", errForbidden},
+		{"fragment", schema, "{ ...f } fragment f on Query { courses { id } }", key("inventory:read"), "courses needs the education:read scope", errForbidden},
+		{"inline fragment", schema, "{ ... on Query { courses { id } } }", key("inventory:read"), "courses needs the education:read scope", errForbidden},
+		{"nested field of another domain", schema, "{ accounts { id class { id } } }", key("account:read"), "", ""},
+		{"typename", schema, "{ __typename }", key("inventory:read"), "", ""},
+		{"unknown domain", payroll, "{ salaries }", key("account:write", "education:write", "inventory:write", "lend:write"), "salaries needs the payroll:read scope", errForbidden},
+		{"person", schema, deleteItem, person(stageFull), "", ""},
+		{"anonymous deleteItem", schema, deleteItem, nil, "deleteItem needs you to log in first", errUnauthenticated},
+		{"anonymous deleteCourse", schema, deleteCourse, nil, "deleteCourse needs you to log in first", errUnauthenticated},
+		{"anonymous query", schema, "{ items { id } }", nil, "items needs you to log in first", errUnauthenticated},
+		{"anonymous subscription", schema, "subscription { liveCourses { id } }", nil, "liveCourses needs you to log in first", errUnauthenticated},
+		{"anonymous login", schema, `mutation { login(credentials: {email: "a@b.c", password: "p"}) { token } }`, nil, "", ""},
+		{"anonymous reset", schema, `mutation { requestPasswordReset(request: {email: "a@b.c"}) }`, nil, "", ""},
+		{"anonymous me", schema, "{ me { id } __typename }", nil, "", ""},
+		{"anonymous login and more", schema, `mutation { login(credentials: {email: "a@b.c", password: "p"}) { token } deleteItem(item: {id: "1"}) }`, nil, "deleteItem needs you to log in first", errUnauthenticated},
+		{"totp step", schema, `mutation { verifyTotp(code: {code: "123456"}) { token } }`, person(stageTOTP), "", ""},
+		{"enrol step", schema, "mutation { beginTotp { secret } }", person(stageEnrol), "", ""},
+		// An admin whose password checked out but who hasn't passed the
+		// second step, or set it up as the role requires.
+		{"totp step deleteCourse", schema, deleteCourse, person(stageTOTP), "deleteCourse needs you to log in first", errUnauthenticated},
+		{"enrol step deleteCourse", schema, deleteCourse, person(stageEnrol), "deleteCourse needs you to log in first", errUnauthenticated},
+		{"enrol step verifyTotp", schema, `mutation { verifyTotp(code: {code: "123456"}) { token } }`, person(stageEnrol), "verifyTotp needs you to log in first", errUnauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, errs := gqlparser.LoadQuery(tt.schema, tt.query)
+			if len(errs) > 0 {
+				t.Fatalf("query doesn't validate: %v", errs)
+			}
+			ctx := context.Background()
+			if tt.session != nil {
+				ctx = context.WithValue(ctx, sessionKey{}, tt.session)
+			}
+			err := scopeCheck{}.MutateOperationContext(ctx, &graphql.OperationContext{Doc: doc})
+			switch {
+			case tt.want == "" && err != nil:
+				t.Errorf("got %v, want nil", err)
+			case tt.want != "" && (err == nil || !strings.HasPrefix(err.Message, tt.want)):
+				t.Errorf("got %v, want %q", err, tt.want)
+			case err != nil && err.Extensions["code"] != tt.code:
+				t.Errorf("code = %v, want %s", err.Extensions["code"], tt.code)
+			}
+		})
+	}
+}
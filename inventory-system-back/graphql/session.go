@@ -0,0 +1,285 @@
+package main
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/jochem11/inventory-system-back/account"
+	"github.com/jochem11/inventory-system-back/graphql/generated"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Sessions are kept by nobody: a token names the account, when it expires,
+// its stage and the session version of the account, signed with
+// SESSION_SECRET. The account is looked up again on every request, so
+// suspending it or changing its password ends its sessions too.
+//
+// A login that still needs a second step gets a short-lived session in the
+// totp or enrol stage, which is only good for finishing that step.
+//
+// Service accounts send an API key instead, which the account service
+// checks on every request. Their sessions are limited to the key's scopes,
+// see scopeCheck. Anonymous GraphQL requests may only log in, see
+// openFields.
+
+const (
+	errUnauthenticated = "UNAUTHENTICATED"
+	errForbidden       = "FORBIDDEN"
+)
+
+const (
+	stageFull  = "full"
+	stageTOTP  = "totp"
+	stageEnrol = "enrol"
+)
+
+const pendingSessionTTL = 5 * time.Minute
+
+// sessionStages maps the second step Authenticate asks for to the stage of
+// the session a login gets.
+var sessionStages = map[string]string{
+	account.SecondFactorNone:  stageFull,
+	account.SecondFactorTOTP:  stageTOTP,
+	account.SecondFactorEnrol: stageEnrol,
+}
+
+var loginSteps = map[string]generated.LoginStep{
+	stageFull:  generated.LoginStepDone,
+	stageTOTP:  generated.LoginStepTotp,
+	stageEnrol: generated.LoginStepEnrolTotp,
+}
+
+var errLoginsOff = errors.New("logins are switched off")
+
+type sessionKey struct{}
+
+type session struct {
+	account *account.Account
+	stage   string
+	// scopes is nil for people, who aren't limited by scopes.
+	scopes []string
+	// keyID is the API key the session was made with, if any.
+	keyID string
+}
+
+// viewer is the account the request was made with, nil for anonymous ones
+// and logins that aren't finished.
+func viewer(ctx context.Context) *account.Account {
+	return sessionAccount(ctx, stageFull)
+}
+
+// sessionAccount is the account of a session in one of stages.
+func sessionAccount(ctx context.Context, stages ...string) *account.Account {
+	s, _ := ctx.Value(sessionKey{}).(*session)
+	if s == nil || !slices.Contains(stages, s.stage) {
+		return nil
+	}
+	return s.account
+}
+
+// requireRole returns the viewer when it has one of roles.
+func requireRole(ctx context.Context, roles ...string) (*account.Account, error) {
+	a := viewer(ctx)
+	if a == nil {
+		return nil, codedError("log in first", errUnauthenticated)
+	}
+	for _, role := range roles {
+		if a.Role == role {
+			return a, nil
+		}
+	}
+	return nil, codedError("not allowed for "+strings.ToLower(a.Role)+" accounts", errForbidden)
+}
+
+func requireAdmin(ctx context.Context) (*account.Account, error) {
+	return requireRole(ctx, account.RoleAdmin)
+}
+
+// requireStaff lets teachers and admins through.
+func requireStaff(ctx context.Context) (*account.Account, error) {
+	return requireRole(ctx, account.RoleTeacher, account.RoleAdmin)
+}
+
+// requireStaffOrKey lets teachers, admins and API keys through. scopeCheck
+// already held the operation of a key to its scopes.
+func requireStaffOrKey(ctx context.Context) (*account.Account, error) {
+	if s, _ := ctx.Value(sessionKey{}).(*session); s != nil && s.scopes != nil {
+		return s.account, nil
+	}
+	return requireStaff(ctx)
+}
+
+// allowScoped guards the endpoints outside GraphQL. API keys need access to
+// domain, as scopeCheck asks of them in GraphQL, people need one of roles.
+// Anyone else is answered with a 401 or 403.
+func allowScoped(w http.ResponseWriter, r *http.Request, domain, access string, roles ...string) bool {
+	if s, _ := r.Context().Value(sessionKey{}).(*session); s != nil && s.scopes != nil {
+		if account.HasScope(s.scopes, domain, access) {
+			return true
+		}
+		http.Error(w, "needs the "+domain+":"+access+" scope", http.StatusForbidden)
+		return false
+	}
+
+	_, err := requireRole(r.Context(), roles...)
+	if err == nil {
+		return true
+	}
+	code := http.StatusForbidden
+	if viewer(r.Context()) == nil {
+		code = http.StatusUnauthorized
+	}
+	http.Error(w, err.Error(), code)
+	return false
+}
+
+func codedError(msg, code string) error {
+	err := gqlerror.Errorf("%s", msg)
+	errcode.Set(err, code)
+	return err
+}
+
+// newSession logs a in up to stage.
+func (s *Server) newSession(a *account.Account, stage string) *generated.Session {
+	expiresAt := time.Now().Add(s.sessionTTL)
+	if stage != stageFull {
+		expiresAt = time.Now().Add(pendingSessionTTL)
+	}
+	return &generated.Session{
+		Token:     s.sessionToken(&sessionClaims{a.ID, stage, a.SessionVersion}, expiresAt),
+		ExpiresAt: expiresAt,
+		Step:      loginSteps[stage],
+		Account:   toGraphQLAccount(a),
+	}
+}
+
+// sessionClaims are what a session token vouches for.
+type sessionClaims struct {
+	accountID string
+	stage     string
+	version   int
+}
+
+func (s *Server) sessionToken(c *sessionClaims, expiresAt time.Time) string {
+	payload := c.accountID + "." + strconv.FormatInt(expiresAt.Unix(), 10) + "." + c.stage + "." + strconv.Itoa(c.version)
+	return payload + "." + s.sessionSignature(payload)
+}
+
+func (s *Server) sessionSignature(payload string) string {
+	mac := hmac.New(sha256.New, s.sessionSecret)
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// parseSessionToken returns the claims of a token that is signed and not
+// expired at now.
+func (s *Server) parseSessionToken(token string, now time.Time) (*sessionClaims, bool) {
+	if len(s.sessionSecret) == 0 {
+		return nil, false
+	}
+	i := strings.LastIndexByte(token, '.')
+	if i < 0 {
+		return nil, false
+	}
+	payload, sig := token[:i], token[i+1:]
+	if !hmac.Equal([]byte(sig), []byte(s.sessionSignature(payload))) {
+		return nil, false
+	}
+	parts := strings.Split(payload, ".")
+	if len(parts) != 4 {
+		return nil, false
+	}
+	unix, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || !now.Before(time.Unix(unix, 0)) {
+		return nil, false
+	}
+	version, err := strconv.Atoi(parts[3])
+	if err != nil {
+		return nil, false
+	}
+	return &sessionClaims{parts[0], parts[2], version}, true
+}
+
+// credentialError is a token or API key that doesn't check out.
+type credentialError string
+
+func (e credentialError) Error() string {
+	return string(e)
+}
+
+// authenticate puts the account of a Bearer session token or API key in the
+// request context. Requests without one go through anonymously, a token
+// that doesn't check out is turned away.
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sess, err := s.lookupSession(r.Context(), r.Header.Get("Authorization"))
+		if errors.As(err, new(credentialError)) {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		if err != nil {
+			logError(r.Context(), err)
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		if sess != nil {
+			r = r.WithContext(context.WithValue(r.Context(), sessionKey{}, sess))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// lookupSession returns the session of an Authorization value, nil for an
+// empty one. It fails with a credentialError for tokens and keys that don't
+// check out.
+func (s *Server) lookupSession(ctx context.Context, authorization string) (*session, error) {
+	if authorization == "" {
+		return nil, nil
+	}
+	token, ok := strings.CutPrefix(authorization, "Bearer ")
+	if !ok {
+		return nil, credentialError("expected a Bearer token")
+	}
+	if strings.HasPrefix(token, account.APIKeyPrefix) {
+		return s.lookupAPIKey(ctx, token)
+	}
+	claims, ok := s.parseSessionToken(token, time.Now())
+	if !ok {
+		return nil, credentialError("invalid or expired session")
+	}
+
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+	a, err := s.accountClient.GetAccount(ctx, claims.accountID)
+	if isNotFound(err) || (err == nil && (a.State != account.StateActive || a.SessionVersion != claims.version)) {
+		return nil, credentialError("invalid or expired session")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &session{account: a, stage: claims.stage}, nil
+}
+
+func (s *Server) lookupAPIKey(ctx context.Context, key string) (*session, error) {
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+	a, k, err := s.accountClient.AuthenticateAPIKey(ctx, key)
+	if status.Code(err) == codes.Unauthenticated {
+		return nil, credentialError("invalid or revoked API key")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &session{account: a, stage: stageFull, scopes: k.Scopes, keyID: k.ID}, nil
+}
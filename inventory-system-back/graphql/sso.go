@@ -0,0 +1,207 @@
+package main
+
+import (
+	"crypto/hmac"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jochem11/inventory-system-back/account"
+	"github.com/jochem11/inventory-system-back/internal/oidc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Single sign-on runs through the browser: /auth/oidc/login sends it to the
+// provider, which sends it back to /auth/oidc/callback. The state, nonce and
+// PKCE verifier wait in a signed cookie in between. The login ends on the
+// front end's OIDC_DONE_URL with the session, or an error, in the fragment,
+// which never reaches a server.
+
+const (
+	ssoCookie = "oidc_login"
+	ssoTTL    = 10 * time.Minute
+)
+
+// roleRanks orders the roles, a login whose claims map to several gets the
+// highest.
+var roleRanks = []string{account.RoleStudent, account.RoleTeacher, account.RoleAdmin}
+
+type ssoLogin struct {
+	oidc.Login
+	Expires int64 `json:"expires"`
+}
+
+func (s *Server) ssoLoginHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		l, err := oidc.NewLogin()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		ctx, cancel := s.withTimeout(r.Context())
+		defer cancel()
+		to, err := s.oidc.AuthURL(ctx, l)
+		if err != nil {
+			logError(ctx, err)
+			http.Error(w, "the identity provider can't be reached", http.StatusBadGateway)
+			return
+		}
+
+		value, err := s.ssoCookieValue(&ssoLogin{*l, time.Now().Add(ssoTTL).Unix()})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		http.SetCookie(w, &http.Cookie{
+			Name:     ssoCookie,
+			Value:    value,
+			Path:     "/auth/oidc/",
+			MaxAge:   int(ssoTTL.Seconds()),
+			HttpOnly: true,
+			Secure:   strings.HasPrefix(s.oidcCallback, "https://"),
+			// Lax still sends it along on the provider's redirect back.
+			SameSite: http.SameSiteLaxMode,
+		})
+		http.Redirect(w, r, to, http.StatusFound)
+	})
+}
+
+func (s *Server) ssoCallbackHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		// The cookie is only good for one try.
+		http.SetCookie(w, &http.Cookie{Name: ssoCookie, Path: "/auth/oidc/", MaxAge: -1})
+
+		q := r.URL.Query()
+		if e := q.Get("error"); e != "" {
+			s.ssoDone(w, r, url.Values{"error": {"the identity provider refused the login: " + e}})
+			return
+		}
+		l, ok := s.ssoCookieLogin(r, time.Now())
+		if !ok || !hmac.Equal([]byte(q.Get("state")), []byte(l.State)) {
+			s.ssoDone(w, r, url.Values{"error": {"the login expired, try again"}})
+			return
+		}
+
+		ctx, cancel := s.withTimeout(r.Context())
+		defer cancel()
+		claims, err := s.oidc.Exchange(ctx, q.Get("code"), &l.Login)
+		if err != nil {
+			logError(ctx, err)
+			s.ssoDone(w, r, url.Values{"error": {"the identity provider's answer didn't check out"}})
+			return
+		}
+		email := claims.String("email")
+		if verified, _ := claims["email_verified"].(bool); email == "" || !verified {
+			s.ssoDone(w, r, url.Values{"error": {"the identity provider has no verified email for you"}})
+			return
+		}
+
+		first, last := claims.String("given_name"), claims.String("family_name")
+		if first == "" && last == "" {
+			name := strings.TrimSpace(claims.String("name"))
+			if i := strings.LastIndexByte(name, ' '); i > 0 {
+				first, last = name[:i], name[i+1:]
+			}
+		}
+		a, secondFactor, err := s.accountClient.AuthenticateExternal(ctx, email, first, last, s.ssoRole(claims), s.ssoMayLink(email, claims))
+		if status.Code(err) == codes.Unauthenticated {
+			s.ssoDone(w, r, url.Values{"error": {"your account can't log in"}})
+			return
+		}
+		if status.Code(err) == codes.FailedPrecondition {
+			s.ssoDone(w, r, url.Values{"error": {"your account isn't linked to single sign-on, log in with your password"}})
+			return
+		}
+		if status.Code(err) == codes.InvalidArgument {
+			// Most likely the provider left out the names or the email.
+			s.ssoDone(w, r, url.Values{"error": {status.Convert(err).Message()}})
+			return
+		}
+		if err != nil {
+			logError(ctx, err)
+			s.ssoDone(w, r, url.Values{"error": {"logging in failed, try again later"}})
+			return
+		}
+
+		session := s.newSession(a, sessionStages[secondFactor])
+		s.ssoDone(w, r, url.Values{
+			"token":     {session.Token},
+			"expiresAt": {session.ExpiresAt.Format(time.RFC3339)},
+			"step":      {string(session.Step)},
+		})
+	})
+}
+
+// ssoRole maps the role claim of a login to the highest role configured for
+// its values. Logins without one become students.
+func (s *Server) ssoRole(claims oidc.Claims) string {
+	role := account.RoleStudent
+	for _, v := range claims.Strings(s.oidcRoleClaim) {
+		if mapped, ok := s.oidcRoles[v]; ok && slices.Index(roleRanks, mapped) > slices.Index(roleRanks, role) {
+			role = mapped
+		}
+	}
+	return role
+}
+
+// ssoMayLink reports whether a login may take over an existing account with
+// a password or a staff role: its email has to be in one of OIDC_LINK_DOMAINS,
+// and the hosted domain the provider names, if any, has to be the same.
+func (s *Server) ssoMayLink(email string, claims oidc.Claims) bool {
+	i := strings.LastIndexByte(email, '@')
+	if i < 0 {
+		return false
+	}
+	domain := strings.ToLower(email[i+1:])
+	if !slices.ContainsFunc(s.oidcLinkDomains, func(d string) bool { return strings.EqualFold(d, domain) }) {
+		return false
+	}
+	hd := claims.String("hd")
+	return hd == "" || strings.EqualFold(hd, domain)
+}
+
+func (s *Server) ssoDone(w http.ResponseWriter, r *http.Request, fragment url.Values) {
+	http.Redirect(w, r, s.oidcDoneURL+"#"+fragment.Encode(), http.StatusFound)
+}
+
+func (s *Server) ssoCookieValue(l *ssoLogin) (string, error) {
+	b, err := json.Marshal(l)
+	if err != nil {
+		return "", err
+	}
+	payload := base64.RawURLEncoding.EncodeToString(b)
+	return payload + "." + s.sessionSignature(ssoCookie+":"+payload), nil
+}
+
+func (s *Server) ssoCookieLogin(r *http.Request, now time.Time) (*ssoLogin, bool) {
+	c, err := r.Cookie(ssoCookie)
+	if err != nil {
+		return nil, false
+	}
+	payload, sig, ok := strings.Cut(c.Value, ".")
+	if !ok || !hmac.Equal([]byte(sig), []byte(s.sessionSignature(ssoCookie+":"+payload))) {
+		return nil, false
+	}
+	b, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, false
+	}
+	var l ssoLogin
+	if err := json.Unmarshal(b, &l); err != nil || !now.Before(time.Unix(l.Expires, 0)) {
+		return nil, false
+	}
+	return &l, true
+}
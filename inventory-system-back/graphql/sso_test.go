@@ -0,0 +1,164 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jochem11/inventory-system-back/internal/oidc"
+	"github.com/jochem11/inventory-system-back/internal/oidc/oidctest"
+)
+
+const (
+	testClientID = "gateway"
+	testDoneURL  = "http://front.test/login/done"
+)
+
+func TestSSOCallbackRejects(t *testing.T) {
+	verified := map[string]any{"email": "jan@school.test", "email_verified": true, "name": "Jan Jansen"}
+	tests := []struct {
+		name   string
+		claims map[string]any
+		// state, nonce and audience replace the right ones when set, age
+		// moves the time the ID token is issued at back.
+		state    string
+		nonce    string
+		audience string
+		age      time.Duration
+		want     string
+	}{
+		{name: "state mismatch", claims: verified, state: "someone-elses-state", want: "the login expired, try again"},
+		{name: "wrong nonce", claims: verified, nonce: "someone-elses-nonce", want: "the identity provider's answer didn't check out"},
+		{name: "wrong audience", claims: verified, audience: "another-client", want: "the identity provider's answer didn't check out"},
+		{name: "expired", claims: verified, age: time.Hour, want: "the identity provider's answer didn't check out"},
+		{
+			name:   "missing email_verified",
+			claims: map[string]any{"email": "jan@school.test", "name": "Jan Jansen"},
+			want:   "the identity provider has no verified email for you",
+		},
+		{
+			name:   "unverified email",
+			claims: map[string]any{"email": "jan@school.test", "email_verified": false, "name": "Jan Jansen"},
+			want:   "the identity provider has no verified email for you",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := oidc.NewLogin()
+			if err != nil {
+				t.Fatal(err)
+			}
+			nonce := l.Nonce
+			if tt.nonce != "" {
+				nonce = tt.nonce
+			}
+
+			// The provider's token endpoint is replaced so the ID token can be
+			// made wrong on purpose, the rest is oidctest's.
+			var p *oidctest.Provider
+			idp := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/token" {
+					p.ServeHTTP(w, r)
+					return
+				}
+				if tt.audience != "" {
+					p.ClientID = tt.audience
+				}
+				token, err := p.IDToken(nonce, time.Now().Add(-tt.age))
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(map[string]any{"access_token": "access", "token_type": "Bearer", "id_token": token})
+			}))
+			defer idp.Close()
+			if p, err = oidctest.NewProvider(idp.URL, testClientID, tt.claims); err != nil {
+				t.Fatal(err)
+			}
+
+			s := &Server{
+				rpcTimeout:    5 * time.Second,
+				sessionSecret: []byte("test secret"),
+				oidc: oidc.NewProvider(oidc.Config{
+					Issuer:      idp.URL,
+					ClientID:    testClientID,
+					RedirectURL: "http://gateway.test/auth/oidc/callback",
+				}, idp.Client()),
+				oidcRoleClaim: "roles",
+				oidcDoneURL:   testDoneURL,
+			}
+			cookie, err := s.ssoCookieValue(&ssoLogin{*l, time.Now().Add(ssoTTL).Unix()})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			state := l.State
+			if tt.state != "" {
+				state = tt.state
+			}
+			r := httptest.NewRequest(http.MethodGet, "/auth/oidc/callback?"+url.Values{"code": {"code"}, "state": {state}}.Encode(), nil)
+			r.AddCookie(&http.Cookie{Name: ssoCookie, Value: cookie})
+			w := httptest.NewRecorder()
+			s.ssoCallbackHandler().ServeHTTP(w, r)
+
+			fragment := ssoFragment(t, w)
+			if fragment.Has("token") {
+				t.Fatalf("got a session, want error %q", tt.want)
+			}
+			if got := fragment.Get("error"); got != tt.want {
+				t.Errorf("error = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSSOMayLink(t *testing.T) {
+	s := &Server{oidcLinkDomains: []string{"School.test"}}
+	tests := []struct {
+		name   string
+		email  string
+		claims oidc.Claims
+		want   bool
+	}{
+		{"configured domain", "jan@school.test", oidc.Claims{}, true},
+		{"matching hd", "jan@SCHOOL.test", oidc.Claims{"hd": "school.test"}, true},
+		{"other hd", "jan@school.test", oidc.Claims{"hd": "elsewhere.test"}, false},
+		{"other domain", "jan@elsewhere.test", oidc.Claims{}, false},
+		{"subdomain", "jan@mail.school.test", oidc.Claims{}, false},
+		{"no domain", "jan", oidc.Claims{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.ssoMayLink(tt.email, tt.claims); got != tt.want {
+				t.Errorf("ssoMayLink(%q, %v) = %v, want %v", tt.email, tt.claims, got, tt.want)
+			}
+		})
+	}
+
+	if (&Server{}).ssoMayLink("jan@school.test", oidc.Claims{}) {
+		t.Error("ssoMayLink without OIDC_LINK_DOMAINS = true, want false")
+	}
+}
+
+// ssoFragment returns the fragment the callback redirected to the front end
+// with.
+func ssoFragment(t *testing.T, w *httptest.ResponseRecorder) url.Values {
+	t.Helper()
+	if w.Code != http.StatusFound {
+		t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusFound, w.Body)
+	}
+	done, f, _ := strings.Cut(w.Header().Get("Location"), "#")
+	if done != testDoneURL {
+		t.Fatalf("redirected to %s, want %s", done, testDoneURL)
+	}
+	fragment, err := url.ParseQuery(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fragment
+}
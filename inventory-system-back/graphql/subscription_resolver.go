@@ -10,7 +10,10 @@ type subscriptionResolver struct {
 }
 
 func (r *subscriptionResolver) LiveCourses(ctx context.Context, pagination *generated.PaginationInput) (<-chan []*generated.Course, error) {
-	skip, take := getPaginationBounds(pagination)
+	skip, take, err := r.server.pagination(pagination)
+	if err != nil {
+		return nil, err
+	}
 
 	coursesChan, err := r.server.educationClient.LiveCourses(ctx, skip, take)
 	if err != nil {
@@ -32,12 +35,7 @@ func (r *subscriptionResolver) LiveCourses(ctx context.Context, pagination *gene
 
 				gqlCourses := make([]*generated.Course, 0, len(courses))
 				for _, c := range courses {
-					gqlCourses = append(gqlCourses, &generated.Course{
-						ID:        c.ID,
-						Name:      c.Name,
-						CreatedAt: c.CreatedAt,
-						UpdatedAt: c.UpdatedAt,
-					})
+					gqlCourses = append(gqlCourses, toGraphQLCourse(c))
 				}
 
 				select {
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+type tableFormat string
+
+const (
+	tableCSV  tableFormat = "csv"
+	tableXLSX tableFormat = "xlsx"
+
+	xlsxContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+)
+
+var errUnknownTableFormat = errors.New("unknown format, use csv or xlsx")
+
+func parseTableFormat(s string) (tableFormat, error) {
+	switch tableFormat(strings.ToLower(s)) {
+	case "", tableCSV:
+		return tableCSV, nil
+	case tableXLSX:
+		return tableXLSX, nil
+	}
+	return "", errUnknownTableFormat
+}
+
+func (f tableFormat) contentType() string {
+	if f == tableXLSX {
+		return xlsxContentType
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// readTable returns the rows of a CSV file or of the first sheet of an XLSX
+// workbook. The first row is the header.
+func readTable(r io.Reader, f tableFormat) ([][]string, error) {
+	if f == tableXLSX {
+		wb, err := excelize.OpenReader(r)
+		if err != nil {
+			return nil, err
+		}
+		defer wb.Close()
+
+		sheets := wb.GetSheetList()
+		if len(sheets) == 0 {
+			return nil, nil
+		}
+		return wb.GetRows(sheets[0])
+	}
+
+	br := bufio.NewReader(r)
+	// Excel writes a byte order mark in front of UTF-8 CSV files.
+	if bom, _ := br.Peek(3); bytes.Equal(bom, []byte("\xef\xbb\xbf")) {
+		br.Discard(3)
+	}
+
+	cr := csv.NewReader(br)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+	// Spreadsheets with a comma as decimal separator export semicolons.
+	if line, _ := br.Peek(br.Buffered()); len(line) > 0 {
+		header, _, _ := strings.Cut(string(line), "\n")
+		if strings.Contains(header, ";") && !strings.Contains(header, ",") {
+			cr.Comma = ';'
+		}
+	}
+	return cr.ReadAll()
+}
+
+func writeTable(w io.Writer, f tableFormat, sheet string, rows [][]string) error {
+	if f == tableXLSX {
+		wb := excelize.NewFile()
+		defer wb.Close()
+
+		if err := wb.SetSheetName(wb.GetSheetName(0), sheet); err != nil {
+			return err
+		}
+		for i, row := range rows {
+			cell, err := excelize.CoordinatesToCellName(1, i+1)
+			if err != nil {
+				return err
+			}
+			values := make([]interface{}, len(row))
+			for j, v := range row {
+				values[j] = v
+			}
+			if err := wb.SetSheetRow(sheet, cell, &values); err != nil {
+				return err
+			}
+		}
+		return wb.Write(w)
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.WriteAll(rows); err != nil {
+		return err
+	}
+	return cw.Error()
+}
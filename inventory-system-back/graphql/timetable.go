@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jochem11/inventory-system-back/graphql/generated"
+	"github.com/jochem11/inventory-system-back/inventory"
+)
+
+var (
+	errClock    = errors.New("times must be HH:MM, from 00:00 through 24:00")
+	errNotARoom = errors.New("roomId must be a ROOM location")
+)
+
+var weekdays = map[generated.Weekday]time.Weekday{
+	generated.WeekdayMonday:    time.Monday,
+	generated.WeekdayTuesday:   time.Tuesday,
+	generated.WeekdayWednesday: time.Wednesday,
+	generated.WeekdayThursday:  time.Thursday,
+	generated.WeekdayFriday:    time.Friday,
+	generated.WeekdaySaturday:  time.Saturday,
+	generated.WeekdaySunday:    time.Sunday,
+}
+
+func toGraphQLWeekday(d time.Weekday) generated.Weekday {
+	for w, day := range weekdays {
+		if day == d {
+			return w
+		}
+	}
+	return ""
+}
+
+// parseClock turns HH:MM into minutes after midnight.
+func parseClock(s string) (int, error) {
+	h, m, ok := strings.Cut(s, ":")
+	hours, errH := strconv.Atoi(h)
+	minutes, errM := strconv.Atoi(m)
+	if !ok || len(h) != 2 || len(m) != 2 || errH != nil || errM != nil ||
+		hours < 0 || minutes < 0 || minutes > 59 || hours*60+minutes > 24*60 {
+		return 0, errClock
+	}
+	return hours*60 + minutes, nil
+}
+
+func optionalClock(s *string) (*int, error) {
+	if s == nil {
+		return nil, nil
+	}
+	m, err := parseClock(*s)
+	if err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+func formatClock(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+// checkRoom makes sure a lesson slot is put in a room, not a building or a
+// cabinet.
+func (s *Server) checkRoom(ctx context.Context, roomID string) error {
+	l, err := s.inventoryClient.GetLocation(ctx, roomID)
+	if err != nil {
+		return err
+	}
+	if l.Kind != inventory.LocationKindRoom {
+		return errNotARoom
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/99designs/gqlgen/graphql"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("github.com/jochem11/inventory-system-back/graphql")
+
+// otelTracer opens a span per operation, below the HTTP request span, and one
+// per resolver, so the gRPC and SQL spans of a slow field hang under it. Like
+// metricsTracer it skips fields that are only read off a struct.
+type otelTracer struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+	graphql.FieldInterceptor
+} = otelTracer{}
+
+func (otelTracer) ExtensionName() string {
+	return "Tracing"
+}
+
+func (otelTracer) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (otelTracer) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	opType, name := operationLabels(ctx)
+	ctx, span := tracer.Start(ctx, fmt.Sprintf("%s %s", opType, name),
+		trace.WithAttributes(
+			attribute.String("graphql.operation.type", opType),
+			attribute.String("graphql.operation.name", name),
+		),
+	)
+	defer span.End()
+
+	res := next(ctx)
+	if res != nil && len(res.Errors) > 0 {
+		span.SetStatus(codes.Error, res.Errors.Error())
+	}
+	return res
+}
+
+func (otelTracer) InterceptField(ctx context.Context, next graphql.Resolver) (any, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || !fc.IsResolver {
+		return next(ctx)
+	}
+
+	ctx, span := tracer.Start(ctx, fc.Object+"."+fc.Field.Name,
+		trace.WithAttributes(attribute.String("graphql.field.path", fc.Path().String())),
+	)
+	defer span.End()
+
+	res, err := next(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return res, err
+}
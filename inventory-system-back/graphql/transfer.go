@@ -0,0 +1,911 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/jochem11/inventory-system-back/account"
+	"github.com/jochem11/inventory-system-back/inventory"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxImportBytes = 10 << 20
+
+type rowAction string
+
+const (
+	rowCreate    rowAction = "create"
+	rowUpdate    rowAction = "update"
+	rowUnchanged rowAction = "unchanged"
+)
+
+// record is a single imported row keyed by column name. Columns that are not
+// in the file are absent, so an import never clears fields it was not given.
+type record map[string]string
+
+func (r record) has(column string) bool {
+	_, ok := r[column]
+	return ok
+}
+
+// rowPlan is what an import would do with a row. apply runs after every row
+// has been validated and is skipped on a dry run.
+type rowPlan struct {
+	action rowAction
+	apply  func(ctx context.Context) error
+}
+
+// transferEntity describes how one entity is imported and exported. The first
+// column is the natural key that makes re-importing the same file a no-op.
+type transferEntity struct {
+	columns  []string
+	required []string
+	// domain is the scope API keys need, read to export and write to import.
+	domain string
+	// admin limits the entity to admins, other entities are open to staff.
+	admin  bool
+	plan   func(ctx context.Context, s *Server, row record, seen map[string]bool) (*rowPlan, error)
+	export func(ctx context.Context, s *Server) ([][]string, error)
+}
+
+var transferEntities = map[string]*transferEntity{
+	"courses": {
+		columns:  []string{"name"},
+		required: []string{"name"},
+		domain:   "education",
+		plan:     planCourse,
+		export:   exportCourses,
+	},
+	"classes": {
+		columns:  []string{"name", "course"},
+		required: []string{"name", "course"},
+		domain:   "education",
+		plan:     planClass,
+		export:   exportClasses,
+	},
+	"locations": {
+		columns:  []string{"code", "name", "kind", "parent", "class"},
+		required: []string{"code", "name", "kind"},
+		domain:   "inventory",
+		plan:     planLocation,
+		export:   exportLocations,
+	},
+	"items": {
+		columns:  []string{"asset_tag", "name", "description", "location", "item_type"},
+		required: []string{"asset_tag"},
+		domain:   "inventory",
+		plan:     planItem,
+		export:   exportItems,
+	},
+	"accounts": {
+		columns:  []string{"email", "first_name", "insertion", "last_name", "role", "class", "card_number", "language"},
+		required: []string{"email"},
+		domain:   "account",
+		admin:    true,
+		plan:     planAccount,
+		export:   exportAccounts,
+	},
+}
+
+// roles are the roles of the people who may transfer the entity.
+func (e *transferEntity) roles() []string {
+	if e.admin {
+		return []string{account.RoleAdmin}
+	}
+	return []string{account.RoleTeacher, account.RoleAdmin}
+}
+
+type importRow struct {
+	Row    int       `json:"row"`
+	Key    string    `json:"key"`
+	Action rowAction `json:"action,omitempty"`
+	Errors []string  `json:"errors,omitempty"`
+}
+
+type importReport struct {
+	Entity    string       `json:"entity"`
+	DryRun    bool         `json:"dryRun"`
+	Applied   bool         `json:"applied"`
+	Created   int          `json:"created"`
+	Updated   int          `json:"updated"`
+	Unchanged int          `json:"unchanged"`
+	Failed    int          `json:"failed"`
+	Rows      []*importRow `json:"rows"`
+}
+
+// importHandler serves POST /import/{entity}. The body is the file itself or a
+// multipart form with a "file" field. Query parameters:
+//
+//	format=csv|xlsx      defaults to the upload's extension or content type
+//	dryRun=true          validate and report without writing anything
+//	map.<column>=<name>  read a column from a differently named header
+//
+// Nothing is written when any row fails validation. Writes are upserts on the
+// natural key, so a failed import can be fixed and simply run again.
+func (s *Server) importHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		name := strings.TrimPrefix(r.URL.Path, "/import/")
+		entity, ok := transferEntities[name]
+		if !ok {
+			http.Error(w, fmt.Sprintf("cannot import %q", name), http.StatusNotFound)
+			return
+		}
+		if !allowScoped(w, r, entity.domain, account.ScopeWrite, entity.roles()...) {
+			return
+		}
+
+		q := r.URL.Query()
+		dryRun, _ := strconv.ParseBool(q.Get("dryRun"))
+
+		r.Body = http.MaxBytesReader(w, r.Body, maxImportBytes)
+		body, filename, err := uploadedFile(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		defer body.Close()
+
+		format, err := parseTableFormat(uploadFormat(q.Get("format"), filename, r.Header.Get("Content-Type")))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		rows, err := readTable(body, format)
+		if err != nil {
+			http.Error(w, "reading "+string(format)+": "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		if len(rows) == 0 {
+			http.Error(w, "file has no header row", http.StatusBadRequest)
+			return
+		}
+
+		mapping, err := columnMapping(entity, rows[0], q)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		report := &importReport{Entity: name, DryRun: dryRun, Rows: []*importRow{}}
+		plans := []*rowPlan{}
+		planned := []*importRow{}
+		seen := map[string]bool{}
+
+		for i, cells := range rows[1:] {
+			rec := record{}
+			for column, idx := range mapping {
+				v := ""
+				if idx < len(cells) {
+					v = strings.TrimSpace(cells[idx])
+				}
+				rec[column] = v
+			}
+			if isBlank(rec) {
+				continue
+			}
+
+			key := rec[entity.columns[0]]
+			row := &importRow{Row: i + 2, Key: key}
+			report.Rows = append(report.Rows, row)
+
+			for _, column := range entity.required {
+				if rec[column] == "" {
+					row.Errors = append(row.Errors, column+" is required")
+				}
+			}
+			if key != "" && seen[key] {
+				row.Errors = append(row.Errors, fmt.Sprintf("%s %q appears more than once", entity.columns[0], key))
+			}
+			if len(row.Errors) > 0 {
+				report.Failed++
+				continue
+			}
+
+			ctx, cancel := s.withTimeout(r.Context())
+			plan, err := entity.plan(ctx, s, rec, seen)
+			cancel()
+			if err != nil {
+				row.Errors = append(row.Errors, err.Error())
+				report.Failed++
+				continue
+			}
+
+			seen[key] = true
+			row.Action = plan.action
+			plans = append(plans, plan)
+			planned = append(planned, row)
+			switch plan.action {
+			case rowCreate:
+				report.Created++
+			case rowUpdate:
+				report.Updated++
+			default:
+				report.Unchanged++
+			}
+		}
+
+		if dryRun || report.Failed > 0 {
+			code := http.StatusOK
+			if !dryRun {
+				code = http.StatusUnprocessableEntity
+			}
+			writeJSON(w, code, report)
+			return
+		}
+
+		for i, plan := range plans {
+			if plan.apply == nil {
+				continue
+			}
+			ctx, cancel := s.withTimeout(r.Context())
+			err := plan.apply(ctx)
+			cancel()
+			if err != nil {
+				logError(ctx, err)
+				planned[i].Errors = append(planned[i].Errors, err.Error())
+				report.Failed++
+				writeJSON(w, http.StatusInternalServerError, report)
+				return
+			}
+		}
+
+		report.Applied = true
+		writeJSON(w, http.StatusOK, report)
+	})
+}
+
+// exportHandler serves GET /export/{entity}?format=csv|xlsx with the same
+// columns the importer reads, so an export can be edited and imported again.
+func (s *Server) exportHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		name := strings.TrimPrefix(r.URL.Path, "/export/")
+		entity, ok := transferEntities[name]
+		if !ok {
+			http.Error(w, fmt.Sprintf("cannot export %q", name), http.StatusNotFound)
+			return
+		}
+		if !allowScoped(w, r, entity.domain, account.ScopeRead, entity.roles()...) {
+			return
+		}
+
+		format, err := parseTableFormat(r.URL.Query().Get("format"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), s.exportTimeout)
+		defer cancel()
+
+		rows, err := entity.export(ctx, s)
+		if err != nil {
+			logError(ctx, err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", format.contentType())
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.%s"`, name, format))
+		if err := writeTable(w, format, name, append([][]string{entity.columns}, rows...)); err != nil {
+			logError(ctx, err)
+		}
+	})
+}
+
+func uploadedFile(r *http.Request) (io.ReadCloser, string, error) {
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType != "multipart/form-data" {
+		return r.Body, "", nil
+	}
+
+	if err := r.ParseMultipartForm(maxImportBytes); err != nil {
+		return nil, "", err
+	}
+	f, header, err := r.FormFile("file")
+	if err != nil {
+		return nil, "", err
+	}
+	return f, header.Filename, nil
+}
+
+func uploadFormat(format, filename, contentType string) string {
+	if format != "" {
+		return format
+	}
+	if ext := strings.TrimPrefix(path.Ext(filename), "."); ext != "" {
+		return ext
+	}
+	if mediaType, _, _ := mime.ParseMediaType(contentType); mediaType == xlsxContentType {
+		return string(tableXLSX)
+	}
+	return string(tableCSV)
+}
+
+// columnMapping finds the position of every known column in the header row,
+// honouring map.<column>=<header> overrides. Headers match case-insensitively.
+func columnMapping(entity *transferEntity, header []string, q map[string][]string) (map[string]int, error) {
+	known := map[string]bool{}
+	for _, column := range entity.columns {
+		known[column] = true
+	}
+
+	wanted := map[string]string{}
+	for _, column := range entity.columns {
+		wanted[column] = column
+	}
+	for k, v := range q {
+		column, ok := strings.CutPrefix(k, "map.")
+		if !ok {
+			continue
+		}
+		if !known[column] {
+			return nil, fmt.Errorf("unknown column %q, expected one of %s", column, strings.Join(entity.columns, ", "))
+		}
+		wanted[column] = v[0]
+	}
+
+	mapping := map[string]int{}
+	for column, name := range wanted {
+		for i, h := range header {
+			if strings.EqualFold(strings.TrimSpace(h), strings.TrimSpace(name)) {
+				mapping[column] = i
+				break
+			}
+		}
+	}
+
+	for _, column := range entity.required {
+		if _, ok := mapping[column]; !ok {
+			return nil, fmt.Errorf("missing column %q", wanted[column])
+		}
+	}
+	return mapping, nil
+}
+
+func isBlank(r record) bool {
+	for _, v := range r {
+		if v != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		slog.Error("Writing response", "err", err)
+	}
+}
+
+func isNotFound(err error) bool {
+	return status.Code(err) == codes.NotFound
+}
+
+// Courses
+
+func planCourse(ctx context.Context, s *Server, r record, _ map[string]bool) (*rowPlan, error) {
+	name := r["name"]
+	if len(name) > 100 {
+		return nil, fmt.Errorf("name is longer than 100 characters")
+	}
+
+	_, err := s.educationClient.GetCourseByName(ctx, name)
+	if err == nil {
+		return &rowPlan{action: rowUnchanged}, nil
+	}
+	if !isNotFound(err) {
+		return nil, err
+	}
+
+	return &rowPlan{action: rowCreate, apply: func(ctx context.Context) error {
+		_, err := s.educationClient.PostCourse(ctx, name, nil)
+		return err
+	}}, nil
+}
+
+func exportCourses(ctx context.Context, s *Server) ([][]string, error) {
+	rows := [][]string{}
+	for skip := uint64(0); ; skip += s.paging.Max {
+		courses, err := s.educationClient.GetCourses(ctx, skip, s.paging.Max, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range courses {
+			rows = append(rows, []string{c.Name})
+		}
+		if uint64(len(courses)) < s.paging.Max {
+			return rows, nil
+		}
+	}
+}
+
+// Classes
+
+func planClass(ctx context.Context, s *Server, r record, _ map[string]bool) (*rowPlan, error) {
+	name := r["name"]
+	if len(name) > 100 {
+		return nil, fmt.Errorf("name is longer than 100 characters")
+	}
+
+	course, err := s.educationClient.GetCourseByName(ctx, r["course"])
+	if isNotFound(err) {
+		return nil, fmt.Errorf("course %q does not exist", r["course"])
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.educationClient.GetClassByName(ctx, name)
+	if isNotFound(err) {
+		return &rowPlan{action: rowCreate, apply: func(ctx context.Context) error {
+			_, err := s.educationClient.PostClass(ctx, course.ID, name, nil)
+			return err
+		}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if existing.CourseID == course.ID {
+		return &rowPlan{action: rowUnchanged}, nil
+	}
+	return &rowPlan{action: rowUpdate, apply: func(ctx context.Context) error {
+		_, err := s.educationClient.UpdateClass(ctx, existing.ID, nil, &course.ID, nil, nil)
+		return err
+	}}, nil
+}
+
+func exportClasses(ctx context.Context, s *Server) ([][]string, error) {
+	rows := [][]string{}
+	for skip := uint64(0); ; skip += s.paging.Max {
+		classes, err := s.educationClient.GetClasses(ctx, skip, s.paging.Max, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range classes {
+			rows = append(rows, []string{c.Name, c.Course.Name})
+		}
+		if uint64(len(classes)) < s.paging.Max {
+			return rows, nil
+		}
+	}
+}
+
+// Locations
+
+var locationKinds = map[string]bool{
+	inventory.LocationKindBuilding: true,
+	inventory.LocationKindRoom:     true,
+	inventory.LocationKindCabinet:  true,
+	inventory.LocationKindShelf:    true,
+}
+
+func (s *Server) locationByCode(ctx context.Context, code string) (*inventory.Location, error) {
+	locations, err := s.inventoryClient.GetLocations(ctx, 0, 1, nil, &code, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(locations) == 0 {
+		return nil, nil
+	}
+	return locations[0], nil
+}
+
+func planLocation(ctx context.Context, s *Server, r record, seen map[string]bool) (*rowPlan, error) {
+	code, name := r["code"], r["name"]
+	kind := strings.ToUpper(r["kind"])
+	if !locationKinds[kind] {
+		return nil, fmt.Errorf("kind %q is not one of BUILDING, ROOM, CABINET, SHELF", r["kind"])
+	}
+	if len(code) > 50 || len(name) > 100 {
+		return nil, fmt.Errorf("code or name is too long")
+	}
+
+	parentCode := r["parent"]
+	var parent *inventory.Location
+	if parentCode != "" {
+		p, err := s.locationByCode(ctx, parentCode)
+		if err != nil {
+			return nil, err
+		}
+		// A parent created further up in the same file does not exist yet.
+		if p == nil && !seen[parentCode] {
+			return nil, fmt.Errorf("parent location %q does not exist", parentCode)
+		}
+		parent = p
+	}
+
+	var classID *string
+	if r["class"] != "" {
+		class, err := s.educationClient.GetClassByName(ctx, r["class"])
+		if isNotFound(err) {
+			return nil, fmt.Errorf("class %q does not exist", r["class"])
+		}
+		if err != nil {
+			return nil, err
+		}
+		classID = &class.ID
+	}
+
+	existing, err := s.locationByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing == nil {
+		return &rowPlan{action: rowCreate, apply: func(ctx context.Context) error {
+			var parentID *string
+			if parentCode != "" {
+				p, err := s.locationByCode(ctx, parentCode)
+				if err != nil {
+					return err
+				}
+				if p == nil {
+					return fmt.Errorf("parent location %q does not exist", parentCode)
+				}
+				parentID = &p.ID
+			}
+			_, err := s.inventoryClient.PostLocation(ctx, parentID, kind, name, code, classID)
+			return err
+		}}, nil
+	}
+
+	if existing.Kind != kind {
+		return nil, fmt.Errorf("kind of existing location cannot be changed from %s", existing.Kind)
+	}
+	if r.has("parent") {
+		var parentID *string
+		if parent != nil {
+			parentID = &parent.ID
+		}
+		if parentCode != "" && parent == nil || !sameID(existing.ParentID, parentID) {
+			return nil, fmt.Errorf("parent of existing location cannot be changed by import")
+		}
+	}
+
+	nameChanged := existing.Name != name
+	classChanged := r.has("class") && !sameID(existing.ClassID, classID)
+	if !nameChanged && !classChanged {
+		return &rowPlan{action: rowUnchanged}, nil
+	}
+
+	return &rowPlan{action: rowUpdate, apply: func(ctx context.Context) error {
+		var id *string
+		if classChanged {
+			id = classID
+		}
+		_, err := s.inventoryClient.UpdateLocation(ctx, existing.ID, &name, nil, id, classChanged && classID == nil)
+		return err
+	}}, nil
+}
+
+func exportLocations(ctx context.Context, s *Server) ([][]string, error) {
+	locations := []*inventory.Location{}
+	for skip := uint64(0); ; skip += s.paging.Max {
+		page, err := s.inventoryClient.GetLocations(ctx, skip, s.paging.Max, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		locations = append(locations, page...)
+		if uint64(len(page)) < s.paging.Max {
+			break
+		}
+	}
+
+	codes := map[string]string{}
+	for _, l := range locations {
+		codes[l.ID] = l.Code
+	}
+	var classIDs []string
+	for _, l := range locations {
+		if l.ClassID != nil {
+			classIDs = append(classIDs, *l.ClassID)
+		}
+	}
+	classNames, err := s.classNames(ctx, classIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := [][]string{}
+	for _, l := range locations {
+		parent, class := "", ""
+		if l.ParentID != nil {
+			parent = codes[*l.ParentID]
+		}
+		if l.ClassID != nil {
+			class = classNames[*l.ClassID]
+		}
+		rows = append(rows, []string{l.Code, l.Name, l.Kind, parent, class})
+	}
+	return rows, nil
+}
+
+// classNames looks the classes up by ID, locations may still point at classes
+// of an earlier term.
+func (s *Server) classNames(ctx context.Context, ids []string) (map[string]string, error) {
+	names := map[string]string{}
+	for len(ids) > 0 {
+		n := min(len(ids), int(s.paging.Max))
+		classes, err := s.educationClient.GetClassesByIDs(ctx, ids[:n])
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range classes {
+			names[c.ID] = c.Name
+		}
+		ids = ids[n:]
+	}
+	return names, nil
+}
+
+func sameID(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// Items
+
+func planItem(ctx context.Context, s *Server, r record, _ map[string]bool) (*rowPlan, error) {
+	tag := r["asset_tag"]
+	if len(tag) > 50 || len(r["name"]) > 100 || len(r["item_type"]) > 50 {
+		return nil, fmt.Errorf("asset_tag, name or item_type is too long")
+	}
+
+	var location *inventory.Location
+	if r["location"] != "" {
+		l, err := s.locationByCode(ctx, r["location"])
+		if err != nil {
+			return nil, err
+		}
+		if l == nil {
+			return nil, fmt.Errorf("location %q does not exist", r["location"])
+		}
+		location = l
+	}
+	var locationID *string
+	if location != nil {
+		locationID = &location.ID
+	}
+
+	existing, err := s.inventoryClient.GetItemByAssetTag(ctx, tag)
+	if isNotFound(err) {
+		if r["name"] == "" {
+			return nil, fmt.Errorf("name is required for new items")
+		}
+		return &rowPlan{action: rowCreate, apply: func(ctx context.Context) error {
+			_, err := s.inventoryClient.PostItem(ctx, r["name"], tag, r["description"], r["item_type"], locationID)
+			return err
+		}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var name, description, itemType *string
+	if r["name"] != "" && r["name"] != existing.Name {
+		v := r["name"]
+		name = &v
+	}
+	if r.has("description") && r["description"] != existing.Description {
+		v := r["description"]
+		description = &v
+	}
+	if r.has("item_type") && r["item_type"] != existing.ItemType {
+		v := r["item_type"]
+		itemType = &v
+	}
+	moved := r.has("location") && !sameID(existing.LocationID, locationID)
+
+	if name == nil && description == nil && itemType == nil && !moved {
+		return &rowPlan{action: rowUnchanged}, nil
+	}
+
+	return &rowPlan{action: rowUpdate, apply: func(ctx context.Context) error {
+		if name != nil || description != nil || itemType != nil {
+			if _, err := s.inventoryClient.UpdateItem(ctx, existing.ID, name, nil, description, itemType); err != nil {
+				return err
+			}
+		}
+		if moved {
+			if _, _, err := s.inventoryClient.MoveItem(ctx, existing.ID, locationID, "import"); err != nil {
+				return err
+			}
+		}
+		return nil
+	}}, nil
+}
+
+func exportItems(ctx context.Context, s *Server) ([][]string, error) {
+	codes := map[string]string{}
+	for skip := uint64(0); ; skip += s.paging.Max {
+		page, err := s.inventoryClient.GetLocations(ctx, skip, s.paging.Max, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range page {
+			codes[l.ID] = l.Code
+		}
+		if uint64(len(page)) < s.paging.Max {
+			break
+		}
+	}
+
+	rows := [][]string{}
+	for skip := uint64(0); ; skip += s.paging.Max {
+		items, err := s.inventoryClient.GetItems(ctx, skip, s.paging.Max)
+		if err != nil {
+			return nil, err
+		}
+		for _, i := range items {
+			location := ""
+			if i.LocationID != nil {
+				location = codes[*i.LocationID]
+			}
+			rows = append(rows, []string{i.AssetTag, i.Name, i.Description, location, i.ItemType})
+		}
+		if uint64(len(items)) < s.paging.Max {
+			return rows, nil
+		}
+	}
+}
+
+// Accounts
+
+// importRoles leaves SERVICE out, service accounts have no email to key on.
+var importRoles = []string{account.RoleStudent, account.RoleTeacher, account.RoleAdmin}
+
+var importLanguages = []string{account.LanguageDutch, account.LanguageEnglish}
+
+// planAccount creates accounts as invited, which mails them to verify their
+// email, and never changes the state of existing ones.
+func planAccount(ctx context.Context, s *Server, r record, _ map[string]bool) (*rowPlan, error) {
+	email := strings.ToLower(r["email"])
+	if len(email) > 254 || len(r["first_name"]) > 100 || len(r["insertion"]) > 50 || len(r["last_name"]) > 100 || len(r["card_number"]) > 50 {
+		return nil, fmt.Errorf("email, a name or card_number is too long")
+	}
+	role := strings.ToUpper(r["role"])
+	if role != "" && !slices.Contains(importRoles, role) {
+		return nil, fmt.Errorf("role %q is not one of %s", r["role"], strings.Join(importRoles, ", "))
+	}
+	language := strings.ToLower(r["language"])
+	if language != "" && !slices.Contains(importLanguages, language) {
+		return nil, fmt.Errorf("language %q is not one of %s", r["language"], strings.Join(importLanguages, ", "))
+	}
+
+	var classID *string
+	if r["class"] != "" {
+		class, err := s.educationClient.GetClassByName(ctx, r["class"])
+		if isNotFound(err) {
+			return nil, fmt.Errorf("class %q does not exist", r["class"])
+		}
+		if err != nil {
+			return nil, err
+		}
+		classID = &class.ID
+	}
+
+	existing, err := s.accountClient.GetAccountByEmail(ctx, email)
+	if isNotFound(err) {
+		if r["first_name"] == "" || r["last_name"] == "" {
+			return nil, fmt.Errorf("first_name and last_name are required for new accounts")
+		}
+		if role == "" {
+			role = account.RoleStudent
+		}
+		return &rowPlan{action: rowCreate, apply: func(ctx context.Context) error {
+			_, err := s.accountClient.PostAccount(ctx, r["first_name"], r["insertion"], r["last_name"], email, r["card_number"], role, language, classID)
+			return err
+		}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if existing.Role == account.RoleService || existing.AnonymisedAt != nil {
+		return nil, fmt.Errorf("account %q can't be changed by import", email)
+	}
+
+	changed := func(column, current string) *string {
+		if !r.has(column) || r[column] == current {
+			return nil
+		}
+		v := r[column]
+		return &v
+	}
+	var firstName, lastName *string
+	if r["first_name"] != "" {
+		firstName = changed("first_name", existing.FirstName)
+	}
+	if r["last_name"] != "" {
+		lastName = changed("last_name", existing.LastName)
+	}
+	insertion := changed("insertion", existing.Insertion)
+	cardNumber := changed("card_number", existing.CardNumber)
+	var newRole, newLanguage *string
+	if role != "" && role != existing.Role {
+		newRole = &role
+	}
+	if language != "" && language != existing.Language {
+		newLanguage = &language
+	}
+	classChanged := r.has("class") && !sameID(existing.ClassID, classID)
+
+	if firstName == nil && lastName == nil && insertion == nil && cardNumber == nil && newRole == nil && newLanguage == nil && !classChanged {
+		return &rowPlan{action: rowUnchanged}, nil
+	}
+
+	return &rowPlan{action: rowUpdate, apply: func(ctx context.Context) error {
+		var id *string
+		if classChanged {
+			id = classID
+		}
+		_, err := s.accountClient.UpdateAccount(ctx, existing.ID, firstName, insertion, lastName, nil, cardNumber, newRole, newLanguage, id, classChanged && classID == nil)
+		return err
+	}}, nil
+}
+
+// exportAccounts leaves out service accounts and anonymised ones, neither has
+// an email to import them by.
+func exportAccounts(ctx context.Context, s *Server) ([][]string, error) {
+	accounts := []*account.Account{}
+	for skip := uint64(0); ; skip += s.paging.Max {
+		page, err := s.accountClient.GetAccounts(ctx, skip, s.paging.Max, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range page {
+			if a.Role != account.RoleService && a.AnonymisedAt == nil {
+				accounts = append(accounts, a)
+			}
+		}
+		if uint64(len(page)) < s.paging.Max {
+			break
+		}
+	}
+
+	var classIDs []string
+	for _, a := range accounts {
+		if a.ClassID != nil {
+			classIDs = append(classIDs, *a.ClassID)
+		}
+	}
+	classNames, err := s.classNames(ctx, classIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := [][]string{}
+	for _, a := range accounts {
+		class := ""
+		if a.ClassID != nil {
+			class = classNames[*a.ClassID]
+		}
+		rows = append(rows, []string{a.Email, a.FirstName, a.Insertion, a.LastName, a.Role, class, a.CardNumber, a.Language})
+	}
+	return rows, nil
+}
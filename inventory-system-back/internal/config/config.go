@@ -0,0 +1,154 @@
+// Package config loads the typed envconfig struct of a binary. Values come
+// from the struct defaults, then an optional config file, then the
+// environment, each overriding the one before.
+package config
+
+import (
+	"bufio"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+)
+
+// The config file uses the environment variable names, one KEY=value per
+// line, which is also what --print-config writes.
+const printTemplate = `{{range .}}{{usage_key .}}={{if eq (.Tags.Get "secret") "true"}}<redacted>{{else}}{{.Field}}{{end}}
+{{end}}`
+
+// Load fills cfg and calls its Validate method, if it has one. It takes two
+// flags from the command line: --config <file>, which defaults to
+// $CONFIG_FILE, and --print-config, which prints the result and exits. The
+// remaining arguments are returned for subcommands like healthcheck.
+func Load(cfg any) ([]string, error) {
+	fs := flag.NewFlagSet(os.Args[0], flag.ContinueOnError)
+	file := fs.String("config", os.Getenv("CONFIG_FILE"), "read settings from this KEY=value file, the environment takes precedence")
+	printConfig := fs.Bool("print-config", false, "print the resolved settings and exit")
+	if err := fs.Parse(os.Args[1:]); err != nil {
+		return nil, err
+	}
+
+	if *file != "" {
+		if err := loadFile(*file); err != nil {
+			return nil, err
+		}
+	}
+	if err := envconfig.Process("", cfg); err != nil {
+		return nil, err
+	}
+	if v, ok := cfg.(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid config: %w", err)
+		}
+	}
+
+	if *printConfig {
+		if err := Print(os.Stdout, cfg); err != nil {
+			return nil, err
+		}
+		os.Exit(0)
+	}
+	return fs.Args(), nil
+}
+
+// Print writes cfg in the config file format, with the values of fields
+// tagged secret:"true" redacted.
+func Print(w io.Writer, cfg any) error {
+	return envconfig.Usagef("", cfg, w, printTemplate)
+}
+
+// loadFile sets every variable in path that is not in the environment yet.
+func loadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	sc := bufio.NewScanner(f)
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return fmt.Errorf("%s:%d: expected KEY=value", path, n)
+		}
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			value = unquoted
+		}
+
+		if _, set := os.LookupEnv(key); !set {
+			os.Setenv(key, value)
+		}
+	}
+	return sc.Err()
+}
+
+// Paging bounds the take of list calls.
+type Paging struct {
+	Default uint64 `envconfig:"DEFAULT" default:"50"`
+	Max     uint64 `envconfig:"MAX" default:"100"`
+}
+
+var ErrPageTooLarge = errors.New("page size exceeds the maximum")
+
+func (p Paging) Validate() error {
+	if p.Default == 0 || p.Max < p.Default {
+		return fmt.Errorf("page size default %d must be between 1 and the max of %d", p.Default, p.Max)
+	}
+	return nil
+}
+
+// Take returns take, or the default if it is nil, and fails above the
+// maximum rather than quietly returning a shorter page.
+func (p Paging) Take(take *uint64) (uint64, error) {
+	if take == nil {
+		return p.Default, nil
+	}
+	if *take > p.Max {
+		return 0, fmt.Errorf("%w of %d", ErrPageTooLarge, p.Max)
+	}
+	return *take, nil
+}
+
+// DBPool sizes a sql.DB connection pool.
+type DBPool struct {
+	MaxOpenConns    int           `envconfig:"MAX_OPEN_CONNS" default:"20"`
+	MaxIdleConns    int           `envconfig:"MAX_IDLE_CONNS" default:"5"`
+	ConnMaxLifetime time.Duration `envconfig:"CONN_MAX_LIFETIME" default:"30m"`
+	ConnMaxIdleTime time.Duration `envconfig:"CONN_MAX_IDLE_TIME" default:"5m"`
+}
+
+func (p DBPool) Validate() error {
+	if p.MaxOpenConns < 1 || p.MaxIdleConns < 0 || p.MaxIdleConns > p.MaxOpenConns {
+		return fmt.Errorf("pool needs 1 or more open connections and at most as many idle ones, got %d and %d", p.MaxOpenConns, p.MaxIdleConns)
+	}
+	return nil
+}
+
+func (p DBPool) Apply(db *sql.DB) {
+	db.SetMaxOpenConns(p.MaxOpenConns)
+	db.SetMaxIdleConns(p.MaxIdleConns)
+	db.SetConnMaxLifetime(p.ConnMaxLifetime)
+	db.SetConnMaxIdleTime(p.ConnMaxIdleTime)
+}
+
+// Port checks that port can be listened on.
+func Port(name string, port int) error {
+	if port < 1 || port > 65535 {
+		return fmt.Errorf("%s %d is not a valid port", name, port)
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+// Package grpctls builds the transport credentials for the gRPC servers and
+// clients from envconfig settings: plaintext when nothing is set, TLS with a
+// certificate and key, and mutual TLS when client certificates are required.
+package grpctls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Config is meant to be embedded in a binary's envconfig struct under the
+// TLS prefix, e.g. TLS_CERT and TLS_CLIENT_AUTH.
+type Config struct {
+	Cert string `envconfig:"CERT"`
+	Key  string `envconfig:"KEY"`
+	// CA verifies the other side. Clients fall back to the system roots
+	// without it, servers need it for ClientAuth.
+	CA string `envconfig:"CA"`
+	// ClientAuth makes servers require a client certificate signed by CA.
+	ClientAuth bool `envconfig:"CLIENT_AUTH"`
+	// DevDir switches to a throwaway CA kept in this directory, which the
+	// containers of a docker-compose setup share. Each process issues its
+	// own certificate from it at startup. Never use it in production.
+	DevDir string `envconfig:"DEV_DIR"`
+}
+
+// Enabled reports whether any TLS setting is present. Servers need TLS_CERT
+// and TLS_KEY, a client without a certificate of its own only needs TLS_CA.
+func (c Config) Enabled() bool {
+	return c.Cert != "" || c.CA != "" || c.DevDir != ""
+}
+
+func (c Config) Validate() error {
+	if c.DevDir != "" {
+		if c.Cert != "" || c.Key != "" || c.CA != "" {
+			return errors.New("TLS_DEV_DIR can't be combined with TLS_CERT, TLS_KEY or TLS_CA")
+		}
+		return nil
+	}
+	if (c.Cert == "") != (c.Key == "") {
+		return errors.New("TLS_CERT and TLS_KEY must be set together")
+	}
+	if c.ClientAuth && (c.Cert == "" || c.CA == "") {
+		return errors.New("TLS_CLIENT_AUTH needs TLS_CERT, TLS_KEY and TLS_CA")
+	}
+	return nil
+}
+
+// Server returns the credentials for a gRPC server. name is the host name
+// clients dial, which certificates issued in dev mode are made out to.
+func (c Config) Server(name string) (credentials.TransportCredentials, error) {
+	if !c.Enabled() {
+		return insecure.NewCredentials(), nil
+	}
+	if c.Cert == "" && c.DevDir == "" {
+		return nil, errors.New("a TLS server needs TLS_CERT and TLS_KEY")
+	}
+
+	cert, pool, err := c.load(name)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &tls.Config{
+		Certificates: []tls.Certificate{*cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if c.ClientAuth {
+		cfg.ClientCAs = pool
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return credentials.NewTLS(cfg), nil
+}
+
+// Client returns the credentials for dialing the other services. The
+// certificate, if any, is presented for mutual TLS.
+func (c Config) Client(name string) (credentials.TransportCredentials, error) {
+	if !c.Enabled() {
+		return insecure.NewCredentials(), nil
+	}
+
+	cert, pool, err := c.load(name)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &tls.Config{
+		RootCAs:    pool,
+		MinVersion: tls.VersionTLS12,
+	}
+	if cert != nil {
+		cfg.Certificates = []tls.Certificate{*cert}
+	}
+	return credentials.NewTLS(cfg), nil
+}
+
+// load returns the certificate of this process, if it has one, and the pool
+// that verifies its peers. A nil pool means the system roots.
+func (c Config) load(name string) (*tls.Certificate, *x509.CertPool, error) {
+	if c.DevDir != "" {
+		return devCertificate(c.DevDir, name)
+	}
+
+	var cert *tls.Certificate
+	if c.Cert != "" {
+		kp, err := tls.LoadX509KeyPair(c.Cert, c.Key)
+		if err != nil {
+			return nil, nil, err
+		}
+		cert = &kp
+	}
+	if c.CA == "" {
+		return cert, nil, nil
+	}
+
+	pem, err := os.ReadFile(c.CA)
+	if err != nil {
+		return nil, nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, nil, fmt.Errorf("no certificates in %s", c.CA)
+	}
+	return cert, pool, nil
+}
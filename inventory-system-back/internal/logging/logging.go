@@ -0,0 +1,74 @@
+// Package logging sets up structured JSON logging and carries a request ID
+// from the gateway through gRPC metadata into every service log line.
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/segmentio/ksuid"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RequestIDHeader is used both as the HTTP header and the gRPC metadata key.
+const RequestIDHeader = "x-request-id"
+
+type requestIDKey struct{}
+
+// Setup installs a JSON handler at the given level ("debug", "info", "warn"
+// or "error") as the slog default. The standard log package writes through it
+// as well.
+func Setup(level string) error {
+	l, err := ParseLevel(level)
+	if err != nil {
+		return err
+	}
+
+	h := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: l})
+	slog.SetDefault(slog.New(contextHandler{h}))
+	return nil
+}
+
+func ParseLevel(level string) (slog.Level, error) {
+	var l slog.Level
+	err := l.UnmarshalText([]byte(level))
+	return l, err
+}
+
+func NewRequestID() string {
+	return ksuid.New().String()
+}
+
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// contextHandler adds the request ID and, when tracing, the trace ID of the
+// context to every record logged with one of the slog ...Context functions.
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if id := RequestID(ctx); id != "" {
+		r.AddAttrs(slog.String("request_id", id))
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		r.AddAttrs(slog.String("trace_id", sc.TraceID().String()))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
+}
@@ -0,0 +1,166 @@
+// Package mail sends the emails services write to people. Mailers are picked
+// from envconfig settings: SMTP in production, a directory of .eml files for
+// local development, and an in-memory outbox for tests.
+package mail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Message is a plain text email.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+type Mailer interface {
+	Send(ctx context.Context, m Message) error
+}
+
+// Config is meant to be embedded in a binary's envconfig struct under the
+// MAIL prefix, e.g. MAIL_SENDER and MAIL_SMTP_ADDR.
+type Config struct {
+	// Sender is smtp, file or memory.
+	Sender string `envconfig:"SENDER" default:"file"`
+	From   string `envconfig:"FROM" default:"Inventory <no-reply@localhost>"`
+
+	SMTPAddr     string `envconfig:"SMTP_ADDR"`
+	SMTPUser     string `envconfig:"SMTP_USER"`
+	SMTPPassword string `envconfig:"SMTP_PASSWORD" secret:"true"`
+
+	// Dir is where the file sender writes its messages.
+	Dir string `envconfig:"DIR" default:"/tmp/mail"`
+}
+
+func (c Config) Validate() error {
+	if _, err := mail.ParseAddress(c.From); err != nil {
+		return fmt.Errorf("MAIL_FROM: %w", err)
+	}
+	switch c.Sender {
+	case "smtp":
+		if _, _, err := net.SplitHostPort(c.SMTPAddr); err != nil {
+			return fmt.Errorf("MAIL_SMTP_ADDR: %w", err)
+		}
+		if (c.SMTPUser == "") != (c.SMTPPassword == "") {
+			return errors.New("MAIL_SMTP_USER and MAIL_SMTP_PASSWORD must be set together")
+		}
+	case "file":
+		if c.Dir == "" {
+			return errors.New("the file sender needs MAIL_DIR")
+		}
+	case "memory":
+	default:
+		return fmt.Errorf("MAIL_SENDER %q is not smtp, file or memory", c.Sender)
+	}
+	return nil
+}
+
+// Mailer returns the mailer Sender names.
+func (c Config) Mailer() (Mailer, error) {
+	switch c.Sender {
+	case "smtp":
+		return &SMTP{Addr: c.SMTPAddr, From: c.From, User: c.SMTPUser, Password: c.SMTPPassword}, nil
+	case "file":
+		return NewFile(c.Dir, c.From)
+	case "memory":
+		return &Memory{}, nil
+	}
+	return nil, fmt.Errorf("unknown mail sender %q", c.Sender)
+}
+
+// SMTP hands messages to a mail server, logging in with PLAIN auth when User
+// is set. The server must offer STARTTLS for that.
+type SMTP struct {
+	Addr     string
+	From     string
+	User     string
+	Password string
+}
+
+func (s *SMTP) Send(ctx context.Context, m Message) error {
+	from, err := mail.ParseAddress(s.From)
+	if err != nil {
+		return err
+	}
+	var auth smtp.Auth
+	if s.User != "" {
+		host, _, _ := net.SplitHostPort(s.Addr)
+		auth = smtp.PlainAuth("", s.User, s.Password, host)
+	}
+
+	// net/smtp takes no context, so a cancelled one only stops the send from
+	// starting.
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return smtp.SendMail(s.Addr, auth, from.Address, []string{m.To}, format(s.From, m, time.Now()))
+}
+
+// File writes every message to its own .eml file in a directory, where they
+// can be opened with a mail client.
+type File struct {
+	dir  string
+	from string
+	seq  atomic.Uint64
+}
+
+func NewFile(dir, from string) (*File, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &File{dir: dir, from: from}, nil
+}
+
+func (f *File) Send(_ context.Context, m Message) error {
+	now := time.Now()
+	name := fmt.Sprintf("%s-%d.eml", now.UTC().Format("20060102T150405.000000000"), f.seq.Add(1))
+	return os.WriteFile(filepath.Join(f.dir, name), format(f.from, m, now), 0o644)
+}
+
+// Memory keeps the messages it is given.
+type Memory struct {
+	mu   sync.Mutex
+	sent []Message
+}
+
+func (mem *Memory) Send(_ context.Context, m Message) error {
+	mem.mu.Lock()
+	defer mem.mu.Unlock()
+	mem.sent = append(mem.sent, m)
+	return nil
+}
+
+// Sent returns the messages sent so far, oldest first.
+func (mem *Memory) Sent() []Message {
+	mem.mu.Lock()
+	defer mem.mu.Unlock()
+	return append([]Message(nil), mem.sent...)
+}
+
+// format lays m out as an RFC 5322 message.
+func format(from string, m Message, date time.Time) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", m.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", m.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(strings.ReplaceAll(m.Body, "\r\n", "\n"), "\n", "\r\n"))
+	return []byte(b.String())
+}
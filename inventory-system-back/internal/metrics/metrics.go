@@ -0,0 +1,58 @@
+// Package metrics holds the Prometheus collectors shared by the gateway and
+// the services, and the /metrics handler that exposes them.
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"time"
+
+	grpcprom "github.com/grpc-ecosystem/go-grpc-middleware/providers/prometheus"
+	"github.com/jochem11/inventory-system-back/internal/serve"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+)
+
+var (
+	// GRPCServer counts and times every call a service handles, labelled
+	// by method and status code.
+	GRPCServer = grpcprom.NewServerMetrics(grpcprom.WithServerHandlingTimeHistogram())
+
+	// GRPCClient does the same on the calling side, so the gateway sees
+	// the latency of each service including the network.
+	GRPCClient = grpcprom.NewClientMetrics(grpcprom.WithClientHandlingTimeHistogram())
+)
+
+func init() {
+	prometheus.MustRegister(GRPCServer, GRPCClient)
+}
+
+// Handler serves everything registered with the default registry, which
+// includes the Go runtime and process collectors.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// Serve exposes Handler on /metrics of its own port until ctx is cancelled.
+// The services need it next to their gRPC port, which Prometheus can't scrape,
+// and the gateway keeps it off the port it serves the public on.
+func Serve(ctx context.Context, port int, drain time.Duration) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", Handler())
+	return serve.HTTP(ctx, &http.Server{Addr: fmt.Sprintf(":%d", port), Handler: mux}, drain)
+}
+
+// InitGRPCServer zeroes the series of every method registered on srv so
+// they show up before the first call.
+func InitGRPCServer(srv *grpc.Server) {
+	GRPCServer.InitializeMetrics(srv)
+}
+
+// RegisterDB exports the connection pool stats of db, labelled db_name=name.
+func RegisterDB(db *sql.DB, name string) error {
+	return prometheus.Register(collectors.NewDBStatsCollector(db, name))
+}
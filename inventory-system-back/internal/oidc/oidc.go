@@ -0,0 +1,361 @@
+// Package oidc logs people in with an OpenID Connect provider, using the
+// authorization code flow with PKCE. It only does what that flow needs:
+// discovery, the token exchange and checking RS256 ID tokens.
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Config is meant to be embedded in a binary's envconfig struct under the
+// OIDC prefix, e.g. OIDC_ISSUER and OIDC_CLIENT_ID. Single sign-on is off
+// while Issuer is empty.
+type Config struct {
+	Issuer   string `envconfig:"ISSUER"`
+	ClientID string `envconfig:"CLIENT_ID"`
+	// ClientSecret is sent with client_secret_basic. Public clients leave it
+	// empty and rely on PKCE alone.
+	ClientSecret string `envconfig:"CLIENT_SECRET" secret:"true"`
+	// RedirectURL is the callback registered with the provider.
+	RedirectURL string   `envconfig:"REDIRECT_URL"`
+	Scopes      []string `envconfig:"SCOPES" default:"openid,email,profile"`
+}
+
+func (c Config) Enabled() bool {
+	return c.Issuer != ""
+}
+
+func (c Config) Validate() error {
+	if !c.Enabled() {
+		return nil
+	}
+	var errs []error
+	if u, err := url.Parse(c.Issuer); err != nil || !u.IsAbs() {
+		errs = append(errs, fmt.Errorf("OIDC_ISSUER %q must be an absolute URL", c.Issuer))
+	}
+	if u, err := url.Parse(c.RedirectURL); err != nil || !u.IsAbs() {
+		errs = append(errs, fmt.Errorf("OIDC_REDIRECT_URL %q must be an absolute URL", c.RedirectURL))
+	}
+	if c.ClientID == "" {
+		errs = append(errs, errors.New("OIDC_CLIENT_ID is required with OIDC_ISSUER"))
+	}
+	if !slices.Contains(c.Scopes, "openid") {
+		errs = append(errs, errors.New("OIDC_SCOPES must include openid"))
+	}
+	return errors.Join(errs...)
+}
+
+// leeway allows for clock drift between us and the provider.
+const leeway = time.Minute
+
+var ErrInvalidToken = errors.New("invalid ID token")
+
+// Claims are the claims of a checked ID token.
+type Claims map[string]any
+
+// String returns the string claim name, or "" if it is missing or not a
+// string.
+func (c Claims) String(name string) string {
+	s, _ := c[name].(string)
+	return s
+}
+
+// Strings returns a claim that may be a single string or a list of them,
+// like roles and groups.
+func (c Claims) Strings(name string) []string {
+	switch v := c[name].(type) {
+	case string:
+		return []string{v}
+	case []any:
+		var res []string
+		for _, e := range v {
+			if s, ok := e.(string); ok {
+				res = append(res, s)
+			}
+		}
+		return res
+	}
+	return nil
+}
+
+// Provider talks to the provider at Config.Issuer. Its metadata is fetched
+// on first use, so the provider doesn't have to be up when we start.
+type Provider struct {
+	cfg    Config
+	client *http.Client
+
+	mu   sync.Mutex
+	meta *metadata
+	keys map[string]*rsa.PublicKey
+}
+
+type metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+func NewProvider(cfg Config, client *http.Client) *Provider {
+	return &Provider{cfg: cfg, client: client}
+}
+
+// Login is what has to be remembered between sending someone to the
+// provider and them coming back.
+type Login struct {
+	State    string `json:"state"`
+	Nonce    string `json:"nonce"`
+	Verifier string `json:"verifier"`
+}
+
+func NewLogin() (*Login, error) {
+	var l Login
+	for _, s := range []*string{&l.State, &l.Nonce, &l.Verifier} {
+		b := make([]byte, 32)
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+		*s = base64.RawURLEncoding.EncodeToString(b)
+	}
+	return &l, nil
+}
+
+// AuthURL is where to send someone to log in for l.
+func (p *Provider) AuthURL(ctx context.Context, l *Login) (string, error) {
+	meta, err := p.metadata(ctx)
+	if err != nil {
+		return "", err
+	}
+	challenge := sha256.Sum256([]byte(l.Verifier))
+	q := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {p.cfg.ClientID},
+		"redirect_uri":          {p.cfg.RedirectURL},
+		"scope":                 {strings.Join(p.cfg.Scopes, " ")},
+		"state":                 {l.State},
+		"nonce":                 {l.Nonce},
+		"code_challenge":        {base64.RawURLEncoding.EncodeToString(challenge[:])},
+		"code_challenge_method": {"S256"},
+	}
+	u, err := url.Parse(meta.AuthorizationEndpoint)
+	if err != nil {
+		return "", err
+	}
+	if u.RawQuery != "" {
+		u.RawQuery += "&"
+	}
+	u.RawQuery += q.Encode()
+	return u.String(), nil
+}
+
+// Exchange trades the code the provider sent back for the claims of its ID
+// token, which are checked against l.
+func (p *Provider) Exchange(ctx context.Context, code string, l *Login) (Claims, error) {
+	meta, err := p.metadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.cfg.RedirectURL},
+		"client_id":     {p.cfg.ClientID},
+		"code_verifier": {l.Verifier},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, meta.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if p.cfg.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.cfg.ClientID), url.QueryEscape(p.cfg.ClientSecret))
+	}
+
+	var res struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	status, err := p.do(req, &res)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK || res.Error != "" {
+		return nil, fmt.Errorf("token endpoint: %d %s %s", status, res.Error, res.ErrorDescription)
+	}
+	return p.verify(ctx, res.IDToken, l.Nonce, time.Now())
+}
+
+// verify checks the signature and claims of an ID token.
+func (p *Provider) verify(ctx context.Context, token, nonce string, now time.Time) (Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrInvalidToken
+	}
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, ErrInvalidToken
+	}
+	if header.Alg != "RS256" {
+		return nil, fmt.Errorf("%w: unsupported alg %q", ErrInvalidToken, header.Alg)
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	key, err := p.key(ctx, header.Kid)
+	if err != nil {
+		return nil, err
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], sig); err != nil {
+		return nil, fmt.Errorf("%w: bad signature", ErrInvalidToken)
+	}
+
+	var claims Claims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, ErrInvalidToken
+	}
+	meta, err := p.metadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	exp, _ := claims["exp"].(float64)
+	switch {
+	case claims.String("iss") != meta.Issuer:
+		return nil, fmt.Errorf("%w: wrong issuer", ErrInvalidToken)
+	case !slices.Contains(claims.Strings("aud"), p.cfg.ClientID):
+		return nil, fmt.Errorf("%w: wrong audience", ErrInvalidToken)
+	case !now.Add(-leeway).Before(time.Unix(int64(exp), 0)):
+		return nil, fmt.Errorf("%w: expired", ErrInvalidToken)
+	case claims.String("nonce") != nonce:
+		return nil, fmt.Errorf("%w: wrong nonce", ErrInvalidToken)
+	}
+	return claims, nil
+}
+
+func (p *Provider) metadata(ctx context.Context) (*metadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.meta != nil {
+		return p.meta, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(p.cfg.Issuer, "/")+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, err
+	}
+	var meta metadata
+	status, err := p.do(req, &meta)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("discovery: %d", status)
+	}
+	if meta.Issuer != p.cfg.Issuer {
+		return nil, fmt.Errorf("discovery: provider calls itself %q, not %q", meta.Issuer, p.cfg.Issuer)
+	}
+	p.meta = &meta
+	return p.meta, nil
+}
+
+// key returns the signing key kid, fetching the key set again for a key
+// it doesn't know, as providers rotate them.
+func (p *Provider) key(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	meta, err := p.metadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	key, ok := p.keys[kid]
+	p.mu.Unlock()
+	if ok {
+		return key, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, meta.JWKSURI, nil)
+	if err != nil {
+		return nil, err
+	}
+	var set struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			Use string `json:"use"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+	status, err := p.do(req, &set)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("jwks: %d", status)
+	}
+
+	keys := map[string]*rsa.PublicKey{}
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" || (k.Use != "" && k.Use != "sig") {
+			continue
+		}
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			continue
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil || len(e) > 4 {
+			continue
+		}
+		keys[k.Kid] = &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+	}
+
+	p.mu.Lock()
+	p.keys = keys
+	p.mu.Unlock()
+	if key, ok := keys[kid]; ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("%w: unknown key %q", ErrInvalidToken, kid)
+}
+
+func (p *Provider) do(req *http.Request, v any) (int, error) {
+	req.Header.Set("Accept", "application/json")
+	res, err := p.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	if err := json.NewDecoder(io.LimitReader(res.Body, 1<<20)).Decode(v); err != nil && res.StatusCode == http.StatusOK {
+		return 0, err
+	}
+	return res.StatusCode, nil
+}
+
+func decodeSegment(seg string, v any) error {
+	b, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
@@ -0,0 +1,144 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type breakerState int
+
+const (
+	closed breakerState = iota
+	open
+	halfOpen
+)
+
+func (s breakerState) String() string {
+	switch s {
+	case open:
+		return "open"
+	case halfOpen:
+		return "half-open"
+	}
+	return "closed"
+}
+
+// Breaker is a circuit breaker for one backend. It only counts failures that
+// say the backend is unreachable or stuck, a NotFound or InvalidArgument is
+// a healthy answer.
+type Breaker struct {
+	name     string
+	failures int
+	cooldown time.Duration
+
+	mu       sync.Mutex
+	state    breakerState
+	count    int
+	openedAt time.Time
+	probing  bool
+}
+
+func NewBreaker(name string, failures int, cooldown time.Duration) *Breaker {
+	return &Breaker{name: name, failures: failures, cooldown: cooldown}
+}
+
+// allow reports whether a call may go out. Once the cooldown has passed a
+// single call is let through, the rest keep failing until it returns.
+func (b *Breaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case open:
+		if time.Since(b.openedAt) < b.cooldown {
+			return false
+		}
+		b.setState(halfOpen)
+		b.probing = true
+		return true
+	case halfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		return true
+	}
+	return true
+}
+
+func (b *Breaker) record(ctx context.Context, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+	if errors.Is(ctx.Err(), context.Canceled) {
+		// The caller gave up, which says nothing about the backend.
+		return
+	}
+	if !backendFault(err) {
+		b.count = 0
+		if b.state != closed {
+			b.setState(closed)
+		}
+		return
+	}
+
+	b.count++
+	if b.state == halfOpen || b.count >= b.failures {
+		b.openedAt = time.Now()
+		if b.state != open {
+			b.setState(open)
+		}
+	}
+}
+
+func (b *Breaker) setState(s breakerState) {
+	slog.Warn("Circuit breaker changed state", "backend", b.name, "from", b.state.String(), "to", s.String())
+	b.state = s
+}
+
+func (b *Breaker) rejected() error {
+	return status.Errorf(codes.Unavailable, "%s is unavailable, circuit breaker open", b.name)
+}
+
+func (b *Breaker) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if !b.allow() {
+			return b.rejected()
+		}
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		b.record(ctx, err)
+		return err
+	}
+}
+
+// StreamClientInterceptor only judges whether a stream could be opened,
+// errors while receiving are left to the caller.
+func (b *Breaker) StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if !b.allow() {
+			return nil, b.rejected()
+		}
+		s, err := streamer(ctx, desc, cc, method, opts...)
+		b.record(ctx, err)
+		return s, err
+	}
+}
+
+// backendFault tells failures of the backend apart from answers. A deadline
+// counts: while a backend is down, calls wait for it until they run out of
+// time.
+func backendFault(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,138 @@
+// Package resilience holds the client side policies the gateway uses to talk
+// to the services: retries with exponential backoff for idempotent RPCs, a
+// circuit breaker, and the backoff used to re-subscribe to streams.
+package resilience
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+type Config struct {
+	// RetryMaxAttempts counts the first call, gRPC caps it at 5.
+	RetryMaxAttempts       int           `envconfig:"MAX_ATTEMPTS" default:"4"`
+	RetryInitialBackoff    time.Duration `envconfig:"INITIAL_BACKOFF" default:"100ms"`
+	RetryMaxBackoff        time.Duration `envconfig:"MAX_BACKOFF" default:"2s"`
+	RetryBackoffMultiplier float64       `envconfig:"BACKOFF_MULTIPLIER" default:"2"`
+
+	// BreakerFailures consecutive failed calls open the breaker, which then
+	// fails calls straight away for BreakerCooldown before letting one
+	// through to see if the service is back.
+	BreakerFailures int           `envconfig:"BREAKER_FAILURES" default:"5"`
+	BreakerCooldown time.Duration `envconfig:"BREAKER_COOLDOWN" default:"10s"`
+}
+
+func (c Config) Validate() error {
+	var errs []error
+	if c.RetryMaxAttempts < 1 || c.RetryMaxAttempts > 5 {
+		errs = append(errs, fmt.Errorf("retry max attempts %d must be between 1 and 5", c.RetryMaxAttempts))
+	}
+	if c.RetryInitialBackoff <= 0 || c.RetryMaxBackoff < c.RetryInitialBackoff {
+		errs = append(errs, errors.New("retry backoff must be positive with the max at least the initial backoff"))
+	}
+	if c.RetryBackoffMultiplier < 1 {
+		errs = append(errs, errors.New("retry backoff multiplier must be at least 1"))
+	}
+	if c.BreakerFailures < 1 || c.BreakerCooldown <= 0 {
+		errs = append(errs, errors.New("breaker failures and cooldown must be positive"))
+	}
+	return errors.Join(errs...)
+}
+
+// idempotent reports whether a method only reads and may be sent again. The
+// services name all of those Get... or, for streams, Live....
+func idempotent(method string) bool {
+	return strings.HasPrefix(method, "Get") || strings.HasPrefix(method, "Live")
+}
+
+type methodName struct {
+	Service string `json:"service"`
+	Method  string `json:"method,omitempty"`
+}
+
+type retryPolicy struct {
+	MaxAttempts          int      `json:"maxAttempts"`
+	InitialBackoff       string   `json:"initialBackoff"`
+	MaxBackoff           string   `json:"maxBackoff"`
+	BackoffMultiplier    float64  `json:"backoffMultiplier"`
+	RetryableStatusCodes []string `json:"retryableStatusCodes"`
+}
+
+type methodConfig struct {
+	Name         []methodName `json:"name"`
+	WaitForReady bool         `json:"waitForReady"`
+	RetryPolicy  *retryPolicy `json:"retryPolicy,omitempty"`
+}
+
+// ServiceConfig returns the gRPC service config for desc. Every call waits
+// for the connection to be ready instead of failing while the service
+// restarts, which is safe because nothing has been sent yet. Idempotent
+// methods are also retried on UNAVAILABLE.
+func (c Config) ServiceConfig(desc grpc.ServiceDesc) string {
+	var retried []methodName
+	for _, m := range desc.Methods {
+		if idempotent(m.MethodName) {
+			retried = append(retried, methodName{desc.ServiceName, m.MethodName})
+		}
+	}
+	for _, s := range desc.Streams {
+		if idempotent(s.StreamName) {
+			retried = append(retried, methodName{desc.ServiceName, s.StreamName})
+		}
+	}
+
+	cfg := struct {
+		MethodConfig []methodConfig `json:"methodConfig"`
+	}{[]methodConfig{
+		{
+			Name:         []methodName{{Service: desc.ServiceName}},
+			WaitForReady: true,
+		},
+		{
+			Name:         retried,
+			WaitForReady: true,
+			RetryPolicy: &retryPolicy{
+				MaxAttempts:          c.RetryMaxAttempts,
+				InitialBackoff:       seconds(c.RetryInitialBackoff),
+				MaxBackoff:           seconds(c.RetryMaxBackoff),
+				BackoffMultiplier:    c.RetryBackoffMultiplier,
+				RetryableStatusCodes: []string{"UNAVAILABLE"},
+			},
+		},
+	}}
+
+	b, _ := json.Marshal(cfg)
+	return string(b)
+}
+
+// DialOptions applies the service config and a circuit breaker named after
+// the service to a client connection.
+func (c Config) DialOptions(desc grpc.ServiceDesc) []grpc.DialOption {
+	b := NewBreaker(desc.ServiceName, c.BreakerFailures, c.BreakerCooldown)
+	return []grpc.DialOption{
+		grpc.WithDefaultServiceConfig(c.ServiceConfig(desc)),
+		grpc.WithChainUnaryInterceptor(b.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(b.StreamClientInterceptor()),
+	}
+}
+
+// Backoff returns the wait before re-subscribing after attempt failures in a
+// row, with up to 20% jitter so clients don't all come back at once.
+func (c Config) Backoff(attempt int) time.Duration {
+	d := float64(c.RetryInitialBackoff)
+	for i := 0; i < attempt && d < float64(c.RetryMaxBackoff); i++ {
+		d *= c.RetryBackoffMultiplier
+	}
+	d = min(d, float64(c.RetryMaxBackoff))
+	return time.Duration(d * (0.8 + 0.2*rand.Float64()))
+}
+
+func seconds(d time.Duration) string {
+	return fmt.Sprintf("%gs", d.Seconds())
+}
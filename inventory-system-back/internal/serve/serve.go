@@ -0,0 +1,187 @@
+// Package serve holds the process plumbing every binary shares: signal
+// handling, graceful shutdown and health reporting.
+package serve
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// HealthInterval is how often RegisterHealth re-runs its check.
+const HealthInterval = 5 * time.Second
+
+// GRPCOptions holds the settings each service's ListenGRPC takes from its
+// config.
+type GRPCOptions struct {
+	Port int
+	// Drain is how long in-flight RPCs get once shutdown starts.
+	Drain time.Duration
+	// Timeout bounds unary RPCs whose caller set no shorter deadline.
+	Timeout time.Duration
+	Creds   credentials.TransportCredentials
+}
+
+// SignalContext is cancelled on SIGINT or SIGTERM.
+func SignalContext() (context.Context, context.CancelFunc) {
+	return signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+}
+
+// GRPC serves srv on port until ctx is cancelled. In-flight RPCs then get
+// drain to finish before the remaining connections are closed.
+func GRPC(ctx context.Context, srv *grpc.Server, port int, drain time.Duration) error {
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return err
+	}
+
+	errc := make(chan error, 1)
+	go func() {
+		errc <- srv.Serve(lis)
+	}()
+
+	select {
+	case err := <-errc:
+		return err
+	case <-ctx.Done():
+	}
+
+	slog.Info("Shutting down", "drain", drain.String())
+	stopped := make(chan struct{})
+	go func() {
+		srv.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(drain):
+		slog.Warn("Drain timeout reached, closing remaining connections")
+		srv.Stop()
+	}
+	return nil
+}
+
+// HTTP serves srv until ctx is cancelled and then shuts it down, giving open
+// requests drain to complete.
+func HTTP(ctx context.Context, srv *http.Server, drain time.Duration) error {
+	errc := make(chan error, 1)
+	go func() {
+		errc <- srv.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errc:
+		return err
+	case <-ctx.Done():
+	}
+
+	slog.Info("Shutting down", "drain", drain.String())
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), drain)
+	defer cancel()
+
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		slog.Warn("Drain timeout reached, closing remaining connections", "err", err)
+		return srv.Close()
+	}
+	if err := <-errc; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// TimeoutInterceptor gives each unary RPC at most d to complete.
+func TimeoutInterceptor(d time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, cancel := context.WithTimeout(ctx, d)
+		defer cancel()
+		return handler(ctx, req)
+	}
+}
+
+// RegisterHealth adds the grpc.health.v1 service to srv. The overall status and
+// that of service follow check, which is polled until ctx is cancelled; from
+// then on both report NOT_SERVING so load balancers stop routing new calls.
+func RegisterHealth(ctx context.Context, srv *grpc.Server, service string, check func(ctx context.Context) error) {
+	hs := health.NewServer()
+	healthpb.RegisterHealthServer(srv, hs)
+
+	set := func(status healthpb.HealthCheckResponse_ServingStatus) {
+		hs.SetServingStatus("", status)
+		hs.SetServingStatus(service, status)
+	}
+
+	go func() {
+		ticker := time.NewTicker(HealthInterval)
+		defer ticker.Stop()
+
+		for {
+			checkCtx, cancel := context.WithTimeout(ctx, time.Second)
+			err := check(checkCtx)
+			cancel()
+
+			if err != nil {
+				slog.Warn("Health check failed", "err", err)
+				set(healthpb.HealthCheckResponse_NOT_SERVING)
+			} else {
+				set(healthpb.HealthCheckResponse_SERVING)
+			}
+
+			select {
+			case <-ctx.Done():
+				hs.Shutdown()
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// CheckHealth asks the grpc.health.v1 service behind conn for the overall
+// status and returns an error unless it is SERVING.
+func CheckHealth(ctx context.Context, conn grpc.ClientConnInterface) error {
+	res, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		return err
+	}
+	if res.Status != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("status %s", res.Status)
+	}
+	return nil
+}
+
+// Probe checks the gRPC server on the local port and returns an exit code,
+// so images without extra tooling can use `app healthcheck` as their
+// container health check. It dials in plaintext unless opts set transport
+// credentials.
+func Probe(port int, opts ...grpc.DialOption) int {
+	opts = append([]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, opts...)
+	conn, err := grpc.NewClient(fmt.Sprintf("localhost:%d", port), opts...)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	if err := CheckHealth(ctx, conn); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	return 0
+}
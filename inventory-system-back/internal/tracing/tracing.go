@@ -0,0 +1,120 @@
+// Package tracing sets up OpenTelemetry and the instrumentation every binary
+// shares, so one trace follows a request from the GraphQL operation through
+// the gRPC hop down to the SQL statements.
+package tracing
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+
+	"github.com/XSAM/otelsql"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc/filters"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.32.0"
+	"google.golang.org/grpc"
+)
+
+// Exporters accepted by Setup.
+const (
+	OTLP   = "otlp"
+	Stdout = "stdout"
+	None   = "none"
+)
+
+// Setup installs the global tracer provider and the W3C trace context
+// propagator. OTLP exports over gRPC and reads its endpoint from the standard
+// OTEL_EXPORTER_OTLP_* variables, stdout prints spans for local debugging and
+// none only propagates. The returned func flushes pending spans.
+func Setup(ctx context.Context, service, exporter string) (func(context.Context) error, error) {
+	if err := CheckExporter(exporter); err != nil {
+		return nil, err
+	}
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	var exp sdktrace.SpanExporter
+	var err error
+	switch exporter {
+	case OTLP:
+		exp, err = otlptracegrpc.New(ctx)
+	case Stdout:
+		exp, err = stdouttrace.New()
+	default:
+		return func(context.Context) error { return nil }, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.New(ctx,
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+		resource.WithAttributes(semconv.ServiceName(service)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exp),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(tp)
+	return tp.Shutdown, nil
+}
+
+func CheckExporter(exporter string) error {
+	switch exporter {
+	case OTLP, Stdout, None, "":
+		return nil
+	}
+	return fmt.Errorf("unknown trace exporter %q, want %s, %s or %s", exporter, OTLP, Stdout, None)
+}
+
+// Health checks run every few seconds and would bury the useful traces.
+var grpcFilter = otelgrpc.WithFilter(filters.Not(filters.HealthCheck()))
+
+// ServerOption starts a span for every RPC a server handles, continuing the
+// trace of the caller.
+func ServerOption() grpc.ServerOption {
+	return grpc.StatsHandler(otelgrpc.NewServerHandler(grpcFilter))
+}
+
+// DialOption starts a span for every outgoing RPC and passes its context on
+// in the metadata.
+func DialOption() grpc.DialOption {
+	return grpc.WithStatsHandler(otelgrpc.NewClientHandler(grpcFilter))
+}
+
+// HTTPMiddleware starts a span per request, continuing the trace of the
+// caller if it sent a traceparent header.
+func HTTPMiddleware(next http.Handler) http.Handler {
+	return otelhttp.NewHandler(next, "http",
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return r.Method + " " + r.URL.Path
+		}),
+		otelhttp.WithFilter(func(r *http.Request) bool {
+			return r.URL.Path != "/healthz" && r.URL.Path != "/readyz" && r.URL.Path != "/metrics"
+		}),
+	)
+}
+
+// OpenPostgres works like sql.Open("postgres", url) but records a span for
+// each statement. Pings, row iteration and session resets are left out.
+func OpenPostgres(url string) (*sql.DB, error) {
+	return otelsql.Open("postgres", url,
+		otelsql.WithAttributes(semconv.DBSystemNamePostgreSQL),
+		otelsql.WithSpanOptions(otelsql.SpanOptions{
+			OmitConnResetSession: true,
+			OmitRows:             true,
+		}),
+	)
+}
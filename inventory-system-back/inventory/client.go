@@ -1 +1,577 @@
 package inventory
+
+import (
+	"context"
+	"time"
+
+	"github.com/jochem11/inventory-system-back/internal/logging"
+	"github.com/jochem11/inventory-system-back/internal/metrics"
+	"github.com/jochem11/inventory-system-back/internal/resilience"
+	"github.com/jochem11/inventory-system-back/internal/serve"
+	"github.com/jochem11/inventory-system-back/internal/tracing"
+	"github.com/jochem11/inventory-system-back/inventory/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+type Client struct {
+	conn    *grpc.ClientConn
+	service pb.InventoryServiceClient
+}
+
+// NewClient connects lazily, so the service doesn't have to be up yet. Calls
+// wait for it to come up within their deadline, read calls are retried and
+// policy's circuit breaker fails them fast while it stays down.
+func NewClient(url string, creds credentials.TransportCredentials, policy resilience.Config) (*Client, error) {
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		tracing.DialOption(),
+		grpc.WithChainUnaryInterceptor(logging.UnaryClientInterceptor(), metrics.GRPCClient.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(logging.StreamClientInterceptor(), metrics.GRPCClient.StreamClientInterceptor()),
+	}
+	conn, err := grpc.NewClient(url, append(opts, policy.DialOptions(pb.InventoryService_ServiceDesc)...)...)
+	if err != nil {
+		return nil, err
+	}
+	c := pb.NewInventoryServiceClient(conn)
+	return &Client{conn, c}, nil
+}
+
+func (c *Client) Close() {
+	c.conn.Close()
+}
+
+// Ready reports whether the service and its database are serving.
+func (c *Client) Ready(ctx context.Context) error {
+	return serve.CheckHealth(ctx, c.conn)
+}
+
+// --- Items ---
+
+func (c *Client) PostItem(ctx context.Context, name, assetTag, description, itemType string, locationID *string) (*Item, error) {
+	r, err := c.service.PostItem(ctx, &pb.PostItemRequest{
+		Name:        name,
+		AssetTag:    assetTag,
+		Description: description,
+		LocationId:  locationID,
+		ItemType:    itemType,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return itemFromProto(r.Item), nil
+}
+
+func (c *Client) GetItem(ctx context.Context, id string) (*Item, error) {
+	r, err := c.service.GetItem(ctx, &pb.GetItemRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	return itemFromProto(r.Item), nil
+}
+
+func (c *Client) GetItemByAssetTag(ctx context.Context, assetTag string) (*Item, error) {
+	r, err := c.service.GetItemByAssetTag(ctx, &pb.GetItemByAssetTagRequest{AssetTag: assetTag})
+	if err != nil {
+		return nil, err
+	}
+	return itemFromProto(r.Item), nil
+}
+
+func (c *Client) GetItems(ctx context.Context, skip, take uint64) ([]*Item, error) {
+	r, err := c.service.GetItems(ctx, &pb.GetItemsRequest{Skip: skip, Take: take})
+	if err != nil {
+		return nil, err
+	}
+
+	items := []*Item{}
+	for _, i := range r.Items {
+		items = append(items, itemFromProto(i))
+	}
+	return items, nil
+}
+
+func (c *Client) GetItemsByIDs(ctx context.Context, ids []string) ([]*Item, error) {
+	r, err := c.service.GetItemsByIDs(ctx, &pb.GetItemsByIDsRequest{Ids: ids})
+	if err != nil {
+		return nil, err
+	}
+
+	items := []*Item{}
+	for _, i := range r.Items {
+		items = append(items, itemFromProto(i))
+	}
+	return items, nil
+}
+
+func (c *Client) UpdateItem(ctx context.Context, id string, name, assetTag, description, itemType *string) (*Item, error) {
+	r, err := c.service.UpdateItem(ctx, &pb.UpdateItemRequest{
+		Id:          id,
+		Name:        name,
+		AssetTag:    assetTag,
+		Description: description,
+		ItemType:    itemType,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return itemFromProto(r.Item), nil
+}
+
+func (c *Client) GetItemTypeCounts(ctx context.Context, itemTypes []string) ([]*ItemTypeCount, error) {
+	r, err := c.service.GetItemTypeCounts(ctx, &pb.GetItemTypeCountsRequest{ItemTypes: itemTypes})
+	if err != nil {
+		return nil, err
+	}
+
+	counts := []*ItemTypeCount{}
+	for _, t := range r.Counts {
+		counts = append(counts, &ItemTypeCount{
+			ItemType: t.ItemType,
+			Total:    int(t.Total),
+			Lendable: int(t.Lendable),
+		})
+	}
+	return counts, nil
+}
+
+func (c *Client) DeleteItem(ctx context.Context, id string) error {
+	_, err := c.service.DeleteItem(ctx, &pb.DeleteItemRequest{Id: id})
+	return err
+}
+
+// CheckItemLendable returns nil when the item may be lent out now.
+func (c *Client) CheckItemLendable(ctx context.Context, id string) error {
+	_, err := c.service.CheckItemLendable(ctx, &pb.CheckItemLendableRequest{Id: id})
+	return err
+}
+
+func (c *Client) MoveItem(ctx context.Context, id string, locationID *string, note string) (*Item, *ItemMove, error) {
+	r, err := c.service.MoveItem(ctx, &pb.MoveItemRequest{
+		ItemId:     id,
+		LocationId: locationID,
+		Note:       note,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return itemFromProto(r.Item), itemMoveFromProto(r.Move), nil
+}
+
+func (c *Client) GetItemMoves(ctx context.Context, skip, take uint64, itemID string) ([]*ItemMove, error) {
+	r, err := c.service.GetItemMoves(ctx, &pb.GetItemMovesRequest{Skip: skip, Take: take, ItemId: itemID})
+	if err != nil {
+		return nil, err
+	}
+
+	moves := []*ItemMove{}
+	for _, m := range r.Moves {
+		moves = append(moves, itemMoveFromProto(m))
+	}
+	return moves, nil
+}
+
+func (c *Client) GetItemsInLocation(ctx context.Context, skip, take uint64, locationID string, includeSubLocations bool) ([]*Item, error) {
+	r, err := c.service.GetItemsInLocation(ctx, &pb.GetItemsInLocationRequest{
+		Skip:                skip,
+		Take:                take,
+		LocationId:          locationID,
+		IncludeSubLocations: includeSubLocations,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := []*Item{}
+	for _, i := range r.Items {
+		items = append(items, itemFromProto(i))
+	}
+	return items, nil
+}
+
+func (c *Client) GetItemsByClass(ctx context.Context, skip, take uint64, classID string) ([]*Item, error) {
+	r, err := c.service.GetItemsByClass(ctx, &pb.GetItemsByClassRequest{Skip: skip, Take: take, ClassId: classID})
+	if err != nil {
+		return nil, err
+	}
+
+	items := []*Item{}
+	for _, i := range r.Items {
+		items = append(items, itemFromProto(i))
+	}
+	return items, nil
+}
+
+// --- Locations ---
+
+func (c *Client) PostLocation(ctx context.Context, parentID *string, kind, name, code string, classID *string) (*Location, error) {
+	r, err := c.service.PostLocation(ctx, &pb.PostLocationRequest{
+		ParentId: parentID,
+		Kind:     kind,
+		Name:     name,
+		Code:     code,
+		ClassId:  classID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return locationFromProto(r.Location), nil
+}
+
+func (c *Client) GetLocation(ctx context.Context, id string) (*Location, error) {
+	r, err := c.service.GetLocation(ctx, &pb.GetLocationRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	return locationFromProto(r.Location), nil
+}
+
+func (c *Client) GetLocations(ctx context.Context, skip, take uint64, parentID, code, classID *string) ([]*Location, error) {
+	r, err := c.service.GetLocations(ctx, &pb.GetLocationsRequest{
+		Skip:     skip,
+		Take:     take,
+		ParentId: parentID,
+		Code:     code,
+		ClassId:  classID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	locations := []*Location{}
+	for _, l := range r.Locations {
+		locations = append(locations, locationFromProto(l))
+	}
+	return locations, nil
+}
+
+func (c *Client) GetLocationsByIDs(ctx context.Context, ids []string) ([]*Location, error) {
+	r, err := c.service.GetLocationsByIDs(ctx, &pb.GetLocationsByIDsRequest{Ids: ids})
+	if err != nil {
+		return nil, err
+	}
+
+	locations := []*Location{}
+	for _, l := range r.Locations {
+		locations = append(locations, locationFromProto(l))
+	}
+	return locations, nil
+}
+
+func (c *Client) UpdateLocation(ctx context.Context, id string, name, code, classID *string, clearClass bool) (*Location, error) {
+	r, err := c.service.UpdateLocation(ctx, &pb.UpdateLocationRequest{
+		Id:         id,
+		Name:       name,
+		Code:       code,
+		ClassId:    classID,
+		ClearClass: clearClass,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return locationFromProto(r.Location), nil
+}
+
+func (c *Client) DeleteLocation(ctx context.Context, id string) error {
+	_, err := c.service.DeleteLocation(ctx, &pb.DeleteLocationRequest{Id: id})
+	return err
+}
+
+// --- Maintenance ---
+
+func (c *Client) OpenMaintenanceTicket(ctx context.Context, t *MaintenanceTicket) (*MaintenanceTicket, error) {
+	r, err := c.service.OpenMaintenanceTicket(ctx, &pb.OpenMaintenanceTicketRequest{
+		ItemId:           t.ItemID,
+		ScheduleId:       t.ScheduleID,
+		Kind:             t.Kind,
+		Description:      t.Description,
+		Vendor:           t.Vendor,
+		Technician:       t.Technician,
+		ExpectedReturnAt: timeToProto(t.ExpectedReturnAt),
+		CostCents:        t.CostCents,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return maintenanceTicketFromProto(r.Ticket), nil
+}
+
+func (c *Client) GetMaintenanceTicket(ctx context.Context, id string) (*MaintenanceTicket, error) {
+	r, err := c.service.GetMaintenanceTicket(ctx, &pb.GetMaintenanceTicketRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	return maintenanceTicketFromProto(r.Ticket), nil
+}
+
+func (c *Client) GetMaintenanceTickets(ctx context.Context, skip, take uint64, itemID *string, openOnly bool) ([]*MaintenanceTicket, error) {
+	r, err := c.service.GetMaintenanceTickets(ctx, &pb.GetMaintenanceTicketsRequest{
+		Skip:     skip,
+		Take:     take,
+		ItemId:   itemID,
+		OpenOnly: openOnly,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tickets := []*MaintenanceTicket{}
+	for _, t := range r.Tickets {
+		tickets = append(tickets, maintenanceTicketFromProto(t))
+	}
+	return tickets, nil
+}
+
+func (c *Client) UpdateMaintenanceTicket(ctx context.Context, id string, status, vendor, technician *string, expectedReturnAt *time.Time, costCents *int64) (*MaintenanceTicket, error) {
+	r, err := c.service.UpdateMaintenanceTicket(ctx, &pb.UpdateMaintenanceTicketRequest{
+		Id:               id,
+		Status:           status,
+		Vendor:           vendor,
+		Technician:       technician,
+		ExpectedReturnAt: timeToProto(expectedReturnAt),
+		CostCents:        costCents,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return maintenanceTicketFromProto(r.Ticket), nil
+}
+
+func (c *Client) PostMaintenanceSchedule(ctx context.Context, itemID, description string, intervalMonths uint32, firstDueAt *time.Time) (*MaintenanceSchedule, error) {
+	r, err := c.service.PostMaintenanceSchedule(ctx, &pb.PostMaintenanceScheduleRequest{
+		ItemId:         itemID,
+		Description:    description,
+		IntervalMonths: intervalMonths,
+		FirstDueAt:     timeToProto(firstDueAt),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return maintenanceScheduleFromProto(r.Schedule), nil
+}
+
+func (c *Client) GetMaintenanceSchedules(ctx context.Context, skip, take uint64, dueBefore *time.Time) ([]*MaintenanceSchedule, error) {
+	r, err := c.service.GetMaintenanceSchedules(ctx, &pb.GetMaintenanceSchedulesRequest{
+		Skip:      skip,
+		Take:      take,
+		DueBefore: timeToProto(dueBefore),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	schedules := []*MaintenanceSchedule{}
+	for _, m := range r.Schedules {
+		schedules = append(schedules, maintenanceScheduleFromProto(m))
+	}
+	return schedules, nil
+}
+
+func (c *Client) DeleteMaintenanceSchedule(ctx context.Context, id string) error {
+	_, err := c.service.DeleteMaintenanceSchedule(ctx, &pb.DeleteMaintenanceScheduleRequest{Id: id})
+	return err
+}
+
+// --- Consumables ---
+
+func (c *Client) PostConsumable(ctx context.Context, sku, name, unit string, lowStockThreshold int64) (*Consumable, error) {
+	r, err := c.service.PostConsumable(ctx, &pb.PostConsumableRequest{
+		Sku:               sku,
+		Name:              name,
+		Unit:              unit,
+		LowStockThreshold: lowStockThreshold,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return consumableFromProto(r.Consumable), nil
+}
+
+func (c *Client) GetConsumable(ctx context.Context, id string) (*Consumable, error) {
+	r, err := c.service.GetConsumable(ctx, &pb.GetConsumableRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	return consumableFromProto(r.Consumable), nil
+}
+
+func (c *Client) GetConsumables(ctx context.Context, skip, take uint64, lowStockOnly bool) ([]*Consumable, error) {
+	r, err := c.service.GetConsumables(ctx, &pb.GetConsumablesRequest{Skip: skip, Take: take, LowStockOnly: lowStockOnly})
+	if err != nil {
+		return nil, err
+	}
+
+	consumables := []*Consumable{}
+	for _, co := range r.Consumables {
+		consumables = append(consumables, consumableFromProto(co))
+	}
+	return consumables, nil
+}
+
+func (c *Client) UpdateConsumable(ctx context.Context, id string, sku, name, unit *string, lowStockThreshold *int64) (*Consumable, error) {
+	r, err := c.service.UpdateConsumable(ctx, &pb.UpdateConsumableRequest{
+		Id:                id,
+		Sku:               sku,
+		Name:              name,
+		Unit:              unit,
+		LowStockThreshold: lowStockThreshold,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return consumableFromProto(r.Consumable), nil
+}
+
+func (c *Client) DeleteConsumable(ctx context.Context, id string) error {
+	_, err := c.service.DeleteConsumable(ctx, &pb.DeleteConsumableRequest{Id: id})
+	return err
+}
+
+func (c *Client) RecordStockMovement(ctx context.Context, consumableID, locationID, kind string, quantity int64, note string) (*StockMovement, *StockLevel, error) {
+	r, err := c.service.RecordStockMovement(ctx, &pb.RecordStockMovementRequest{
+		ConsumableId: consumableID,
+		LocationId:   locationID,
+		Kind:         kind,
+		Quantity:     quantity,
+		Note:         note,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return stockMovementFromProto(r.Movement), stockLevelFromProto(r.Level), nil
+}
+
+func (c *Client) GetStockLevels(ctx context.Context, consumableID string) ([]*StockLevel, error) {
+	r, err := c.service.GetStockLevels(ctx, &pb.GetStockLevelsRequest{ConsumableId: consumableID})
+	if err != nil {
+		return nil, err
+	}
+
+	levels := []*StockLevel{}
+	for _, l := range r.Levels {
+		levels = append(levels, stockLevelFromProto(l))
+	}
+	return levels, nil
+}
+
+func (c *Client) GetStockMovements(ctx context.Context, skip, take uint64, consumableID string) ([]*StockMovement, error) {
+	r, err := c.service.GetStockMovements(ctx, &pb.GetStockMovementsRequest{
+		Skip:         skip,
+		Take:         take,
+		ConsumableId: consumableID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	movements := []*StockMovement{}
+	for _, m := range r.Movements {
+		movements = append(movements, stockMovementFromProto(m))
+	}
+	return movements, nil
+}
+
+// --- Conversions ---
+
+func itemFromProto(i *pb.Item) *Item {
+	return &Item{
+		ID:          i.Id,
+		Name:        i.Name,
+		AssetTag:    i.AssetTag,
+		Description: i.Description,
+		Status:      i.Status,
+		LocationID:  i.LocationId,
+		CreatedAt:   i.CreatedAt.AsTime(),
+		UpdatedAt:   i.UpdatedAt.AsTime(),
+		ItemType:    i.ItemType,
+	}
+}
+
+func itemMoveFromProto(m *pb.ItemMove) *ItemMove {
+	return &ItemMove{
+		ID:             m.Id,
+		ItemID:         m.ItemId,
+		FromLocationID: m.FromLocationId,
+		ToLocationID:   m.ToLocationId,
+		Note:           m.Note,
+		MovedAt:        m.MovedAt.AsTime(),
+	}
+}
+
+func locationFromProto(l *pb.Location) *Location {
+	return &Location{
+		ID:        l.Id,
+		ParentID:  l.ParentId,
+		Kind:      l.Kind,
+		Name:      l.Name,
+		Code:      l.Code,
+		ClassID:   l.ClassId,
+		CreatedAt: l.CreatedAt.AsTime(),
+		UpdatedAt: l.UpdatedAt.AsTime(),
+	}
+}
+
+func maintenanceTicketFromProto(t *pb.MaintenanceTicket) *MaintenanceTicket {
+	return &MaintenanceTicket{
+		ID:               t.Id,
+		ItemID:           t.ItemId,
+		ScheduleID:       t.ScheduleId,
+		Kind:             t.Kind,
+		Status:           t.Status,
+		Description:      t.Description,
+		Vendor:           t.Vendor,
+		Technician:       t.Technician,
+		ExpectedReturnAt: timeFromProto(t.ExpectedReturnAt),
+		CostCents:        t.CostCents,
+		ClosedAt:         timeFromProto(t.ClosedAt),
+		CreatedAt:        t.CreatedAt.AsTime(),
+		UpdatedAt:        t.UpdatedAt.AsTime(),
+	}
+}
+
+func maintenanceScheduleFromProto(m *pb.MaintenanceSchedule) *MaintenanceSchedule {
+	return &MaintenanceSchedule{
+		ID:              m.Id,
+		ItemID:          m.ItemId,
+		Description:     m.Description,
+		IntervalMonths:  m.IntervalMonths,
+		NextDueAt:       m.NextDueAt.AsTime(),
+		LastPerformedAt: timeFromProto(m.LastPerformedAt),
+		CreatedAt:       m.CreatedAt.AsTime(),
+		UpdatedAt:       m.UpdatedAt.AsTime(),
+	}
+}
+
+func consumableFromProto(c *pb.Consumable) *Consumable {
+	return &Consumable{
+		ID:                c.Id,
+		SKU:               c.Sku,
+		Name:              c.Name,
+		Unit:              c.Unit,
+		LowStockThreshold: c.LowStockThreshold,
+		OnHand:            c.OnHand,
+		CreatedAt:         c.CreatedAt.AsTime(),
+		UpdatedAt:         c.UpdatedAt.AsTime(),
+	}
+}
+
+func stockLevelFromProto(l *pb.StockLevel) *StockLevel {
+	return &StockLevel{
+		ConsumableID: l.ConsumableId,
+		LocationID:   l.LocationId,
+		Quantity:     l.Quantity,
+		UpdatedAt:    l.UpdatedAt.AsTime(),
+	}
+}
+
+func stockMovementFromProto(m *pb.StockMovement) *StockMovement {
+	return &StockMovement{
+		ID:           m.Id,
+		ConsumableID: m.ConsumableId,
+		LocationID:   m.LocationId,
+		Kind:         m.Kind,
+		Quantity:     m.Quantity,
+		Note:         m.Note,
+		CreatedAt:    m.CreatedAt.AsTime(),
+	}
+}
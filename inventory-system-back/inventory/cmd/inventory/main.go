@@ -1 +1,125 @@
 package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jochem11/inventory-system-back/internal/config"
+	"github.com/jochem11/inventory-system-back/internal/grpctls"
+	"github.com/jochem11/inventory-system-back/internal/logging"
+	"github.com/jochem11/inventory-system-back/internal/metrics"
+	"github.com/jochem11/inventory-system-back/internal/serve"
+	"github.com/jochem11/inventory-system-back/internal/tracing"
+	"github.com/jochem11/inventory-system-back/inventory"
+	"github.com/tinrab/retry"
+	"google.golang.org/grpc"
+	"log"
+	"log/slog"
+	"os"
+	"time"
+)
+
+type Config struct {
+	Port         int           `envconfig:"PORT" default:"8080"`
+	MetricsPort  int           `envconfig:"METRICS_PORT" default:"9090"`
+	DatabaseURL  string        `envconfig:"DATABASE_URL" required:"true" secret:"true"`
+	DrainTimeout time.Duration `envconfig:"DRAIN_TIMEOUT" default:"15s"`
+	LogLevel     string        `envconfig:"LOG_LEVEL" default:"info"`
+
+	// RPCTimeout bounds each unary RPC that comes without a shorter deadline.
+	RPCTimeout time.Duration `envconfig:"RPC_TIMEOUT" default:"10s"`
+	Page       config.Paging `envconfig:"PAGE_SIZE"`
+	DB         config.DBPool `envconfig:"DB"`
+
+	// TracingExporter is otlp, stdout or none.
+	TracingExporter string `envconfig:"TRACING_EXPORTER" default:"none"`
+
+	TLS grpctls.Config `envconfig:"TLS"`
+}
+
+func (c Config) Validate() error {
+	var errs []error
+	errs = append(errs,
+		config.Port("PORT", c.Port),
+		config.Port("METRICS_PORT", c.MetricsPort),
+	)
+	if c.Port == c.MetricsPort {
+		errs = append(errs, errors.New("PORT and METRICS_PORT must differ"))
+	}
+	if _, err := logging.ParseLevel(c.LogLevel); err != nil {
+		errs = append(errs, fmt.Errorf("LOG_LEVEL: %w", err))
+	}
+	if c.DrainTimeout <= 0 || c.RPCTimeout <= 0 {
+		errs = append(errs, errors.New("DRAIN_TIMEOUT and RPC_TIMEOUT must be positive"))
+	}
+	errs = append(errs,
+		tracing.CheckExporter(c.TracingExporter),
+		c.Page.Validate(),
+		c.DB.Validate(),
+		c.TLS.Validate(),
+	)
+	return errors.Join(errs...)
+}
+
+func main() {
+	var cfg Config
+	args, err := config.Load(&cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if len(args) > 0 && args[0] == "healthcheck" {
+		creds, err := cfg.TLS.Client("inventory")
+		if err != nil {
+			log.Fatal(err)
+		}
+		os.Exit(serve.Probe(cfg.Port, grpc.WithTransportCredentials(creds), grpc.WithAuthority("inventory")))
+	}
+
+	if err := logging.Setup(cfg.LogLevel); err != nil {
+		log.Fatal(err)
+	}
+	shutdownTracing, err := tracing.Setup(context.Background(), "inventory", cfg.TracingExporter)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer shutdownTracing(context.Background())
+
+	creds, err := cfg.TLS.Server("inventory")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ctx, stop := serve.SignalContext()
+	defer stop()
+
+	var r inventory.Repository
+	retry.ForeverSleep(2*time.Second, func(_ int) (err error) {
+		r, err = inventory.NewPostgresRepository(cfg.DatabaseURL, cfg.DB)
+		if err != nil {
+			slog.Error("Connecting to database", "err", err)
+		}
+		return
+	})
+	defer r.Close()
+
+	go func() {
+		if err := metrics.Serve(ctx, cfg.MetricsPort, cfg.DrainTimeout); err != nil {
+			slog.Error("Serving metrics", "err", err)
+		}
+	}()
+
+	slog.Info("Listening", "port", cfg.Port, "metrics_port", cfg.MetricsPort)
+	s := inventory.NewInventoryService(r, cfg.Page)
+	err = inventory.ListenGRPC(ctx, s, r, serve.GRPCOptions{
+		Port:    cfg.Port,
+		Drain:   cfg.DrainTimeout,
+		Timeout: cfg.RPCTimeout,
+		Creds:   creds,
+	})
+	if err != nil {
+		r.Close()
+		log.Fatal(err)
+	}
+	slog.Info("Stopped")
+}
@@ -0,0 +1,286 @@
+package label
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"strings"
+
+	"github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/code128"
+	"github.com/boombuler/barcode/qr"
+	"github.com/go-pdf/fpdf"
+)
+
+type Symbology string
+
+const (
+	Code128 Symbology = "CODE128"
+	QR      Symbology = "QR"
+)
+
+type Format string
+
+const (
+	PNG Format = "png"
+	SVG Format = "svg"
+	PDF Format = "pdf"
+)
+
+var (
+	ErrUnknownSymbology = errors.New("unknown symbology")
+	ErrUnknownFormat    = errors.New("unknown label format")
+	ErrEmptyContent     = errors.New("nothing to encode")
+	ErrNoLabels         = errors.New("no labels to print")
+)
+
+// Label is a single sticker on a printed sheet.
+type Label struct {
+	Name     string
+	AssetTag string
+}
+
+const (
+	// Quiet zones and the height of linear symbols are in modules.
+	qrQuietZone      = 4
+	code128QuietZone = 10
+	code128Height    = 40
+
+	pngModulePixels = 4
+)
+
+// A4 sheet with 3 x 8 labels of 70 x 37 mm, all sizes in millimetres.
+const (
+	sheetColumns  = 3
+	sheetRows     = 8
+	labelWidth    = 70.0
+	labelHeight   = 37.0
+	sheetTop      = (297.0 - sheetRows*labelHeight) / 2
+	labelPadding  = 3.0
+	qrSize        = labelHeight - 2*labelPadding
+	code128Width  = labelWidth - qrSize - 3*labelPadding
+	code128Bars   = 12.0
+	labelFontSize = 8.0
+)
+
+func ParseSymbology(s string) (Symbology, error) {
+	switch Symbology(strings.ToUpper(s)) {
+	case Code128:
+		return Code128, nil
+	case QR:
+		return QR, nil
+	}
+	return "", ErrUnknownSymbology
+}
+
+func ParseFormat(s string) (Format, error) {
+	switch Format(strings.ToLower(s)) {
+	case PNG:
+		return PNG, nil
+	case SVG:
+		return SVG, nil
+	case PDF:
+		return PDF, nil
+	}
+	return "", ErrUnknownFormat
+}
+
+func ContentType(f Format) string {
+	switch f {
+	case PNG:
+		return "image/png"
+	case SVG:
+		return "image/svg+xml"
+	case PDF:
+		return "application/pdf"
+	}
+	return "application/octet-stream"
+}
+
+// symbol is an encoded barcode as dark rectangles on a module grid,
+// quiet zone included.
+type symbol struct {
+	width  int
+	height int
+	bars   []image.Rectangle
+}
+
+func encode(s Symbology, content string) (*symbol, error) {
+	if content == "" {
+		return nil, ErrEmptyContent
+	}
+
+	var (
+		bc  barcode.Barcode
+		err error
+	)
+	switch s {
+	case Code128:
+		bc, err = code128.Encode(content)
+	case QR:
+		bc, err = qr.Encode(content, qr.M, qr.Auto)
+	default:
+		return nil, ErrUnknownSymbology
+	}
+	if err != nil {
+		return nil, fmt.Errorf("encoding %q as %s: %w", content, s, err)
+	}
+
+	b := bc.Bounds()
+	sym := &symbol{}
+
+	if s == Code128 {
+		q := code128QuietZone
+		sym.width = b.Dx() + 2*q
+		sym.height = code128Height
+		for _, r := range darkRuns(bc, b.Min.Y) {
+			sym.bars = append(sym.bars, image.Rect(r.Min.X+q, 0, r.Max.X+q, code128Height))
+		}
+		return sym, nil
+	}
+
+	q := qrQuietZone
+	sym.width = b.Dx() + 2*q
+	sym.height = b.Dy() + 2*q
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for _, r := range darkRuns(bc, y) {
+			sym.bars = append(sym.bars, image.Rect(r.Min.X+q, y-b.Min.Y+q, r.Max.X+q, y-b.Min.Y+q+1))
+		}
+	}
+	return sym, nil
+}
+
+// darkRuns merges neighbouring dark modules on one row into single rectangles,
+// which keeps the SVG and PDF output small.
+func darkRuns(bc barcode.Barcode, y int) []image.Rectangle {
+	b := bc.Bounds()
+	var runs []image.Rectangle
+	start := -1
+	for x := b.Min.X; x <= b.Max.X; x++ {
+		dark := x < b.Max.X && isDark(bc.At(x, y))
+		if dark && start < 0 {
+			start = x
+		}
+		if !dark && start >= 0 {
+			runs = append(runs, image.Rect(start-b.Min.X, 0, x-b.Min.X, 1))
+			start = -1
+		}
+	}
+	return runs
+}
+
+func isDark(c color.Color) bool {
+	return color.GrayModel.Convert(c).(color.Gray).Y < 128
+}
+
+func WritePNG(w io.Writer, s Symbology, content string) error {
+	sym, err := encode(s, content)
+	if err != nil {
+		return err
+	}
+
+	img := image.NewPaletted(
+		image.Rect(0, 0, sym.width*pngModulePixels, sym.height*pngModulePixels),
+		color.Palette{color.White, color.Black},
+	)
+	for _, r := range sym.bars {
+		for y := r.Min.Y * pngModulePixels; y < r.Max.Y*pngModulePixels; y++ {
+			for x := r.Min.X * pngModulePixels; x < r.Max.X*pngModulePixels; x++ {
+				img.SetColorIndex(x, y, 1)
+			}
+		}
+	}
+	return png.Encode(w, img)
+}
+
+func WriteSVG(w io.Writer, s Symbology, content string) error {
+	sym, err := encode(s, content)
+	if err != nil {
+		return err
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 %d %d" shape-rendering="crispEdges">`, sym.width, sym.height)
+	fmt.Fprintf(&sb, `<rect width="%d" height="%d" fill="#fff"/><path fill="#000" d="`, sym.width, sym.height)
+	for _, r := range sym.bars {
+		fmt.Fprintf(&sb, "M%d %dh%dv%dh-%dz", r.Min.X, r.Min.Y, r.Dx(), r.Dy(), r.Dx())
+	}
+	sb.WriteString(`"/></svg>`)
+
+	_, err = io.WriteString(w, sb.String())
+	return err
+}
+
+// WriteSheet lays the labels out on as many A4 pages as needed. Every label
+// carries a QR code, the item name and a Code128 of the asset tag.
+func WriteSheet(w io.Writer, labels []Label) error {
+	if len(labels) == 0 {
+		return ErrNoLabels
+	}
+
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(0, 0, 0)
+	pdf.SetAutoPageBreak(false, 0)
+	pdf.SetFont("Helvetica", "", labelFontSize)
+	pdf.SetFillColor(0, 0, 0)
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	for i, l := range labels {
+		page, x, y := cell(i)
+		if page == pdf.PageCount() {
+			pdf.AddPage()
+		}
+
+		qrSym, err := encode(QR, l.AssetTag)
+		if err != nil {
+			return err
+		}
+		drawSymbol(pdf, qrSym, x+labelPadding, y+labelPadding, qrSize, qrSize)
+
+		textX := x + 2*labelPadding + qrSize
+		pdf.SetXY(textX, y+labelPadding)
+		pdf.SetFont("Helvetica", "B", labelFontSize)
+		pdf.CellFormat(code128Width, 4, tr(truncate(pdf, l.Name, code128Width)), "", 2, "L", false, 0, "")
+		pdf.SetFont("Helvetica", "", labelFontSize)
+		pdf.CellFormat(code128Width, 4, tr(l.AssetTag), "", 2, "L", false, 0, "")
+
+		barSym, err := encode(Code128, l.AssetTag)
+		if err != nil {
+			return err
+		}
+		drawSymbol(pdf, barSym, textX, y+labelHeight-labelPadding-code128Bars, code128Width, code128Bars)
+	}
+
+	return pdf.Output(w)
+}
+
+// cell places the i-th label: the page it is on, counting from 0, and the
+// top left corner of its cell on that page.
+func cell(i int) (page int, x, y float64) {
+	perPage := sheetColumns * sheetRows
+	col := i % sheetColumns
+	row := (i % perPage) / sheetColumns
+	return i / perPage, float64(col) * labelWidth, sheetTop + float64(row)*labelHeight
+}
+
+func drawSymbol(pdf *fpdf.Fpdf, sym *symbol, x, y, w, h float64) {
+	mx := w / float64(sym.width)
+	my := h / float64(sym.height)
+	for _, r := range sym.bars {
+		pdf.Rect(x+float64(r.Min.X)*mx, y+float64(r.Min.Y)*my, float64(r.Dx())*mx, float64(r.Dy())*my, "F")
+	}
+}
+
+func truncate(pdf *fpdf.Fpdf, s string, width float64) string {
+	if pdf.GetStringWidth(s) <= width {
+		return s
+	}
+	r := []rune(s)
+	for len(r) > 0 && pdf.GetStringWidth(string(r)+"...") > width {
+		r = r[:len(r)-1]
+	}
+	return string(r) + "..."
+}
@@ -0,0 +1,297 @@
+package label
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"image"
+	"image/png"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/code128"
+	"github.com/boombuler/barcode/qr"
+)
+
+// assetTag has no run of digits, so Code128 encodes it in code set B as
+// start, 6 characters, checksum and stop: 8*11 + 13 modules.
+const assetTag = "INV-A7"
+
+const (
+	code128StartB = "11010010000"
+	code128Stop   = "1100011101011"
+	// qrModules is the size of a version 1 QR code, which assetTag fits.
+	qrModules = 21
+)
+
+func TestWritePNGCode128(t *testing.T) {
+	grid := renderPNG(t, Code128)
+	width := 8*11 + 13 + 2*code128QuietZone
+	if len(grid) != code128Height || len(grid[0]) != width {
+		t.Fatalf("got %dx%d modules, want %dx%d", len(grid[0]), len(grid), width, code128Height)
+	}
+
+	row := bits(grid[0])
+	for y := range grid {
+		if bits(grid[y]) != row {
+			t.Fatalf("row %d differs from row 0, bars have to run the full height", y)
+		}
+	}
+	quiet := strings.Repeat("0", code128QuietZone)
+	if !strings.HasPrefix(row, quiet+code128StartB) {
+		t.Errorf("row starts %s, want the quiet zone and start B %s", row[:code128QuietZone+11], code128StartB)
+	}
+	if !strings.HasSuffix(row, code128Stop+quiet) {
+		t.Errorf("row ends %s, want stop %s and the quiet zone", row[len(row)-code128QuietZone-13:], code128Stop)
+	}
+
+	bc, err := code128.Encode(assetTag)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := quiet + bits(barcodeRow(bc, 0)) + quiet; row != want {
+		t.Errorf("row = %s, want %s", row, want)
+	}
+}
+
+func TestWritePNGQR(t *testing.T) {
+	grid := renderPNG(t, QR)
+	size := qrModules + 2*qrQuietZone
+	if len(grid) != size || len(grid[0]) != size {
+		t.Fatalf("got %dx%d modules, want %dx%d", len(grid[0]), len(grid), size, size)
+	}
+
+	// Finder patterns in three corners: a dark ring, a light ring and a dark
+	// 3x3 centre.
+	for _, corner := range []image.Point{{0, 0}, {qrModules - 7, 0}, {0, qrModules - 7}} {
+		for dy := range 7 {
+			for dx := range 7 {
+				ring := max(abs(dx-3), abs(dy-3))
+				want := ring != 2
+				if got := grid[qrQuietZone+corner.Y+dy][qrQuietZone+corner.X+dx]; got != want {
+					t.Errorf("finder at %v: module %d,%d dark = %v, want %v", corner, dx, dy, got, want)
+				}
+			}
+		}
+	}
+	// Timing pattern between the top finders.
+	for x := 8; x < qrModules-8; x++ {
+		if got := grid[qrQuietZone+6][qrQuietZone+x]; got != (x%2 == 0) {
+			t.Errorf("timing module %d dark = %v, want %v", x, got, x%2 == 0)
+		}
+	}
+	// The quiet zone is light all round.
+	for y := range size {
+		for x := range size {
+			inside := x >= qrQuietZone && x < size-qrQuietZone && y >= qrQuietZone && y < size-qrQuietZone
+			if !inside && grid[y][x] {
+				t.Fatalf("quiet zone module %d,%d is dark", x, y)
+			}
+		}
+	}
+
+	bc, err := qr.Encode(assetTag, qr.M, qr.Auto)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for y := range qrModules {
+		if got, want := bits(grid[qrQuietZone+y][qrQuietZone:size-qrQuietZone]), bits(barcodeRow(bc, y)); got != want {
+			t.Errorf("row %d = %s, want %s", y, got, want)
+		}
+	}
+}
+
+func TestWriteSVG(t *testing.T) {
+	for _, s := range []Symbology{Code128, QR} {
+		t.Run(string(s), func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := WriteSVG(&buf, s, assetTag); err != nil {
+				t.Fatal(err)
+			}
+			var doc struct {
+				ViewBox string `xml:"viewBox,attr"`
+				Path    struct {
+					D string `xml:"d,attr"`
+				} `xml:"path"`
+			}
+			if err := xml.Unmarshal(buf.Bytes(), &doc); err != nil {
+				t.Fatalf("not XML: %v", err)
+			}
+
+			// The SVG has to draw the same modules as the PNG.
+			want := renderPNG(t, s)
+			if wantBox := fmt.Sprintf("0 0 %d %d", len(want[0]), len(want)); doc.ViewBox != wantBox {
+				t.Fatalf("viewBox = %q, want %q", doc.ViewBox, wantBox)
+			}
+			got := make([][]bool, len(want))
+			for y := range got {
+				got[y] = make([]bool, len(want[0]))
+			}
+			rects := regexp.MustCompile(`M(\d+) (\d+)h(\d+)v(\d+)h-(\d+)z`)
+			if rest := rects.ReplaceAllString(doc.Path.D, ""); rest != "" {
+				t.Fatalf("path has more than rectangles: %q", rest)
+			}
+			for _, m := range rects.FindAllStringSubmatch(doc.Path.D, -1) {
+				x, y, w, h := atoi(t, m[1]), atoi(t, m[2]), atoi(t, m[3]), atoi(t, m[4])
+				if m[3] != m[5] {
+					t.Fatalf("rectangle %s doesn't close", m[0])
+				}
+				for yy := y; yy < y+h; yy++ {
+					for xx := x; xx < x+w; xx++ {
+						got[yy][xx] = true
+					}
+				}
+			}
+			for y := range want {
+				if bits(got[y]) != bits(want[y]) {
+					t.Errorf("row %d = %s, want %s", y, bits(got[y]), bits(want[y]))
+				}
+			}
+		})
+	}
+}
+
+func TestEncodeErrors(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WritePNG(&buf, QR, ""); !errors.Is(err, ErrEmptyContent) {
+		t.Errorf("WritePNG of nothing = %v, want ErrEmptyContent", err)
+	}
+	if err := WriteSVG(&buf, "EAN13", assetTag); !errors.Is(err, ErrUnknownSymbology) {
+		t.Errorf("WriteSVG as EAN13 = %v, want ErrUnknownSymbology", err)
+	}
+	if err := WriteSheet(&buf, nil); !errors.Is(err, ErrNoLabels) {
+		t.Errorf("WriteSheet without labels = %v, want ErrNoLabels", err)
+	}
+	if err := WriteSheet(&buf, []Label{{Name: "Microscope"}}); !errors.Is(err, ErrEmptyContent) {
+		t.Errorf("WriteSheet without an asset tag = %v, want ErrEmptyContent", err)
+	}
+}
+
+func TestCell(t *testing.T) {
+	bottom := sheetTop + (sheetRows-1)*labelHeight
+	tests := []struct {
+		i    int
+		page int
+		x, y float64
+	}{
+		{0, 0, 0, sheetTop},
+		{2, 0, 2 * labelWidth, sheetTop},
+		{3, 0, 0, sheetTop + labelHeight},
+		{23, 0, 2 * labelWidth, bottom},
+		{24, 1, 0, sheetTop},
+		{216, 9, 0, sheetTop},
+		{239, 9, 2 * labelWidth, bottom},
+	}
+	for _, tt := range tests {
+		page, x, y := cell(tt.i)
+		if page != tt.page || x != tt.x || y != tt.y {
+			t.Errorf("cell(%d) = %d, %v, %v, want %d, %v, %v", tt.i, page, x, y, tt.page, tt.x, tt.y)
+		}
+	}
+
+	// Every cell of a full request is on A4 and has a place of its own.
+	type place struct {
+		page int
+		x, y float64
+	}
+	seen := map[place]int{}
+	for i := range 240 {
+		page, x, y := cell(i)
+		if x < 0 || y < 0 || x+labelWidth > 210 || y+labelHeight > 297 {
+			t.Errorf("cell(%d) at %v, %v runs off the page", i, x, y)
+		}
+		if j, ok := seen[place{page, x, y}]; ok {
+			t.Errorf("cell(%d) and cell(%d) are both at page %d, %v, %v", j, i, page, x, y)
+		}
+		seen[place{page, x, y}] = i
+	}
+}
+
+func TestWriteSheetPages(t *testing.T) {
+	pages := regexp.MustCompile(`/Type /Page[^s]`)
+	for _, tt := range []struct{ labels, pages int }{{1, 1}, {24, 1}, {25, 2}, {240, 10}} {
+		t.Run(strconv.Itoa(tt.labels), func(t *testing.T) {
+			labels := make([]Label, tt.labels)
+			for i := range labels {
+				labels[i] = Label{Name: "Microscope " + strconv.Itoa(i), AssetTag: fmt.Sprintf("INV-%05d", i)}
+			}
+			var buf bytes.Buffer
+			if err := WriteSheet(&buf, labels); err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.HasPrefix(buf.Bytes(), []byte("%PDF-")) {
+				t.Fatalf("not a PDF: %q", buf.Bytes()[:min(buf.Len(), 16)])
+			}
+			if got := len(pages.FindAll(buf.Bytes(), -1)); got != tt.pages {
+				t.Errorf("%d labels make %d pages, want %d", tt.labels, got, tt.pages)
+			}
+		})
+	}
+}
+
+// renderPNG renders assetTag and reads the modules back from the middle of
+// each pngModulePixels square.
+func renderPNG(t *testing.T, s Symbology) [][]bool {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := WritePNG(&buf, s, assetTag); err != nil {
+		t.Fatal(err)
+	}
+	img, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatalf("not a PNG: %v", err)
+	}
+	b := img.Bounds()
+	if b.Dx()%pngModulePixels != 0 || b.Dy()%pngModulePixels != 0 {
+		t.Fatalf("%v isn't a whole number of modules", b)
+	}
+	grid := make([][]bool, b.Dy()/pngModulePixels)
+	for y := range grid {
+		grid[y] = make([]bool, b.Dx()/pngModulePixels)
+		for x := range grid[y] {
+			grid[y][x] = isDark(img.At(b.Min.X+x*pngModulePixels+pngModulePixels/2, b.Min.Y+y*pngModulePixels+pngModulePixels/2))
+		}
+	}
+	return grid
+}
+
+func barcodeRow(bc barcode.Barcode, y int) []bool {
+	b := bc.Bounds()
+	row := make([]bool, b.Dx())
+	for x := range row {
+		row[x] = isDark(bc.At(b.Min.X+x, b.Min.Y+y))
+	}
+	return row
+}
+
+func bits(row []bool) string {
+	var sb strings.Builder
+	for _, dark := range row {
+		if dark {
+			sb.WriteByte('1')
+		} else {
+			sb.WriteByte('0')
+		}
+	}
+	return sb.String()
+}
+
+func atoi(t *testing.T, s string) int {
+	t.Helper()
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
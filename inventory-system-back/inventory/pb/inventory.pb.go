@@ -0,0 +1,4805 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: inventory.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Models
+type Item struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	AssetTag    string                 `protobuf:"bytes,3,opt,name=asset_tag,json=assetTag,proto3" json:"asset_tag,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Status      string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Lendable    bool                   `protobuf:"varint,6,opt,name=lendable,proto3" json:"lendable,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	LocationId  *string                `protobuf:"bytes,9,opt,name=location_id,json=locationId,proto3,oneof" json:"location_id,omitempty"`
+	// item_type groups interchangeable items, such as "camera kit". Empty for
+	// items that aren't interchangeable.
+	ItemType      string `protobuf:"bytes,10,opt,name=item_type,json=itemType,proto3" json:"item_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Item) Reset() {
+	*x = Item{}
+	mi := &file_inventory_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Item) ProtoMessage() {}
+
+func (x *Item) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Item.ProtoReflect.Descriptor instead.
+func (*Item) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Item) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Item) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Item) GetAssetTag() string {
+	if x != nil {
+		return x.AssetTag
+	}
+	return ""
+}
+
+func (x *Item) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Item) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Item) GetLendable() bool {
+	if x != nil {
+		return x.Lendable
+	}
+	return false
+}
+
+func (x *Item) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Item) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Item) GetLocationId() string {
+	if x != nil && x.LocationId != nil {
+		return *x.LocationId
+	}
+	return ""
+}
+
+func (x *Item) GetItemType() string {
+	if x != nil {
+		return x.ItemType
+	}
+	return ""
+}
+
+// ItemTypeCount counts the items of one type, lendable ones are available
+// right now.
+type ItemTypeCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemType      string                 `protobuf:"bytes,1,opt,name=item_type,json=itemType,proto3" json:"item_type,omitempty"`
+	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Lendable      uint32                 `protobuf:"varint,3,opt,name=lendable,proto3" json:"lendable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemTypeCount) Reset() {
+	*x = ItemTypeCount{}
+	mi := &file_inventory_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemTypeCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemTypeCount) ProtoMessage() {}
+
+func (x *ItemTypeCount) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemTypeCount.ProtoReflect.Descriptor instead.
+func (*ItemTypeCount) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ItemTypeCount) GetItemType() string {
+	if x != nil {
+		return x.ItemType
+	}
+	return ""
+}
+
+func (x *ItemTypeCount) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ItemTypeCount) GetLendable() uint32 {
+	if x != nil {
+		return x.Lendable
+	}
+	return 0
+}
+
+type Location struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ParentId      *string                `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Code          string                 `protobuf:"bytes,5,opt,name=code,proto3" json:"code,omitempty"`
+	ClassId       *string                `protobuf:"bytes,6,opt,name=class_id,json=classId,proto3,oneof" json:"class_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Location) Reset() {
+	*x = Location{}
+	mi := &file_inventory_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Location) ProtoMessage() {}
+
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Location) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Location) GetParentId() string {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return ""
+}
+
+func (x *Location) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Location) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Location) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Location) GetClassId() string {
+	if x != nil && x.ClassId != nil {
+		return *x.ClassId
+	}
+	return ""
+}
+
+func (x *Location) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Location) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ItemMove struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ItemId         string                 `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	FromLocationId *string                `protobuf:"bytes,3,opt,name=from_location_id,json=fromLocationId,proto3,oneof" json:"from_location_id,omitempty"`
+	ToLocationId   *string                `protobuf:"bytes,4,opt,name=to_location_id,json=toLocationId,proto3,oneof" json:"to_location_id,omitempty"`
+	Note           string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	MovedAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=moved_at,json=movedAt,proto3" json:"moved_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ItemMove) Reset() {
+	*x = ItemMove{}
+	mi := &file_inventory_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemMove) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemMove) ProtoMessage() {}
+
+func (x *ItemMove) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemMove.ProtoReflect.Descriptor instead.
+func (*ItemMove) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ItemMove) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ItemMove) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *ItemMove) GetFromLocationId() string {
+	if x != nil && x.FromLocationId != nil {
+		return *x.FromLocationId
+	}
+	return ""
+}
+
+func (x *ItemMove) GetToLocationId() string {
+	if x != nil && x.ToLocationId != nil {
+		return *x.ToLocationId
+	}
+	return ""
+}
+
+func (x *ItemMove) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ItemMove) GetMovedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MovedAt
+	}
+	return nil
+}
+
+type MaintenanceTicket struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ItemId           string                 `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	ScheduleId       *string                `protobuf:"bytes,3,opt,name=schedule_id,json=scheduleId,proto3,oneof" json:"schedule_id,omitempty"`
+	Kind             string                 `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
+	Status           string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Description      string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Vendor           string                 `protobuf:"bytes,7,opt,name=vendor,proto3" json:"vendor,omitempty"`
+	Technician       string                 `protobuf:"bytes,8,opt,name=technician,proto3" json:"technician,omitempty"`
+	ExpectedReturnAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expected_return_at,json=expectedReturnAt,proto3,oneof" json:"expected_return_at,omitempty"`
+	CostCents        int64                  `protobuf:"varint,10,opt,name=cost_cents,json=costCents,proto3" json:"cost_cents,omitempty"`
+	ClosedAt         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=closed_at,json=closedAt,proto3,oneof" json:"closed_at,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MaintenanceTicket) Reset() {
+	*x = MaintenanceTicket{}
+	mi := &file_inventory_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaintenanceTicket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceTicket) ProtoMessage() {}
+
+func (x *MaintenanceTicket) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceTicket.ProtoReflect.Descriptor instead.
+func (*MaintenanceTicket) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MaintenanceTicket) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MaintenanceTicket) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *MaintenanceTicket) GetScheduleId() string {
+	if x != nil && x.ScheduleId != nil {
+		return *x.ScheduleId
+	}
+	return ""
+}
+
+func (x *MaintenanceTicket) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *MaintenanceTicket) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *MaintenanceTicket) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MaintenanceTicket) GetVendor() string {
+	if x != nil {
+		return x.Vendor
+	}
+	return ""
+}
+
+func (x *MaintenanceTicket) GetTechnician() string {
+	if x != nil {
+		return x.Technician
+	}
+	return ""
+}
+
+func (x *MaintenanceTicket) GetExpectedReturnAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpectedReturnAt
+	}
+	return nil
+}
+
+func (x *MaintenanceTicket) GetCostCents() int64 {
+	if x != nil {
+		return x.CostCents
+	}
+	return 0
+}
+
+func (x *MaintenanceTicket) GetClosedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return nil
+}
+
+func (x *MaintenanceTicket) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *MaintenanceTicket) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type MaintenanceSchedule struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ItemId          string                 `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Description     string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	IntervalMonths  uint32                 `protobuf:"varint,4,opt,name=interval_months,json=intervalMonths,proto3" json:"interval_months,omitempty"`
+	NextDueAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=next_due_at,json=nextDueAt,proto3" json:"next_due_at,omitempty"`
+	LastPerformedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_performed_at,json=lastPerformedAt,proto3,oneof" json:"last_performed_at,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MaintenanceSchedule) Reset() {
+	*x = MaintenanceSchedule{}
+	mi := &file_inventory_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaintenanceSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceSchedule) ProtoMessage() {}
+
+func (x *MaintenanceSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceSchedule.ProtoReflect.Descriptor instead.
+func (*MaintenanceSchedule) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MaintenanceSchedule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MaintenanceSchedule) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *MaintenanceSchedule) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MaintenanceSchedule) GetIntervalMonths() uint32 {
+	if x != nil {
+		return x.IntervalMonths
+	}
+	return 0
+}
+
+func (x *MaintenanceSchedule) GetNextDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextDueAt
+	}
+	return nil
+}
+
+func (x *MaintenanceSchedule) GetLastPerformedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastPerformedAt
+	}
+	return nil
+}
+
+func (x *MaintenanceSchedule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *MaintenanceSchedule) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type Consumable struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sku               string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Name              string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Unit              string                 `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`
+	LowStockThreshold int64                  `protobuf:"varint,5,opt,name=low_stock_threshold,json=lowStockThreshold,proto3" json:"low_stock_threshold,omitempty"`
+	OnHand            int64                  `protobuf:"varint,6,opt,name=on_hand,json=onHand,proto3" json:"on_hand,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Consumable) Reset() {
+	*x = Consumable{}
+	mi := &file_inventory_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Consumable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Consumable) ProtoMessage() {}
+
+func (x *Consumable) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Consumable.ProtoReflect.Descriptor instead.
+func (*Consumable) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Consumable) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Consumable) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *Consumable) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Consumable) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *Consumable) GetLowStockThreshold() int64 {
+	if x != nil {
+		return x.LowStockThreshold
+	}
+	return 0
+}
+
+func (x *Consumable) GetOnHand() int64 {
+	if x != nil {
+		return x.OnHand
+	}
+	return 0
+}
+
+func (x *Consumable) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Consumable) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type StockLevel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConsumableId  string                 `protobuf:"bytes,1,opt,name=consumable_id,json=consumableId,proto3" json:"consumable_id,omitempty"`
+	LocationId    string                 `protobuf:"bytes,2,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	Quantity      int64                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockLevel) Reset() {
+	*x = StockLevel{}
+	mi := &file_inventory_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockLevel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockLevel) ProtoMessage() {}
+
+func (x *StockLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockLevel.ProtoReflect.Descriptor instead.
+func (*StockLevel) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StockLevel) GetConsumableId() string {
+	if x != nil {
+		return x.ConsumableId
+	}
+	return ""
+}
+
+func (x *StockLevel) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *StockLevel) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *StockLevel) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type StockMovement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ConsumableId  string                 `protobuf:"bytes,2,opt,name=consumable_id,json=consumableId,proto3" json:"consumable_id,omitempty"`
+	LocationId    string                 `protobuf:"bytes,3,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
+	Quantity      int64                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Note          string                 `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockMovement) Reset() {
+	*x = StockMovement{}
+	mi := &file_inventory_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockMovement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockMovement) ProtoMessage() {}
+
+func (x *StockMovement) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockMovement.ProtoReflect.Descriptor instead.
+func (*StockMovement) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *StockMovement) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StockMovement) GetConsumableId() string {
+	if x != nil {
+		return x.ConsumableId
+	}
+	return ""
+}
+
+func (x *StockMovement) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *StockMovement) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *StockMovement) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *StockMovement) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *StockMovement) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Requests
+type PostItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	AssetTag      string                 `protobuf:"bytes,2,opt,name=asset_tag,json=assetTag,proto3" json:"asset_tag,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	LocationId    *string                `protobuf:"bytes,4,opt,name=location_id,json=locationId,proto3,oneof" json:"location_id,omitempty"`
+	ItemType      string                 `protobuf:"bytes,5,opt,name=item_type,json=itemType,proto3" json:"item_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostItemRequest) Reset() {
+	*x = PostItemRequest{}
+	mi := &file_inventory_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostItemRequest) ProtoMessage() {}
+
+func (x *PostItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostItemRequest.ProtoReflect.Descriptor instead.
+func (*PostItemRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PostItemRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PostItemRequest) GetAssetTag() string {
+	if x != nil {
+		return x.AssetTag
+	}
+	return ""
+}
+
+func (x *PostItemRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PostItemRequest) GetLocationId() string {
+	if x != nil && x.LocationId != nil {
+		return *x.LocationId
+	}
+	return ""
+}
+
+func (x *PostItemRequest) GetItemType() string {
+	if x != nil {
+		return x.ItemType
+	}
+	return ""
+}
+
+type GetItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetItemRequest) Reset() {
+	*x = GetItemRequest{}
+	mi := &file_inventory_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemRequest) ProtoMessage() {}
+
+func (x *GetItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemRequest.ProtoReflect.Descriptor instead.
+func (*GetItemRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetItemByAssetTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssetTag      string                 `protobuf:"bytes,1,opt,name=asset_tag,json=assetTag,proto3" json:"asset_tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetItemByAssetTagRequest) Reset() {
+	*x = GetItemByAssetTagRequest{}
+	mi := &file_inventory_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetItemByAssetTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemByAssetTagRequest) ProtoMessage() {}
+
+func (x *GetItemByAssetTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemByAssetTagRequest.ProtoReflect.Descriptor instead.
+func (*GetItemByAssetTagRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetItemByAssetTagRequest) GetAssetTag() string {
+	if x != nil {
+		return x.AssetTag
+	}
+	return ""
+}
+
+type GetItemsByIDsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetItemsByIDsRequest) Reset() {
+	*x = GetItemsByIDsRequest{}
+	mi := &file_inventory_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetItemsByIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemsByIDsRequest) ProtoMessage() {}
+
+func (x *GetItemsByIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemsByIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetItemsByIDsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetItemsByIDsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type GetItemTypeCountsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemTypes     []string               `protobuf:"bytes,1,rep,name=item_types,json=itemTypes,proto3" json:"item_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetItemTypeCountsRequest) Reset() {
+	*x = GetItemTypeCountsRequest{}
+	mi := &file_inventory_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetItemTypeCountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemTypeCountsRequest) ProtoMessage() {}
+
+func (x *GetItemTypeCountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemTypeCountsRequest.ProtoReflect.Descriptor instead.
+func (*GetItemTypeCountsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetItemTypeCountsRequest) GetItemTypes() []string {
+	if x != nil {
+		return x.ItemTypes
+	}
+	return nil
+}
+
+type GetItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skip          uint64                 `protobuf:"varint,1,opt,name=skip,proto3" json:"skip,omitempty"`
+	Take          uint64                 `protobuf:"varint,2,opt,name=take,proto3" json:"take,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetItemsRequest) Reset() {
+	*x = GetItemsRequest{}
+	mi := &file_inventory_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemsRequest) ProtoMessage() {}
+
+func (x *GetItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemsRequest.ProtoReflect.Descriptor instead.
+func (*GetItemsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetItemsRequest) GetSkip() uint64 {
+	if x != nil {
+		return x.Skip
+	}
+	return 0
+}
+
+func (x *GetItemsRequest) GetTake() uint64 {
+	if x != nil {
+		return x.Take
+	}
+	return 0
+}
+
+type UpdateItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	AssetTag      *string                `protobuf:"bytes,3,opt,name=asset_tag,json=assetTag,proto3,oneof" json:"asset_tag,omitempty"`
+	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	ItemType      *string                `protobuf:"bytes,5,opt,name=item_type,json=itemType,proto3,oneof" json:"item_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateItemRequest) Reset() {
+	*x = UpdateItemRequest{}
+	mi := &file_inventory_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateItemRequest) ProtoMessage() {}
+
+func (x *UpdateItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateItemRequest.ProtoReflect.Descriptor instead.
+func (*UpdateItemRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateItemRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateItemRequest) GetAssetTag() string {
+	if x != nil && x.AssetTag != nil {
+		return *x.AssetTag
+	}
+	return ""
+}
+
+func (x *UpdateItemRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateItemRequest) GetItemType() string {
+	if x != nil && x.ItemType != nil {
+		return *x.ItemType
+	}
+	return ""
+}
+
+type DeleteItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteItemRequest) Reset() {
+	*x = DeleteItemRequest{}
+	mi := &file_inventory_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteItemRequest) ProtoMessage() {}
+
+func (x *DeleteItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteItemRequest.ProtoReflect.Descriptor instead.
+func (*DeleteItemRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeleteItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CheckItemLendableRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckItemLendableRequest) Reset() {
+	*x = CheckItemLendableRequest{}
+	mi := &file_inventory_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckItemLendableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckItemLendableRequest) ProtoMessage() {}
+
+func (x *CheckItemLendableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckItemLendableRequest.ProtoReflect.Descriptor instead.
+func (*CheckItemLendableRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CheckItemLendableRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type MoveItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	LocationId    *string                `protobuf:"bytes,2,opt,name=location_id,json=locationId,proto3,oneof" json:"location_id,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveItemRequest) Reset() {
+	*x = MoveItemRequest{}
+	mi := &file_inventory_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveItemRequest) ProtoMessage() {}
+
+func (x *MoveItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveItemRequest.ProtoReflect.Descriptor instead.
+func (*MoveItemRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MoveItemRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *MoveItemRequest) GetLocationId() string {
+	if x != nil && x.LocationId != nil {
+		return *x.LocationId
+	}
+	return ""
+}
+
+func (x *MoveItemRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type GetItemMovesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skip          uint64                 `protobuf:"varint,1,opt,name=skip,proto3" json:"skip,omitempty"`
+	Take          uint64                 `protobuf:"varint,2,opt,name=take,proto3" json:"take,omitempty"`
+	ItemId        string                 `protobuf:"bytes,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetItemMovesRequest) Reset() {
+	*x = GetItemMovesRequest{}
+	mi := &file_inventory_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetItemMovesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemMovesRequest) ProtoMessage() {}
+
+func (x *GetItemMovesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemMovesRequest.ProtoReflect.Descriptor instead.
+func (*GetItemMovesRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetItemMovesRequest) GetSkip() uint64 {
+	if x != nil {
+		return x.Skip
+	}
+	return 0
+}
+
+func (x *GetItemMovesRequest) GetTake() uint64 {
+	if x != nil {
+		return x.Take
+	}
+	return 0
+}
+
+func (x *GetItemMovesRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+type GetItemsInLocationRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Skip                uint64                 `protobuf:"varint,1,opt,name=skip,proto3" json:"skip,omitempty"`
+	Take                uint64                 `protobuf:"varint,2,opt,name=take,proto3" json:"take,omitempty"`
+	LocationId          string                 `protobuf:"bytes,3,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	IncludeSubLocations bool                   `protobuf:"varint,4,opt,name=include_sub_locations,json=includeSubLocations,proto3" json:"include_sub_locations,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetItemsInLocationRequest) Reset() {
+	*x = GetItemsInLocationRequest{}
+	mi := &file_inventory_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetItemsInLocationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemsInLocationRequest) ProtoMessage() {}
+
+func (x *GetItemsInLocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemsInLocationRequest.ProtoReflect.Descriptor instead.
+func (*GetItemsInLocationRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetItemsInLocationRequest) GetSkip() uint64 {
+	if x != nil {
+		return x.Skip
+	}
+	return 0
+}
+
+func (x *GetItemsInLocationRequest) GetTake() uint64 {
+	if x != nil {
+		return x.Take
+	}
+	return 0
+}
+
+func (x *GetItemsInLocationRequest) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *GetItemsInLocationRequest) GetIncludeSubLocations() bool {
+	if x != nil {
+		return x.IncludeSubLocations
+	}
+	return false
+}
+
+type GetItemsByClassRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skip          uint64                 `protobuf:"varint,1,opt,name=skip,proto3" json:"skip,omitempty"`
+	Take          uint64                 `protobuf:"varint,2,opt,name=take,proto3" json:"take,omitempty"`
+	ClassId       string                 `protobuf:"bytes,3,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetItemsByClassRequest) Reset() {
+	*x = GetItemsByClassRequest{}
+	mi := &file_inventory_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetItemsByClassRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemsByClassRequest) ProtoMessage() {}
+
+func (x *GetItemsByClassRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemsByClassRequest.ProtoReflect.Descriptor instead.
+func (*GetItemsByClassRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetItemsByClassRequest) GetSkip() uint64 {
+	if x != nil {
+		return x.Skip
+	}
+	return 0
+}
+
+func (x *GetItemsByClassRequest) GetTake() uint64 {
+	if x != nil {
+		return x.Take
+	}
+	return 0
+}
+
+func (x *GetItemsByClassRequest) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+type PostLocationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParentId      *string                `protobuf:"bytes,1,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Code          string                 `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+	ClassId       *string                `protobuf:"bytes,5,opt,name=class_id,json=classId,proto3,oneof" json:"class_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostLocationRequest) Reset() {
+	*x = PostLocationRequest{}
+	mi := &file_inventory_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostLocationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostLocationRequest) ProtoMessage() {}
+
+func (x *PostLocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostLocationRequest.ProtoReflect.Descriptor instead.
+func (*PostLocationRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PostLocationRequest) GetParentId() string {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return ""
+}
+
+func (x *PostLocationRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *PostLocationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PostLocationRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *PostLocationRequest) GetClassId() string {
+	if x != nil && x.ClassId != nil {
+		return *x.ClassId
+	}
+	return ""
+}
+
+type GetLocationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLocationRequest) Reset() {
+	*x = GetLocationRequest{}
+	mi := &file_inventory_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLocationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLocationRequest) ProtoMessage() {}
+
+func (x *GetLocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLocationRequest.ProtoReflect.Descriptor instead.
+func (*GetLocationRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetLocationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetLocationsByIDsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLocationsByIDsRequest) Reset() {
+	*x = GetLocationsByIDsRequest{}
+	mi := &file_inventory_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLocationsByIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLocationsByIDsRequest) ProtoMessage() {}
+
+func (x *GetLocationsByIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLocationsByIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetLocationsByIDsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetLocationsByIDsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type GetLocationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skip          uint64                 `protobuf:"varint,1,opt,name=skip,proto3" json:"skip,omitempty"`
+	Take          uint64                 `protobuf:"varint,2,opt,name=take,proto3" json:"take,omitempty"`
+	ParentId      *string                `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	Code          *string                `protobuf:"bytes,4,opt,name=code,proto3,oneof" json:"code,omitempty"`
+	ClassId       *string                `protobuf:"bytes,5,opt,name=class_id,json=classId,proto3,oneof" json:"class_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLocationsRequest) Reset() {
+	*x = GetLocationsRequest{}
+	mi := &file_inventory_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLocationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLocationsRequest) ProtoMessage() {}
+
+func (x *GetLocationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLocationsRequest.ProtoReflect.Descriptor instead.
+func (*GetLocationsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetLocationsRequest) GetSkip() uint64 {
+	if x != nil {
+		return x.Skip
+	}
+	return 0
+}
+
+func (x *GetLocationsRequest) GetTake() uint64 {
+	if x != nil {
+		return x.Take
+	}
+	return 0
+}
+
+func (x *GetLocationsRequest) GetParentId() string {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return ""
+}
+
+func (x *GetLocationsRequest) GetCode() string {
+	if x != nil && x.Code != nil {
+		return *x.Code
+	}
+	return ""
+}
+
+func (x *GetLocationsRequest) GetClassId() string {
+	if x != nil && x.ClassId != nil {
+		return *x.ClassId
+	}
+	return ""
+}
+
+type UpdateLocationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Code          *string                `protobuf:"bytes,3,opt,name=code,proto3,oneof" json:"code,omitempty"`
+	ClassId       *string                `protobuf:"bytes,4,opt,name=class_id,json=classId,proto3,oneof" json:"class_id,omitempty"`
+	ClearClass    bool                   `protobuf:"varint,5,opt,name=clear_class,json=clearClass,proto3" json:"clear_class,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLocationRequest) Reset() {
+	*x = UpdateLocationRequest{}
+	mi := &file_inventory_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLocationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLocationRequest) ProtoMessage() {}
+
+func (x *UpdateLocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLocationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLocationRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UpdateLocationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateLocationRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateLocationRequest) GetCode() string {
+	if x != nil && x.Code != nil {
+		return *x.Code
+	}
+	return ""
+}
+
+func (x *UpdateLocationRequest) GetClassId() string {
+	if x != nil && x.ClassId != nil {
+		return *x.ClassId
+	}
+	return ""
+}
+
+func (x *UpdateLocationRequest) GetClearClass() bool {
+	if x != nil {
+		return x.ClearClass
+	}
+	return false
+}
+
+type DeleteLocationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLocationRequest) Reset() {
+	*x = DeleteLocationRequest{}
+	mi := &file_inventory_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLocationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLocationRequest) ProtoMessage() {}
+
+func (x *DeleteLocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLocationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLocationRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteLocationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type OpenMaintenanceTicketRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ItemId           string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	ScheduleId       *string                `protobuf:"bytes,2,opt,name=schedule_id,json=scheduleId,proto3,oneof" json:"schedule_id,omitempty"`
+	Kind             string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Description      string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Vendor           string                 `protobuf:"bytes,5,opt,name=vendor,proto3" json:"vendor,omitempty"`
+	Technician       string                 `protobuf:"bytes,6,opt,name=technician,proto3" json:"technician,omitempty"`
+	ExpectedReturnAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expected_return_at,json=expectedReturnAt,proto3,oneof" json:"expected_return_at,omitempty"`
+	CostCents        int64                  `protobuf:"varint,8,opt,name=cost_cents,json=costCents,proto3" json:"cost_cents,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *OpenMaintenanceTicketRequest) Reset() {
+	*x = OpenMaintenanceTicketRequest{}
+	mi := &file_inventory_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenMaintenanceTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenMaintenanceTicketRequest) ProtoMessage() {}
+
+func (x *OpenMaintenanceTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenMaintenanceTicketRequest.ProtoReflect.Descriptor instead.
+func (*OpenMaintenanceTicketRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *OpenMaintenanceTicketRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *OpenMaintenanceTicketRequest) GetScheduleId() string {
+	if x != nil && x.ScheduleId != nil {
+		return *x.ScheduleId
+	}
+	return ""
+}
+
+func (x *OpenMaintenanceTicketRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *OpenMaintenanceTicketRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *OpenMaintenanceTicketRequest) GetVendor() string {
+	if x != nil {
+		return x.Vendor
+	}
+	return ""
+}
+
+func (x *OpenMaintenanceTicketRequest) GetTechnician() string {
+	if x != nil {
+		return x.Technician
+	}
+	return ""
+}
+
+func (x *OpenMaintenanceTicketRequest) GetExpectedReturnAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpectedReturnAt
+	}
+	return nil
+}
+
+func (x *OpenMaintenanceTicketRequest) GetCostCents() int64 {
+	if x != nil {
+		return x.CostCents
+	}
+	return 0
+}
+
+type GetMaintenanceTicketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMaintenanceTicketRequest) Reset() {
+	*x = GetMaintenanceTicketRequest{}
+	mi := &file_inventory_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMaintenanceTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaintenanceTicketRequest) ProtoMessage() {}
+
+func (x *GetMaintenanceTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaintenanceTicketRequest.ProtoReflect.Descriptor instead.
+func (*GetMaintenanceTicketRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetMaintenanceTicketRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetMaintenanceTicketsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skip          uint64                 `protobuf:"varint,1,opt,name=skip,proto3" json:"skip,omitempty"`
+	Take          uint64                 `protobuf:"varint,2,opt,name=take,proto3" json:"take,omitempty"`
+	ItemId        *string                `protobuf:"bytes,3,opt,name=item_id,json=itemId,proto3,oneof" json:"item_id,omitempty"`
+	OpenOnly      bool                   `protobuf:"varint,4,opt,name=open_only,json=openOnly,proto3" json:"open_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMaintenanceTicketsRequest) Reset() {
+	*x = GetMaintenanceTicketsRequest{}
+	mi := &file_inventory_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMaintenanceTicketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaintenanceTicketsRequest) ProtoMessage() {}
+
+func (x *GetMaintenanceTicketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaintenanceTicketsRequest.ProtoReflect.Descriptor instead.
+func (*GetMaintenanceTicketsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetMaintenanceTicketsRequest) GetSkip() uint64 {
+	if x != nil {
+		return x.Skip
+	}
+	return 0
+}
+
+func (x *GetMaintenanceTicketsRequest) GetTake() uint64 {
+	if x != nil {
+		return x.Take
+	}
+	return 0
+}
+
+func (x *GetMaintenanceTicketsRequest) GetItemId() string {
+	if x != nil && x.ItemId != nil {
+		return *x.ItemId
+	}
+	return ""
+}
+
+func (x *GetMaintenanceTicketsRequest) GetOpenOnly() bool {
+	if x != nil {
+		return x.OpenOnly
+	}
+	return false
+}
+
+type UpdateMaintenanceTicketRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status           *string                `protobuf:"bytes,2,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Vendor           *string                `protobuf:"bytes,3,opt,name=vendor,proto3,oneof" json:"vendor,omitempty"`
+	Technician       *string                `protobuf:"bytes,4,opt,name=technician,proto3,oneof" json:"technician,omitempty"`
+	ExpectedReturnAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expected_return_at,json=expectedReturnAt,proto3,oneof" json:"expected_return_at,omitempty"`
+	CostCents        *int64                 `protobuf:"varint,6,opt,name=cost_cents,json=costCents,proto3,oneof" json:"cost_cents,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateMaintenanceTicketRequest) Reset() {
+	*x = UpdateMaintenanceTicketRequest{}
+	mi := &file_inventory_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMaintenanceTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMaintenanceTicketRequest) ProtoMessage() {}
+
+func (x *UpdateMaintenanceTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMaintenanceTicketRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMaintenanceTicketRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateMaintenanceTicketRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateMaintenanceTicketRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *UpdateMaintenanceTicketRequest) GetVendor() string {
+	if x != nil && x.Vendor != nil {
+		return *x.Vendor
+	}
+	return ""
+}
+
+func (x *UpdateMaintenanceTicketRequest) GetTechnician() string {
+	if x != nil && x.Technician != nil {
+		return *x.Technician
+	}
+	return ""
+}
+
+func (x *UpdateMaintenanceTicketRequest) GetExpectedReturnAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpectedReturnAt
+	}
+	return nil
+}
+
+func (x *UpdateMaintenanceTicketRequest) GetCostCents() int64 {
+	if x != nil && x.CostCents != nil {
+		return *x.CostCents
+	}
+	return 0
+}
+
+type PostMaintenanceScheduleRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ItemId         string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Description    string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	IntervalMonths uint32                 `protobuf:"varint,3,opt,name=interval_months,json=intervalMonths,proto3" json:"interval_months,omitempty"`
+	FirstDueAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=first_due_at,json=firstDueAt,proto3,oneof" json:"first_due_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PostMaintenanceScheduleRequest) Reset() {
+	*x = PostMaintenanceScheduleRequest{}
+	mi := &file_inventory_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostMaintenanceScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostMaintenanceScheduleRequest) ProtoMessage() {}
+
+func (x *PostMaintenanceScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostMaintenanceScheduleRequest.ProtoReflect.Descriptor instead.
+func (*PostMaintenanceScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PostMaintenanceScheduleRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *PostMaintenanceScheduleRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PostMaintenanceScheduleRequest) GetIntervalMonths() uint32 {
+	if x != nil {
+		return x.IntervalMonths
+	}
+	return 0
+}
+
+func (x *PostMaintenanceScheduleRequest) GetFirstDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstDueAt
+	}
+	return nil
+}
+
+type GetMaintenanceSchedulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skip          uint64                 `protobuf:"varint,1,opt,name=skip,proto3" json:"skip,omitempty"`
+	Take          uint64                 `protobuf:"varint,2,opt,name=take,proto3" json:"take,omitempty"`
+	DueBefore     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=due_before,json=dueBefore,proto3,oneof" json:"due_before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMaintenanceSchedulesRequest) Reset() {
+	*x = GetMaintenanceSchedulesRequest{}
+	mi := &file_inventory_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMaintenanceSchedulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaintenanceSchedulesRequest) ProtoMessage() {}
+
+func (x *GetMaintenanceSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaintenanceSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*GetMaintenanceSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetMaintenanceSchedulesRequest) GetSkip() uint64 {
+	if x != nil {
+		return x.Skip
+	}
+	return 0
+}
+
+func (x *GetMaintenanceSchedulesRequest) GetTake() uint64 {
+	if x != nil {
+		return x.Take
+	}
+	return 0
+}
+
+func (x *GetMaintenanceSchedulesRequest) GetDueBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueBefore
+	}
+	return nil
+}
+
+type DeleteMaintenanceScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMaintenanceScheduleRequest) Reset() {
+	*x = DeleteMaintenanceScheduleRequest{}
+	mi := &file_inventory_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMaintenanceScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMaintenanceScheduleRequest) ProtoMessage() {}
+
+func (x *DeleteMaintenanceScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMaintenanceScheduleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMaintenanceScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeleteMaintenanceScheduleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PostConsumableRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Sku               string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Unit              string                 `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`
+	LowStockThreshold int64                  `protobuf:"varint,4,opt,name=low_stock_threshold,json=lowStockThreshold,proto3" json:"low_stock_threshold,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PostConsumableRequest) Reset() {
+	*x = PostConsumableRequest{}
+	mi := &file_inventory_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostConsumableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostConsumableRequest) ProtoMessage() {}
+
+func (x *PostConsumableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostConsumableRequest.ProtoReflect.Descriptor instead.
+func (*PostConsumableRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PostConsumableRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *PostConsumableRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PostConsumableRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *PostConsumableRequest) GetLowStockThreshold() int64 {
+	if x != nil {
+		return x.LowStockThreshold
+	}
+	return 0
+}
+
+type GetConsumableRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConsumableRequest) Reset() {
+	*x = GetConsumableRequest{}
+	mi := &file_inventory_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConsumableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConsumableRequest) ProtoMessage() {}
+
+func (x *GetConsumableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConsumableRequest.ProtoReflect.Descriptor instead.
+func (*GetConsumableRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetConsumableRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetConsumablesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skip          uint64                 `protobuf:"varint,1,opt,name=skip,proto3" json:"skip,omitempty"`
+	Take          uint64                 `protobuf:"varint,2,opt,name=take,proto3" json:"take,omitempty"`
+	LowStockOnly  bool                   `protobuf:"varint,3,opt,name=low_stock_only,json=lowStockOnly,proto3" json:"low_stock_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConsumablesRequest) Reset() {
+	*x = GetConsumablesRequest{}
+	mi := &file_inventory_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConsumablesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConsumablesRequest) ProtoMessage() {}
+
+func (x *GetConsumablesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConsumablesRequest.ProtoReflect.Descriptor instead.
+func (*GetConsumablesRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetConsumablesRequest) GetSkip() uint64 {
+	if x != nil {
+		return x.Skip
+	}
+	return 0
+}
+
+func (x *GetConsumablesRequest) GetTake() uint64 {
+	if x != nil {
+		return x.Take
+	}
+	return 0
+}
+
+func (x *GetConsumablesRequest) GetLowStockOnly() bool {
+	if x != nil {
+		return x.LowStockOnly
+	}
+	return false
+}
+
+type UpdateConsumableRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sku               *string                `protobuf:"bytes,2,opt,name=sku,proto3,oneof" json:"sku,omitempty"`
+	Name              *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Unit              *string                `protobuf:"bytes,4,opt,name=unit,proto3,oneof" json:"unit,omitempty"`
+	LowStockThreshold *int64                 `protobuf:"varint,5,opt,name=low_stock_threshold,json=lowStockThreshold,proto3,oneof" json:"low_stock_threshold,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateConsumableRequest) Reset() {
+	*x = UpdateConsumableRequest{}
+	mi := &file_inventory_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateConsumableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateConsumableRequest) ProtoMessage() {}
+
+func (x *UpdateConsumableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateConsumableRequest.ProtoReflect.Descriptor instead.
+func (*UpdateConsumableRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UpdateConsumableRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateConsumableRequest) GetSku() string {
+	if x != nil && x.Sku != nil {
+		return *x.Sku
+	}
+	return ""
+}
+
+func (x *UpdateConsumableRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateConsumableRequest) GetUnit() string {
+	if x != nil && x.Unit != nil {
+		return *x.Unit
+	}
+	return ""
+}
+
+func (x *UpdateConsumableRequest) GetLowStockThreshold() int64 {
+	if x != nil && x.LowStockThreshold != nil {
+		return *x.LowStockThreshold
+	}
+	return 0
+}
+
+type DeleteConsumableRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteConsumableRequest) Reset() {
+	*x = DeleteConsumableRequest{}
+	mi := &file_inventory_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteConsumableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteConsumableRequest) ProtoMessage() {}
+
+func (x *DeleteConsumableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteConsumableRequest.ProtoReflect.Descriptor instead.
+func (*DeleteConsumableRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DeleteConsumableRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RecordStockMovementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConsumableId  string                 `protobuf:"bytes,1,opt,name=consumable_id,json=consumableId,proto3" json:"consumable_id,omitempty"`
+	LocationId    string                 `protobuf:"bytes,2,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Quantity      int64                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordStockMovementRequest) Reset() {
+	*x = RecordStockMovementRequest{}
+	mi := &file_inventory_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordStockMovementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordStockMovementRequest) ProtoMessage() {}
+
+func (x *RecordStockMovementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordStockMovementRequest.ProtoReflect.Descriptor instead.
+func (*RecordStockMovementRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RecordStockMovementRequest) GetConsumableId() string {
+	if x != nil {
+		return x.ConsumableId
+	}
+	return ""
+}
+
+func (x *RecordStockMovementRequest) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *RecordStockMovementRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *RecordStockMovementRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *RecordStockMovementRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type GetStockLevelsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConsumableId  string                 `protobuf:"bytes,1,opt,name=consumable_id,json=consumableId,proto3" json:"consumable_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStockLevelsRequest) Reset() {
+	*x = GetStockLevelsRequest{}
+	mi := &file_inventory_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStockLevelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStockLevelsRequest) ProtoMessage() {}
+
+func (x *GetStockLevelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStockLevelsRequest.ProtoReflect.Descriptor instead.
+func (*GetStockLevelsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetStockLevelsRequest) GetConsumableId() string {
+	if x != nil {
+		return x.ConsumableId
+	}
+	return ""
+}
+
+type GetStockMovementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skip          uint64                 `protobuf:"varint,1,opt,name=skip,proto3" json:"skip,omitempty"`
+	Take          uint64                 `protobuf:"varint,2,opt,name=take,proto3" json:"take,omitempty"`
+	ConsumableId  string                 `protobuf:"bytes,3,opt,name=consumable_id,json=consumableId,proto3" json:"consumable_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStockMovementsRequest) Reset() {
+	*x = GetStockMovementsRequest{}
+	mi := &file_inventory_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStockMovementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStockMovementsRequest) ProtoMessage() {}
+
+func (x *GetStockMovementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStockMovementsRequest.ProtoReflect.Descriptor instead.
+func (*GetStockMovementsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetStockMovementsRequest) GetSkip() uint64 {
+	if x != nil {
+		return x.Skip
+	}
+	return 0
+}
+
+func (x *GetStockMovementsRequest) GetTake() uint64 {
+	if x != nil {
+		return x.Take
+	}
+	return 0
+}
+
+func (x *GetStockMovementsRequest) GetConsumableId() string {
+	if x != nil {
+		return x.ConsumableId
+	}
+	return ""
+}
+
+// Responses
+type PostItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *Item                  `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostItemResponse) Reset() {
+	*x = PostItemResponse{}
+	mi := &file_inventory_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostItemResponse) ProtoMessage() {}
+
+func (x *PostItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostItemResponse.ProtoReflect.Descriptor instead.
+func (*PostItemResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PostItemResponse) GetItem() *Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type GetItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *Item                  `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetItemResponse) Reset() {
+	*x = GetItemResponse{}
+	mi := &file_inventory_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemResponse) ProtoMessage() {}
+
+func (x *GetItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemResponse.ProtoReflect.Descriptor instead.
+func (*GetItemResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetItemResponse) GetItem() *Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type GetItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Item                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetItemsResponse) Reset() {
+	*x = GetItemsResponse{}
+	mi := &file_inventory_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemsResponse) ProtoMessage() {}
+
+func (x *GetItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemsResponse.ProtoReflect.Descriptor instead.
+func (*GetItemsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetItemsResponse) GetItems() []*Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type GetItemTypeCountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Counts        []*ItemTypeCount       `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetItemTypeCountsResponse) Reset() {
+	*x = GetItemTypeCountsResponse{}
+	mi := &file_inventory_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetItemTypeCountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemTypeCountsResponse) ProtoMessage() {}
+
+func (x *GetItemTypeCountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemTypeCountsResponse.ProtoReflect.Descriptor instead.
+func (*GetItemTypeCountsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetItemTypeCountsResponse) GetCounts() []*ItemTypeCount {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+type UpdateItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *Item                  `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateItemResponse) Reset() {
+	*x = UpdateItemResponse{}
+	mi := &file_inventory_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateItemResponse) ProtoMessage() {}
+
+func (x *UpdateItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateItemResponse.ProtoReflect.Descriptor instead.
+func (*UpdateItemResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *UpdateItemResponse) GetItem() *Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type DeleteItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteItemResponse) Reset() {
+	*x = DeleteItemResponse{}
+	mi := &file_inventory_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteItemResponse) ProtoMessage() {}
+
+func (x *DeleteItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteItemResponse.ProtoReflect.Descriptor instead.
+func (*DeleteItemResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{48}
+}
+
+type CheckItemLendableResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckItemLendableResponse) Reset() {
+	*x = CheckItemLendableResponse{}
+	mi := &file_inventory_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckItemLendableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckItemLendableResponse) ProtoMessage() {}
+
+func (x *CheckItemLendableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckItemLendableResponse.ProtoReflect.Descriptor instead.
+func (*CheckItemLendableResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{49}
+}
+
+type MoveItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *Item                  `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Move          *ItemMove              `protobuf:"bytes,2,opt,name=move,proto3" json:"move,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveItemResponse) Reset() {
+	*x = MoveItemResponse{}
+	mi := &file_inventory_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveItemResponse) ProtoMessage() {}
+
+func (x *MoveItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveItemResponse.ProtoReflect.Descriptor instead.
+func (*MoveItemResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *MoveItemResponse) GetItem() *Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *MoveItemResponse) GetMove() *ItemMove {
+	if x != nil {
+		return x.Move
+	}
+	return nil
+}
+
+type GetItemMovesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Moves         []*ItemMove            `protobuf:"bytes,1,rep,name=moves,proto3" json:"moves,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetItemMovesResponse) Reset() {
+	*x = GetItemMovesResponse{}
+	mi := &file_inventory_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetItemMovesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemMovesResponse) ProtoMessage() {}
+
+func (x *GetItemMovesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemMovesResponse.ProtoReflect.Descriptor instead.
+func (*GetItemMovesResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetItemMovesResponse) GetMoves() []*ItemMove {
+	if x != nil {
+		return x.Moves
+	}
+	return nil
+}
+
+type PostLocationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Location      *Location              `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostLocationResponse) Reset() {
+	*x = PostLocationResponse{}
+	mi := &file_inventory_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostLocationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostLocationResponse) ProtoMessage() {}
+
+func (x *PostLocationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostLocationResponse.ProtoReflect.Descriptor instead.
+func (*PostLocationResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *PostLocationResponse) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type GetLocationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Location      *Location              `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLocationResponse) Reset() {
+	*x = GetLocationResponse{}
+	mi := &file_inventory_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLocationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLocationResponse) ProtoMessage() {}
+
+func (x *GetLocationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLocationResponse.ProtoReflect.Descriptor instead.
+func (*GetLocationResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetLocationResponse) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type GetLocationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locations     []*Location            `protobuf:"bytes,1,rep,name=locations,proto3" json:"locations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLocationsResponse) Reset() {
+	*x = GetLocationsResponse{}
+	mi := &file_inventory_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLocationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLocationsResponse) ProtoMessage() {}
+
+func (x *GetLocationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLocationsResponse.ProtoReflect.Descriptor instead.
+func (*GetLocationsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetLocationsResponse) GetLocations() []*Location {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+type UpdateLocationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Location      *Location              `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLocationResponse) Reset() {
+	*x = UpdateLocationResponse{}
+	mi := &file_inventory_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLocationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLocationResponse) ProtoMessage() {}
+
+func (x *UpdateLocationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLocationResponse.ProtoReflect.Descriptor instead.
+func (*UpdateLocationResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *UpdateLocationResponse) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type DeleteLocationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLocationResponse) Reset() {
+	*x = DeleteLocationResponse{}
+	mi := &file_inventory_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLocationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLocationResponse) ProtoMessage() {}
+
+func (x *DeleteLocationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLocationResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLocationResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{56}
+}
+
+type OpenMaintenanceTicketResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ticket        *MaintenanceTicket     `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenMaintenanceTicketResponse) Reset() {
+	*x = OpenMaintenanceTicketResponse{}
+	mi := &file_inventory_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenMaintenanceTicketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenMaintenanceTicketResponse) ProtoMessage() {}
+
+func (x *OpenMaintenanceTicketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenMaintenanceTicketResponse.ProtoReflect.Descriptor instead.
+func (*OpenMaintenanceTicketResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *OpenMaintenanceTicketResponse) GetTicket() *MaintenanceTicket {
+	if x != nil {
+		return x.Ticket
+	}
+	return nil
+}
+
+type GetMaintenanceTicketResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ticket        *MaintenanceTicket     `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMaintenanceTicketResponse) Reset() {
+	*x = GetMaintenanceTicketResponse{}
+	mi := &file_inventory_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMaintenanceTicketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaintenanceTicketResponse) ProtoMessage() {}
+
+func (x *GetMaintenanceTicketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaintenanceTicketResponse.ProtoReflect.Descriptor instead.
+func (*GetMaintenanceTicketResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetMaintenanceTicketResponse) GetTicket() *MaintenanceTicket {
+	if x != nil {
+		return x.Ticket
+	}
+	return nil
+}
+
+type GetMaintenanceTicketsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tickets       []*MaintenanceTicket   `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMaintenanceTicketsResponse) Reset() {
+	*x = GetMaintenanceTicketsResponse{}
+	mi := &file_inventory_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMaintenanceTicketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaintenanceTicketsResponse) ProtoMessage() {}
+
+func (x *GetMaintenanceTicketsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaintenanceTicketsResponse.ProtoReflect.Descriptor instead.
+func (*GetMaintenanceTicketsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetMaintenanceTicketsResponse) GetTickets() []*MaintenanceTicket {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+type UpdateMaintenanceTicketResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ticket        *MaintenanceTicket     `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMaintenanceTicketResponse) Reset() {
+	*x = UpdateMaintenanceTicketResponse{}
+	mi := &file_inventory_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMaintenanceTicketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMaintenanceTicketResponse) ProtoMessage() {}
+
+func (x *UpdateMaintenanceTicketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMaintenanceTicketResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMaintenanceTicketResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *UpdateMaintenanceTicketResponse) GetTicket() *MaintenanceTicket {
+	if x != nil {
+		return x.Ticket
+	}
+	return nil
+}
+
+type PostMaintenanceScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *MaintenanceSchedule   `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostMaintenanceScheduleResponse) Reset() {
+	*x = PostMaintenanceScheduleResponse{}
+	mi := &file_inventory_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostMaintenanceScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostMaintenanceScheduleResponse) ProtoMessage() {}
+
+func (x *PostMaintenanceScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostMaintenanceScheduleResponse.ProtoReflect.Descriptor instead.
+func (*PostMaintenanceScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *PostMaintenanceScheduleResponse) GetSchedule() *MaintenanceSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type GetMaintenanceSchedulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedules     []*MaintenanceSchedule `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMaintenanceSchedulesResponse) Reset() {
+	*x = GetMaintenanceSchedulesResponse{}
+	mi := &file_inventory_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMaintenanceSchedulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaintenanceSchedulesResponse) ProtoMessage() {}
+
+func (x *GetMaintenanceSchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaintenanceSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*GetMaintenanceSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetMaintenanceSchedulesResponse) GetSchedules() []*MaintenanceSchedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+type DeleteMaintenanceScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMaintenanceScheduleResponse) Reset() {
+	*x = DeleteMaintenanceScheduleResponse{}
+	mi := &file_inventory_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMaintenanceScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMaintenanceScheduleResponse) ProtoMessage() {}
+
+func (x *DeleteMaintenanceScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMaintenanceScheduleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMaintenanceScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{63}
+}
+
+type PostConsumableResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Consumable    *Consumable            `protobuf:"bytes,1,opt,name=consumable,proto3" json:"consumable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostConsumableResponse) Reset() {
+	*x = PostConsumableResponse{}
+	mi := &file_inventory_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostConsumableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostConsumableResponse) ProtoMessage() {}
+
+func (x *PostConsumableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostConsumableResponse.ProtoReflect.Descriptor instead.
+func (*PostConsumableResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *PostConsumableResponse) GetConsumable() *Consumable {
+	if x != nil {
+		return x.Consumable
+	}
+	return nil
+}
+
+type GetConsumableResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Consumable    *Consumable            `protobuf:"bytes,1,opt,name=consumable,proto3" json:"consumable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConsumableResponse) Reset() {
+	*x = GetConsumableResponse{}
+	mi := &file_inventory_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConsumableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConsumableResponse) ProtoMessage() {}
+
+func (x *GetConsumableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConsumableResponse.ProtoReflect.Descriptor instead.
+func (*GetConsumableResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetConsumableResponse) GetConsumable() *Consumable {
+	if x != nil {
+		return x.Consumable
+	}
+	return nil
+}
+
+type GetConsumablesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Consumables   []*Consumable          `protobuf:"bytes,1,rep,name=consumables,proto3" json:"consumables,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConsumablesResponse) Reset() {
+	*x = GetConsumablesResponse{}
+	mi := &file_inventory_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConsumablesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConsumablesResponse) ProtoMessage() {}
+
+func (x *GetConsumablesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConsumablesResponse.ProtoReflect.Descriptor instead.
+func (*GetConsumablesResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetConsumablesResponse) GetConsumables() []*Consumable {
+	if x != nil {
+		return x.Consumables
+	}
+	return nil
+}
+
+type UpdateConsumableResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Consumable    *Consumable            `protobuf:"bytes,1,opt,name=consumable,proto3" json:"consumable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateConsumableResponse) Reset() {
+	*x = UpdateConsumableResponse{}
+	mi := &file_inventory_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateConsumableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateConsumableResponse) ProtoMessage() {}
+
+func (x *UpdateConsumableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateConsumableResponse.ProtoReflect.Descriptor instead.
+func (*UpdateConsumableResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *UpdateConsumableResponse) GetConsumable() *Consumable {
+	if x != nil {
+		return x.Consumable
+	}
+	return nil
+}
+
+type DeleteConsumableResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteConsumableResponse) Reset() {
+	*x = DeleteConsumableResponse{}
+	mi := &file_inventory_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteConsumableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteConsumableResponse) ProtoMessage() {}
+
+func (x *DeleteConsumableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteConsumableResponse.ProtoReflect.Descriptor instead.
+func (*DeleteConsumableResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{68}
+}
+
+type RecordStockMovementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Movement      *StockMovement         `protobuf:"bytes,1,opt,name=movement,proto3" json:"movement,omitempty"`
+	Level         *StockLevel            `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordStockMovementResponse) Reset() {
+	*x = RecordStockMovementResponse{}
+	mi := &file_inventory_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordStockMovementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordStockMovementResponse) ProtoMessage() {}
+
+func (x *RecordStockMovementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordStockMovementResponse.ProtoReflect.Descriptor instead.
+func (*RecordStockMovementResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *RecordStockMovementResponse) GetMovement() *StockMovement {
+	if x != nil {
+		return x.Movement
+	}
+	return nil
+}
+
+func (x *RecordStockMovementResponse) GetLevel() *StockLevel {
+	if x != nil {
+		return x.Level
+	}
+	return nil
+}
+
+type GetStockLevelsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Levels        []*StockLevel          `protobuf:"bytes,1,rep,name=levels,proto3" json:"levels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStockLevelsResponse) Reset() {
+	*x = GetStockLevelsResponse{}
+	mi := &file_inventory_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStockLevelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStockLevelsResponse) ProtoMessage() {}
+
+func (x *GetStockLevelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStockLevelsResponse.ProtoReflect.Descriptor instead.
+func (*GetStockLevelsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetStockLevelsResponse) GetLevels() []*StockLevel {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+type GetStockMovementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Movements     []*StockMovement       `protobuf:"bytes,1,rep,name=movements,proto3" json:"movements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStockMovementsResponse) Reset() {
+	*x = GetStockMovementsResponse{}
+	mi := &file_inventory_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStockMovementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStockMovementsResponse) ProtoMessage() {}
+
+func (x *GetStockMovementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStockMovementsResponse.ProtoReflect.Descriptor instead.
+func (*GetStockMovementsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetStockMovementsResponse) GetMovements() []*StockMovement {
+	if x != nil {
+		return x.Movements
+	}
+	return nil
+}
+
+var File_inventory_proto protoreflect.FileDescriptor
+
+const file_inventory_proto_rawDesc = "" +
+	"\n" +
+	"\x0finventory.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe6\x02\n" +
+	"\x04Item\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tasset_tag\x18\x03 \x01(\tR\bassetTag\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1a\n" +
+	"\blendable\x18\x06 \x01(\bR\blendable\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12$\n" +
+	"\vlocation_id\x18\t \x01(\tH\x00R\n" +
+	"locationId\x88\x01\x01\x12\x1b\n" +
+	"\titem_type\x18\n" +
+	" \x01(\tR\bitemTypeB\x0e\n" +
+	"\f_location_id\"^\n" +
+	"\rItemTypeCount\x12\x1b\n" +
+	"\titem_type\x18\x01 \x01(\tR\bitemType\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\rR\x05total\x12\x1a\n" +
+	"\blendable\x18\x03 \x01(\rR\blendable\"\xa9\x02\n" +
+	"\bLocation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
+	"\tparent_id\x18\x02 \x01(\tH\x00R\bparentId\x88\x01\x01\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x12\n" +
+	"\x04code\x18\x05 \x01(\tR\x04code\x12\x1e\n" +
+	"\bclass_id\x18\x06 \x01(\tH\x01R\aclassId\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\f\n" +
+	"\n" +
+	"_parent_idB\v\n" +
+	"\t_class_id\"\x80\x02\n" +
+	"\bItemMove\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\tR\x06itemId\x12-\n" +
+	"\x10from_location_id\x18\x03 \x01(\tH\x00R\x0efromLocationId\x88\x01\x01\x12)\n" +
+	"\x0eto_location_id\x18\x04 \x01(\tH\x01R\ftoLocationId\x88\x01\x01\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\x125\n" +
+	"\bmoved_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\amovedAtB\x13\n" +
+	"\x11_from_location_idB\x11\n" +
+	"\x0f_to_location_id\"\xbf\x04\n" +
+	"\x11MaintenanceTicket\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\tR\x06itemId\x12$\n" +
+	"\vschedule_id\x18\x03 \x01(\tH\x00R\n" +
+	"scheduleId\x88\x01\x01\x12\x12\n" +
+	"\x04kind\x18\x04 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06vendor\x18\a \x01(\tR\x06vendor\x12\x1e\n" +
+	"\n" +
+	"technician\x18\b \x01(\tR\n" +
+	"technician\x12M\n" +
+	"\x12expected_return_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x10expectedReturnAt\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"cost_cents\x18\n" +
+	" \x01(\x03R\tcostCents\x12<\n" +
+	"\tclosed_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampH\x02R\bclosedAt\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x0e\n" +
+	"\f_schedule_idB\x15\n" +
+	"\x13_expected_return_atB\f\n" +
+	"\n" +
+	"_closed_at\"\x9e\x03\n" +
+	"\x13MaintenanceSchedule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\tR\x06itemId\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12'\n" +
+	"\x0finterval_months\x18\x04 \x01(\rR\x0eintervalMonths\x12:\n" +
+	"\vnext_due_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tnextDueAt\x12K\n" +
+	"\x11last_performed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x0flastPerformedAt\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x14\n" +
+	"\x12_last_performed_at\"\x95\x02\n" +
+	"\n" +
+	"Consumable\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04unit\x18\x04 \x01(\tR\x04unit\x12.\n" +
+	"\x13low_stock_threshold\x18\x05 \x01(\x03R\x11lowStockThreshold\x12\x17\n" +
+	"\aon_hand\x18\x06 \x01(\x03R\x06onHand\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa9\x01\n" +
+	"\n" +
+	"StockLevel\x12#\n" +
+	"\rconsumable_id\x18\x01 \x01(\tR\fconsumableId\x12\x1f\n" +
+	"\vlocation_id\x18\x02 \x01(\tR\n" +
+	"locationId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x03R\bquantity\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xe4\x01\n" +
+	"\rStockMovement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rconsumable_id\x18\x02 \x01(\tR\fconsumableId\x12\x1f\n" +
+	"\vlocation_id\x18\x03 \x01(\tR\n" +
+	"locationId\x12\x12\n" +
+	"\x04kind\x18\x04 \x01(\tR\x04kind\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x03R\bquantity\x12\x12\n" +
+	"\x04note\x18\x06 \x01(\tR\x04note\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xb7\x01\n" +
+	"\x0fPostItemRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\tasset_tag\x18\x02 \x01(\tR\bassetTag\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12$\n" +
+	"\vlocation_id\x18\x04 \x01(\tH\x00R\n" +
+	"locationId\x88\x01\x01\x12\x1b\n" +
+	"\titem_type\x18\x05 \x01(\tR\bitemTypeB\x0e\n" +
+	"\f_location_id\" \n" +
+	"\x0eGetItemRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"7\n" +
+	"\x18GetItemByAssetTagRequest\x12\x1b\n" +
+	"\tasset_tag\x18\x01 \x01(\tR\bassetTag\"(\n" +
+	"\x14GetItemsByIDsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"9\n" +
+	"\x18GetItemTypeCountsRequest\x12\x1d\n" +
+	"\n" +
+	"item_types\x18\x01 \x03(\tR\titemTypes\"9\n" +
+	"\x0fGetItemsRequest\x12\x12\n" +
+	"\x04skip\x18\x01 \x01(\x04R\x04skip\x12\x12\n" +
+	"\x04take\x18\x02 \x01(\x04R\x04take\"\xdc\x01\n" +
+	"\x11UpdateItemRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12 \n" +
+	"\tasset_tag\x18\x03 \x01(\tH\x01R\bassetTag\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x02R\vdescription\x88\x01\x01\x12 \n" +
+	"\titem_type\x18\x05 \x01(\tH\x03R\bitemType\x88\x01\x01B\a\n" +
+	"\x05_nameB\f\n" +
+	"\n" +
+	"_asset_tagB\x0e\n" +
+	"\f_descriptionB\f\n" +
+	"\n" +
+	"_item_type\"#\n" +
+	"\x11DeleteItemRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"*\n" +
+	"\x18CheckItemLendableRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"t\n" +
+	"\x0fMoveItemRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12$\n" +
+	"\vlocation_id\x18\x02 \x01(\tH\x00R\n" +
+	"locationId\x88\x01\x01\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04noteB\x0e\n" +
+	"\f_location_id\"V\n" +
+	"\x13GetItemMovesRequest\x12\x12\n" +
+	"\x04skip\x18\x01 \x01(\x04R\x04skip\x12\x12\n" +
+	"\x04take\x18\x02 \x01(\x04R\x04take\x12\x17\n" +
+	"\aitem_id\x18\x03 \x01(\tR\x06itemId\"\x98\x01\n" +
+	"\x19GetItemsInLocationRequest\x12\x12\n" +
+	"\x04skip\x18\x01 \x01(\x04R\x04skip\x12\x12\n" +
+	"\x04take\x18\x02 \x01(\x04R\x04take\x12\x1f\n" +
+	"\vlocation_id\x18\x03 \x01(\tR\n" +
+	"locationId\x122\n" +
+	"\x15include_sub_locations\x18\x04 \x01(\bR\x13includeSubLocations\"[\n" +
+	"\x16GetItemsByClassRequest\x12\x12\n" +
+	"\x04skip\x18\x01 \x01(\x04R\x04skip\x12\x12\n" +
+	"\x04take\x18\x02 \x01(\x04R\x04take\x12\x19\n" +
+	"\bclass_id\x18\x03 \x01(\tR\aclassId\"\xae\x01\n" +
+	"\x13PostLocationRequest\x12 \n" +
+	"\tparent_id\x18\x01 \x01(\tH\x00R\bparentId\x88\x01\x01\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04code\x18\x04 \x01(\tR\x04code\x12\x1e\n" +
+	"\bclass_id\x18\x05 \x01(\tH\x01R\aclassId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_parent_idB\v\n" +
+	"\t_class_id\"$\n" +
+	"\x12GetLocationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\",\n" +
+	"\x18GetLocationsByIDsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"\xbc\x01\n" +
+	"\x13GetLocationsRequest\x12\x12\n" +
+	"\x04skip\x18\x01 \x01(\x04R\x04skip\x12\x12\n" +
+	"\x04take\x18\x02 \x01(\x04R\x04take\x12 \n" +
+	"\tparent_id\x18\x03 \x01(\tH\x00R\bparentId\x88\x01\x01\x12\x17\n" +
+	"\x04code\x18\x04 \x01(\tH\x01R\x04code\x88\x01\x01\x12\x1e\n" +
+	"\bclass_id\x18\x05 \x01(\tH\x02R\aclassId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_parent_idB\a\n" +
+	"\x05_codeB\v\n" +
+	"\t_class_id\"\xb9\x01\n" +
+	"\x15UpdateLocationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x17\n" +
+	"\x04code\x18\x03 \x01(\tH\x01R\x04code\x88\x01\x01\x12\x1e\n" +
+	"\bclass_id\x18\x04 \x01(\tH\x02R\aclassId\x88\x01\x01\x12\x1f\n" +
+	"\vclear_class\x18\x05 \x01(\bR\n" +
+	"clearClassB\a\n" +
+	"\x05_nameB\a\n" +
+	"\x05_codeB\v\n" +
+	"\t_class_id\"'\n" +
+	"\x15DeleteLocationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xe0\x02\n" +
+	"\x1cOpenMaintenanceTicketRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12$\n" +
+	"\vschedule_id\x18\x02 \x01(\tH\x00R\n" +
+	"scheduleId\x88\x01\x01\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06vendor\x18\x05 \x01(\tR\x06vendor\x12\x1e\n" +
+	"\n" +
+	"technician\x18\x06 \x01(\tR\n" +
+	"technician\x12M\n" +
+	"\x12expected_return_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x10expectedReturnAt\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"cost_cents\x18\b \x01(\x03R\tcostCentsB\x0e\n" +
+	"\f_schedule_idB\x15\n" +
+	"\x13_expected_return_at\"-\n" +
+	"\x1bGetMaintenanceTicketRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x8d\x01\n" +
+	"\x1cGetMaintenanceTicketsRequest\x12\x12\n" +
+	"\x04skip\x18\x01 \x01(\x04R\x04skip\x12\x12\n" +
+	"\x04take\x18\x02 \x01(\x04R\x04take\x12\x1c\n" +
+	"\aitem_id\x18\x03 \x01(\tH\x00R\x06itemId\x88\x01\x01\x12\x1b\n" +
+	"\topen_only\x18\x04 \x01(\bR\bopenOnlyB\n" +
+	"\n" +
+	"\b_item_id\"\xcd\x02\n" +
+	"\x1eUpdateMaintenanceTicketRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\x06status\x18\x02 \x01(\tH\x00R\x06status\x88\x01\x01\x12\x1b\n" +
+	"\x06vendor\x18\x03 \x01(\tH\x01R\x06vendor\x88\x01\x01\x12#\n" +
+	"\n" +
+	"technician\x18\x04 \x01(\tH\x02R\n" +
+	"technician\x88\x01\x01\x12M\n" +
+	"\x12expected_return_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x03R\x10expectedReturnAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"cost_cents\x18\x06 \x01(\x03H\x04R\tcostCents\x88\x01\x01B\t\n" +
+	"\a_statusB\t\n" +
+	"\a_vendorB\r\n" +
+	"\v_technicianB\x15\n" +
+	"\x13_expected_return_atB\r\n" +
+	"\v_cost_cents\"\xd8\x01\n" +
+	"\x1ePostMaintenanceScheduleRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12'\n" +
+	"\x0finterval_months\x18\x03 \x01(\rR\x0eintervalMonths\x12A\n" +
+	"\ffirst_due_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"firstDueAt\x88\x01\x01B\x0f\n" +
+	"\r_first_due_at\"\x97\x01\n" +
+	"\x1eGetMaintenanceSchedulesRequest\x12\x12\n" +
+	"\x04skip\x18\x01 \x01(\x04R\x04skip\x12\x12\n" +
+	"\x04take\x18\x02 \x01(\x04R\x04take\x12>\n" +
+	"\n" +
+	"due_before\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tdueBefore\x88\x01\x01B\r\n" +
+	"\v_due_before\"2\n" +
+	" DeleteMaintenanceScheduleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x81\x01\n" +
+	"\x15PostConsumableRequest\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04unit\x18\x03 \x01(\tR\x04unit\x12.\n" +
+	"\x13low_stock_threshold\x18\x04 \x01(\x03R\x11lowStockThreshold\"&\n" +
+	"\x14GetConsumableRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"e\n" +
+	"\x15GetConsumablesRequest\x12\x12\n" +
+	"\x04skip\x18\x01 \x01(\x04R\x04skip\x12\x12\n" +
+	"\x04take\x18\x02 \x01(\x04R\x04take\x12$\n" +
+	"\x0elow_stock_only\x18\x03 \x01(\bR\flowStockOnly\"\xd9\x01\n" +
+	"\x17UpdateConsumableRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
+	"\x03sku\x18\x02 \x01(\tH\x00R\x03sku\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x01R\x04name\x88\x01\x01\x12\x17\n" +
+	"\x04unit\x18\x04 \x01(\tH\x02R\x04unit\x88\x01\x01\x123\n" +
+	"\x13low_stock_threshold\x18\x05 \x01(\x03H\x03R\x11lowStockThreshold\x88\x01\x01B\x06\n" +
+	"\x04_skuB\a\n" +
+	"\x05_nameB\a\n" +
+	"\x05_unitB\x16\n" +
+	"\x14_low_stock_threshold\")\n" +
+	"\x17DeleteConsumableRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xa6\x01\n" +
+	"\x1aRecordStockMovementRequest\x12#\n" +
+	"\rconsumable_id\x18\x01 \x01(\tR\fconsumableId\x12\x1f\n" +
+	"\vlocation_id\x18\x02 \x01(\tR\n" +
+	"locationId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x03R\bquantity\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\"<\n" +
+	"\x15GetStockLevelsRequest\x12#\n" +
+	"\rconsumable_id\x18\x01 \x01(\tR\fconsumableId\"g\n" +
+	"\x18GetStockMovementsRequest\x12\x12\n" +
+	"\x04skip\x18\x01 \x01(\x04R\x04skip\x12\x12\n" +
+	"\x04take\x18\x02 \x01(\x04R\x04take\x12#\n" +
+	"\rconsumable_id\x18\x03 \x01(\tR\fconsumableId\"0\n" +
+	"\x10PostItemResponse\x12\x1c\n" +
+	"\x04item\x18\x01 \x01(\v2\b.pb.ItemR\x04item\"/\n" +
+	"\x0fGetItemResponse\x12\x1c\n" +
+	"\x04item\x18\x01 \x01(\v2\b.pb.ItemR\x04item\"2\n" +
+	"\x10GetItemsResponse\x12\x1e\n" +
+	"\x05items\x18\x01 \x03(\v2\b.pb.ItemR\x05items\"F\n" +
+	"\x19GetItemTypeCountsResponse\x12)\n" +
+	"\x06counts\x18\x01 \x03(\v2\x11.pb.ItemTypeCountR\x06counts\"2\n" +
+	"\x12UpdateItemResponse\x12\x1c\n" +
+	"\x04item\x18\x01 \x01(\v2\b.pb.ItemR\x04item\"\x14\n" +
+	"\x12DeleteItemResponse\"\x1b\n" +
+	"\x19CheckItemLendableResponse\"R\n" +
+	"\x10MoveItemResponse\x12\x1c\n" +
+	"\x04item\x18\x01 \x01(\v2\b.pb.ItemR\x04item\x12 \n" +
+	"\x04move\x18\x02 \x01(\v2\f.pb.ItemMoveR\x04move\":\n" +
+	"\x14GetItemMovesResponse\x12\"\n" +
+	"\x05moves\x18\x01 \x03(\v2\f.pb.ItemMoveR\x05moves\"@\n" +
+	"\x14PostLocationResponse\x12(\n" +
+	"\blocation\x18\x01 \x01(\v2\f.pb.LocationR\blocation\"?\n" +
+	"\x13GetLocationResponse\x12(\n" +
+	"\blocation\x18\x01 \x01(\v2\f.pb.LocationR\blocation\"B\n" +
+	"\x14GetLocationsResponse\x12*\n" +
+	"\tlocations\x18\x01 \x03(\v2\f.pb.LocationR\tlocations\"B\n" +
+	"\x16UpdateLocationResponse\x12(\n" +
+	"\blocation\x18\x01 \x01(\v2\f.pb.LocationR\blocation\"\x18\n" +
+	"\x16DeleteLocationResponse\"N\n" +
+	"\x1dOpenMaintenanceTicketResponse\x12-\n" +
+	"\x06ticket\x18\x01 \x01(\v2\x15.pb.MaintenanceTicketR\x06ticket\"M\n" +
+	"\x1cGetMaintenanceTicketResponse\x12-\n" +
+	"\x06ticket\x18\x01 \x01(\v2\x15.pb.MaintenanceTicketR\x06ticket\"P\n" +
+	"\x1dGetMaintenanceTicketsResponse\x12/\n" +
+	"\atickets\x18\x01 \x03(\v2\x15.pb.MaintenanceTicketR\atickets\"P\n" +
+	"\x1fUpdateMaintenanceTicketResponse\x12-\n" +
+	"\x06ticket\x18\x01 \x01(\v2\x15.pb.MaintenanceTicketR\x06ticket\"V\n" +
+	"\x1fPostMaintenanceScheduleResponse\x123\n" +
+	"\bschedule\x18\x01 \x01(\v2\x17.pb.MaintenanceScheduleR\bschedule\"X\n" +
+	"\x1fGetMaintenanceSchedulesResponse\x125\n" +
+	"\tschedules\x18\x01 \x03(\v2\x17.pb.MaintenanceScheduleR\tschedules\"#\n" +
+	"!DeleteMaintenanceScheduleResponse\"H\n" +
+	"\x16PostConsumableResponse\x12.\n" +
+	"\n" +
+	"consumable\x18\x01 \x01(\v2\x0e.pb.ConsumableR\n" +
+	"consumable\"G\n" +
+	"\x15GetConsumableResponse\x12.\n" +
+	"\n" +
+	"consumable\x18\x01 \x01(\v2\x0e.pb.ConsumableR\n" +
+	"consumable\"J\n" +
+	"\x16GetConsumablesResponse\x120\n" +
+	"\vconsumables\x18\x01 \x03(\v2\x0e.pb.ConsumableR\vconsumables\"J\n" +
+	"\x18UpdateConsumableResponse\x12.\n" +
+	"\n" +
+	"consumable\x18\x01 \x01(\v2\x0e.pb.ConsumableR\n" +
+	"consumable\"\x1a\n" +
+	"\x18DeleteConsumableResponse\"r\n" +
+	"\x1bRecordStockMovementResponse\x12-\n" +
+	"\bmovement\x18\x01 \x01(\v2\x11.pb.StockMovementR\bmovement\x12$\n" +
+	"\x05level\x18\x02 \x01(\v2\x0e.pb.StockLevelR\x05level\"@\n" +
+	"\x16GetStockLevelsResponse\x12&\n" +
+	"\x06levels\x18\x01 \x03(\v2\x0e.pb.StockLevelR\x06levels\"L\n" +
+	"\x19GetStockMovementsResponse\x12/\n" +
+	"\tmovements\x18\x01 \x03(\v2\x11.pb.StockMovementR\tmovements2\xa0\x14\n" +
+	"\x10InventoryService\x125\n" +
+	"\bPostItem\x12\x13.pb.PostItemRequest\x1a\x14.pb.PostItemResponse\x122\n" +
+	"\aGetItem\x12\x12.pb.GetItemRequest\x1a\x13.pb.GetItemResponse\x12F\n" +
+	"\x11GetItemByAssetTag\x12\x1c.pb.GetItemByAssetTagRequest\x1a\x13.pb.GetItemResponse\x125\n" +
+	"\bGetItems\x12\x13.pb.GetItemsRequest\x1a\x14.pb.GetItemsResponse\x12?\n" +
+	"\rGetItemsByIDs\x12\x18.pb.GetItemsByIDsRequest\x1a\x14.pb.GetItemsResponse\x12;\n" +
+	"\n" +
+	"UpdateItem\x12\x15.pb.UpdateItemRequest\x1a\x16.pb.UpdateItemResponse\x12;\n" +
+	"\n" +
+	"DeleteItem\x12\x15.pb.DeleteItemRequest\x1a\x16.pb.DeleteItemResponse\x12P\n" +
+	"\x11CheckItemLendable\x12\x1c.pb.CheckItemLendableRequest\x1a\x1d.pb.CheckItemLendableResponse\x125\n" +
+	"\bMoveItem\x12\x13.pb.MoveItemRequest\x1a\x14.pb.MoveItemResponse\x12A\n" +
+	"\fGetItemMoves\x12\x17.pb.GetItemMovesRequest\x1a\x18.pb.GetItemMovesResponse\x12I\n" +
+	"\x12GetItemsInLocation\x12\x1d.pb.GetItemsInLocationRequest\x1a\x14.pb.GetItemsResponse\x12C\n" +
+	"\x0fGetItemsByClass\x12\x1a.pb.GetItemsByClassRequest\x1a\x14.pb.GetItemsResponse\x12P\n" +
+	"\x11GetItemTypeCounts\x12\x1c.pb.GetItemTypeCountsRequest\x1a\x1d.pb.GetItemTypeCountsResponse\x12A\n" +
+	"\fPostLocation\x12\x17.pb.PostLocationRequest\x1a\x18.pb.PostLocationResponse\x12>\n" +
+	"\vGetLocation\x12\x16.pb.GetLocationRequest\x1a\x17.pb.GetLocationResponse\x12A\n" +
+	"\fGetLocations\x12\x17.pb.GetLocationsRequest\x1a\x18.pb.GetLocationsResponse\x12K\n" +
+	"\x11GetLocationsByIDs\x12\x1c.pb.GetLocationsByIDsRequest\x1a\x18.pb.GetLocationsResponse\x12G\n" +
+	"\x0eUpdateLocation\x12\x19.pb.UpdateLocationRequest\x1a\x1a.pb.UpdateLocationResponse\x12G\n" +
+	"\x0eDeleteLocation\x12\x19.pb.DeleteLocationRequest\x1a\x1a.pb.DeleteLocationResponse\x12\\\n" +
+	"\x15OpenMaintenanceTicket\x12 .pb.OpenMaintenanceTicketRequest\x1a!.pb.OpenMaintenanceTicketResponse\x12Y\n" +
+	"\x14GetMaintenanceTicket\x12\x1f.pb.GetMaintenanceTicketRequest\x1a .pb.GetMaintenanceTicketResponse\x12\\\n" +
+	"\x15GetMaintenanceTickets\x12 .pb.GetMaintenanceTicketsRequest\x1a!.pb.GetMaintenanceTicketsResponse\x12b\n" +
+	"\x17UpdateMaintenanceTicket\x12\".pb.UpdateMaintenanceTicketRequest\x1a#.pb.UpdateMaintenanceTicketResponse\x12b\n" +
+	"\x17PostMaintenanceSchedule\x12\".pb.PostMaintenanceScheduleRequest\x1a#.pb.PostMaintenanceScheduleResponse\x12b\n" +
+	"\x17GetMaintenanceSchedules\x12\".pb.GetMaintenanceSchedulesRequest\x1a#.pb.GetMaintenanceSchedulesResponse\x12h\n" +
+	"\x19DeleteMaintenanceSchedule\x12$.pb.DeleteMaintenanceScheduleRequest\x1a%.pb.DeleteMaintenanceScheduleResponse\x12G\n" +
+	"\x0ePostConsumable\x12\x19.pb.PostConsumableRequest\x1a\x1a.pb.PostConsumableResponse\x12D\n" +
+	"\rGetConsumable\x12\x18.pb.GetConsumableRequest\x1a\x19.pb.GetConsumableResponse\x12G\n" +
+	"\x0eGetConsumables\x12\x19.pb.GetConsumablesRequest\x1a\x1a.pb.GetConsumablesResponse\x12M\n" +
+	"\x10UpdateConsumable\x12\x1b.pb.UpdateConsumableRequest\x1a\x1c.pb.UpdateConsumableResponse\x12M\n" +
+	"\x10DeleteConsumable\x12\x1b.pb.DeleteConsumableRequest\x1a\x1c.pb.DeleteConsumableResponse\x12V\n" +
+	"\x13RecordStockMovement\x12\x1e.pb.RecordStockMovementRequest\x1a\x1f.pb.RecordStockMovementResponse\x12G\n" +
+	"\x0eGetStockLevels\x12\x19.pb.GetStockLevelsRequest\x1a\x1a.pb.GetStockLevelsResponse\x12P\n" +
+	"\x11GetStockMovements\x12\x1c.pb.GetStockMovementsRequest\x1a\x1d.pb.GetStockMovementsResponseB8Z6github.com/jochem11/inventory-system-back/inventory/pbb\x06proto3"
+
+var (
+	file_inventory_proto_rawDescOnce sync.Once
+	file_inventory_proto_rawDescData []byte
+)
+
+func file_inventory_proto_rawDescGZIP() []byte {
+	file_inventory_proto_rawDescOnce.Do(func() {
+		file_inventory_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_inventory_proto_rawDesc), len(file_inventory_proto_rawDesc)))
+	})
+	return file_inventory_proto_rawDescData
+}
+
+var file_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 72)
+var file_inventory_proto_goTypes = []any{
+	(*Item)(nil),                              // 0: pb.Item
+	(*ItemTypeCount)(nil),                     // 1: pb.ItemTypeCount
+	(*Location)(nil),                          // 2: pb.Location
+	(*ItemMove)(nil),                          // 3: pb.ItemMove
+	(*MaintenanceTicket)(nil),                 // 4: pb.MaintenanceTicket
+	(*MaintenanceSchedule)(nil),               // 5: pb.MaintenanceSchedule
+	(*Consumable)(nil),                        // 6: pb.Consumable
+	(*StockLevel)(nil),                        // 7: pb.StockLevel
+	(*StockMovement)(nil),                     // 8: pb.StockMovement
+	(*PostItemRequest)(nil),                   // 9: pb.PostItemRequest
+	(*GetItemRequest)(nil),                    // 10: pb.GetItemRequest
+	(*GetItemByAssetTagRequest)(nil),          // 11: pb.GetItemByAssetTagRequest
+	(*GetItemsByIDsRequest)(nil),              // 12: pb.GetItemsByIDsRequest
+	(*GetItemTypeCountsRequest)(nil),          // 13: pb.GetItemTypeCountsRequest
+	(*GetItemsRequest)(nil),                   // 14: pb.GetItemsRequest
+	(*UpdateItemRequest)(nil),                 // 15: pb.UpdateItemRequest
+	(*DeleteItemRequest)(nil),                 // 16: pb.DeleteItemRequest
+	(*CheckItemLendableRequest)(nil),          // 17: pb.CheckItemLendableRequest
+	(*MoveItemRequest)(nil),                   // 18: pb.MoveItemRequest
+	(*GetItemMovesRequest)(nil),               // 19: pb.GetItemMovesRequest
+	(*GetItemsInLocationRequest)(nil),         // 20: pb.GetItemsInLocationRequest
+	(*GetItemsByClassRequest)(nil),            // 21: pb.GetItemsByClassRequest
+	(*PostLocationRequest)(nil),               // 22: pb.PostLocationRequest
+	(*GetLocationRequest)(nil),                // 23: pb.GetLocationRequest
+	(*GetLocationsByIDsRequest)(nil),          // 24: pb.GetLocationsByIDsRequest
+	(*GetLocationsRequest)(nil),               // 25: pb.GetLocationsRequest
+	(*UpdateLocationRequest)(nil),             // 26: pb.UpdateLocationRequest
+	(*DeleteLocationRequest)(nil),             // 27: pb.DeleteLocationRequest
+	(*OpenMaintenanceTicketRequest)(nil),      // 28: pb.OpenMaintenanceTicketRequest
+	(*GetMaintenanceTicketRequest)(nil),       // 29: pb.GetMaintenanceTicketRequest
+	(*GetMaintenanceTicketsRequest)(nil),      // 30: pb.GetMaintenanceTicketsRequest
+	(*UpdateMaintenanceTicketRequest)(nil),    // 31: pb.UpdateMaintenanceTicketRequest
+	(*PostMaintenanceScheduleRequest)(nil),    // 32: pb.PostMaintenanceScheduleRequest
+	(*GetMaintenanceSchedulesRequest)(nil),    // 33: pb.GetMaintenanceSchedulesRequest
+	(*DeleteMaintenanceScheduleRequest)(nil),  // 34: pb.DeleteMaintenanceScheduleRequest
+	(*PostConsumableRequest)(nil),             // 35: pb.PostConsumableRequest
+	(*GetConsumableRequest)(nil),              // 36: pb.GetConsumableRequest
+	(*GetConsumablesRequest)(nil),             // 37: pb.GetConsumablesRequest
+	(*UpdateConsumableRequest)(nil),           // 38: pb.UpdateConsumableRequest
+	(*DeleteConsumableRequest)(nil),           // 39: pb.DeleteConsumableRequest
+	(*RecordStockMovementRequest)(nil),        // 40: pb.RecordStockMovementRequest
+	(*GetStockLevelsRequest)(nil),             // 41: pb.GetStockLevelsRequest
+	(*GetStockMovementsRequest)(nil),          // 42: pb.GetStockMovementsRequest
+	(*PostItemResponse)(nil),                  // 43: pb.PostItemResponse
+	(*GetItemResponse)(nil),                   // 44: pb.GetItemResponse
+	(*GetItemsResponse)(nil),                  // 45: pb.GetItemsResponse
+	(*GetItemTypeCountsResponse)(nil),         // 46: pb.GetItemTypeCountsResponse
+	(*UpdateItemResponse)(nil),                // 47: pb.UpdateItemResponse
+	(*DeleteItemResponse)(nil),                // 48: pb.DeleteItemResponse
+	(*CheckItemLendableResponse)(nil),         // 49: pb.CheckItemLendableResponse
+	(*MoveItemResponse)(nil),                  // 50: pb.MoveItemResponse
+	(*GetItemMovesResponse)(nil),              // 51: pb.GetItemMovesResponse
+	(*PostLocationResponse)(nil),              // 52: pb.PostLocationResponse
+	(*GetLocationResponse)(nil),               // 53: pb.GetLocationResponse
+	(*GetLocationsResponse)(nil),              // 54: pb.GetLocationsResponse
+	(*UpdateLocationResponse)(nil),            // 55: pb.UpdateLocationResponse
+	(*DeleteLocationResponse)(nil),            // 56: pb.DeleteLocationResponse
+	(*OpenMaintenanceTicketResponse)(nil),     // 57: pb.OpenMaintenanceTicketResponse
+	(*GetMaintenanceTicketResponse)(nil),      // 58: pb.GetMaintenanceTicketResponse
+	(*GetMaintenanceTicketsResponse)(nil),     // 59: pb.GetMaintenanceTicketsResponse
+	(*UpdateMaintenanceTicketResponse)(nil),   // 60: pb.UpdateMaintenanceTicketResponse
+	(*PostMaintenanceScheduleResponse)(nil),   // 61: pb.PostMaintenanceScheduleResponse
+	(*GetMaintenanceSchedulesResponse)(nil),   // 62: pb.GetMaintenanceSchedulesResponse
+	(*DeleteMaintenanceScheduleResponse)(nil), // 63: pb.DeleteMaintenanceScheduleResponse
+	(*PostConsumableResponse)(nil),            // 64: pb.PostConsumableResponse
+	(*GetConsumableResponse)(nil),             // 65: pb.GetConsumableResponse
+	(*GetConsumablesResponse)(nil),            // 66: pb.GetConsumablesResponse
+	(*UpdateConsumableResponse)(nil),          // 67: pb.UpdateConsumableResponse
+	(*DeleteConsumableResponse)(nil),          // 68: pb.DeleteConsumableResponse
+	(*RecordStockMovementResponse)(nil),       // 69: pb.RecordStockMovementResponse
+	(*GetStockLevelsResponse)(nil),            // 70: pb.GetStockLevelsResponse
+	(*GetStockMovementsResponse)(nil),         // 71: pb.GetStockMovementsResponse
+	(*timestamppb.Timestamp)(nil),             // 72: google.protobuf.Timestamp
+}
+var file_inventory_proto_depIdxs = []int32{
+	72, // 0: pb.Item.created_at:type_name -> google.protobuf.Timestamp
+	72, // 1: pb.Item.updated_at:type_name -> google.protobuf.Timestamp
+	72, // 2: pb.Location.created_at:type_name -> google.protobuf.Timestamp
+	72, // 3: pb.Location.updated_at:type_name -> google.protobuf.Timestamp
+	72, // 4: pb.ItemMove.moved_at:type_name -> google.protobuf.Timestamp
+	72, // 5: pb.MaintenanceTicket.expected_return_at:type_name -> google.protobuf.Timestamp
+	72, // 6: pb.MaintenanceTicket.closed_at:type_name -> google.protobuf.Timestamp
+	72, // 7: pb.MaintenanceTicket.created_at:type_name -> google.protobuf.Timestamp
+	72, // 8: pb.MaintenanceTicket.updated_at:type_name -> google.protobuf.Timestamp
+	72, // 9: pb.MaintenanceSchedule.next_due_at:type_name -> google.protobuf.Timestamp
+	72, // 10: pb.MaintenanceSchedule.last_performed_at:type_name -> google.protobuf.Timestamp
+	72, // 11: pb.MaintenanceSchedule.created_at:type_name -> google.protobuf.Timestamp
+	72, // 12: pb.MaintenanceSchedule.updated_at:type_name -> google.protobuf.Timestamp
+	72, // 13: pb.Consumable.created_at:type_name -> google.protobuf.Timestamp
+	72, // 14: pb.Consumable.updated_at:type_name -> google.protobuf.Timestamp
+	72, // 15: pb.StockLevel.updated_at:type_name -> google.protobuf.Timestamp
+	72, // 16: pb.StockMovement.created_at:type_name -> google.protobuf.Timestamp
+	72, // 17: pb.OpenMaintenanceTicketRequest.expected_return_at:type_name -> google.protobuf.Timestamp
+	72, // 18: pb.UpdateMaintenanceTicketRequest.expected_return_at:type_name -> google.protobuf.Timestamp
+	72, // 19: pb.PostMaintenanceScheduleRequest.first_due_at:type_name -> google.protobuf.Timestamp
+	72, // 20: pb.GetMaintenanceSchedulesRequest.due_before:type_name -> google.protobuf.Timestamp
+	0,  // 21: pb.PostItemResponse.item:type_name -> pb.Item
+	0,  // 22: pb.GetItemResponse.item:type_name -> pb.Item
+	0,  // 23: pb.GetItemsResponse.items:type_name -> pb.Item
+	1,  // 24: pb.GetItemTypeCountsResponse.counts:type_name -> pb.ItemTypeCount
+	0,  // 25: pb.UpdateItemResponse.item:type_name -> pb.Item
+	0,  // 26: pb.MoveItemResponse.item:type_name -> pb.Item
+	3,  // 27: pb.MoveItemResponse.move:type_name -> pb.ItemMove
+	3,  // 28: pb.GetItemMovesResponse.moves:type_name -> pb.ItemMove
+	2,  // 29: pb.PostLocationResponse.location:type_name -> pb.Location
+	2,  // 30: pb.GetLocationResponse.location:type_name -> pb.Location
+	2,  // 31: pb.GetLocationsResponse.locations:type_name -> pb.Location
+	2,  // 32: pb.UpdateLocationResponse.location:type_name -> pb.Location
+	4,  // 33: pb.OpenMaintenanceTicketResponse.ticket:type_name -> pb.MaintenanceTicket
+	4,  // 34: pb.GetMaintenanceTicketResponse.ticket:type_name -> pb.MaintenanceTicket
+	4,  // 35: pb.GetMaintenanceTicketsResponse.tickets:type_name -> pb.MaintenanceTicket
+	4,  // 36: pb.UpdateMaintenanceTicketResponse.ticket:type_name -> pb.MaintenanceTicket
+	5,  // 37: pb.PostMaintenanceScheduleResponse.schedule:type_name -> pb.MaintenanceSchedule
+	5,  // 38: pb.GetMaintenanceSchedulesResponse.schedules:type_name -> pb.MaintenanceSchedule
+	6,  // 39: pb.PostConsumableResponse.consumable:type_name -> pb.Consumable
+	6,  // 40: pb.GetConsumableResponse.consumable:type_name -> pb.Consumable
+	6,  // 41: pb.GetConsumablesResponse.consumables:type_name -> pb.Consumable
+	6,  // 42: pb.UpdateConsumableResponse.consumable:type_name -> pb.Consumable
+	8,  // 43: pb.RecordStockMovementResponse.movement:type_name -> pb.StockMovement
+	7,  // 44: pb.RecordStockMovementResponse.level:type_name -> pb.StockLevel
+	7,  // 45: pb.GetStockLevelsResponse.levels:type_name -> pb.StockLevel
+	8,  // 46: pb.GetStockMovementsResponse.movements:type_name -> pb.StockMovement
+	9,  // 47: pb.InventoryService.PostItem:input_type -> pb.PostItemRequest
+	10, // 48: pb.InventoryService.GetItem:input_type -> pb.GetItemRequest
+	11, // 49: pb.InventoryService.GetItemByAssetTag:input_type -> pb.GetItemByAssetTagRequest
+	14, // 50: pb.InventoryService.GetItems:input_type -> pb.GetItemsRequest
+	12, // 51: pb.InventoryService.GetItemsByIDs:input_type -> pb.GetItemsByIDsRequest
+	15, // 52: pb.InventoryService.UpdateItem:input_type -> pb.UpdateItemRequest
+	16, // 53: pb.InventoryService.DeleteItem:input_type -> pb.DeleteItemRequest
+	17, // 54: pb.InventoryService.CheckItemLendable:input_type -> pb.CheckItemLendableRequest
+	18, // 55: pb.InventoryService.MoveItem:input_type -> pb.MoveItemRequest
+	19, // 56: pb.InventoryService.GetItemMoves:input_type -> pb.GetItemMovesRequest
+	20, // 57: pb.InventoryService.GetItemsInLocation:input_type -> pb.GetItemsInLocationRequest
+	21, // 58: pb.InventoryService.GetItemsByClass:input_type -> pb.GetItemsByClassRequest
+	13, // 59: pb.InventoryService.GetItemTypeCounts:input_type -> pb.GetItemTypeCountsRequest
+	22, // 60: pb.InventoryService.PostLocation:input_type -> pb.PostLocationRequest
+	23, // 61: pb.InventoryService.GetLocation:input_type -> pb.GetLocationRequest
+	25, // 62: pb.InventoryService.GetLocations:input_type -> pb.GetLocationsRequest
+	24, // 63: pb.InventoryService.GetLocationsByIDs:input_type -> pb.GetLocationsByIDsRequest
+	26, // 64: pb.InventoryService.UpdateLocation:input_type -> pb.UpdateLocationRequest
+	27, // 65: pb.InventoryService.DeleteLocation:input_type -> pb.DeleteLocationRequest
+	28, // 66: pb.InventoryService.OpenMaintenanceTicket:input_type -> pb.OpenMaintenanceTicketRequest
+	29, // 67: pb.InventoryService.GetMaintenanceTicket:input_type -> pb.GetMaintenanceTicketRequest
+	30, // 68: pb.InventoryService.GetMaintenanceTickets:input_type -> pb.GetMaintenanceTicketsRequest
+	31, // 69: pb.InventoryService.UpdateMaintenanceTicket:input_type -> pb.UpdateMaintenanceTicketRequest
+	32, // 70: pb.InventoryService.PostMaintenanceSchedule:input_type -> pb.PostMaintenanceScheduleRequest
+	33, // 71: pb.InventoryService.GetMaintenanceSchedules:input_type -> pb.GetMaintenanceSchedulesRequest
+	34, // 72: pb.InventoryService.DeleteMaintenanceSchedule:input_type -> pb.DeleteMaintenanceScheduleRequest
+	35, // 73: pb.InventoryService.PostConsumable:input_type -> pb.PostConsumableRequest
+	36, // 74: pb.InventoryService.GetConsumable:input_type -> pb.GetConsumableRequest
+	37, // 75: pb.InventoryService.GetConsumables:input_type -> pb.GetConsumablesRequest
+	38, // 76: pb.InventoryService.UpdateConsumable:input_type -> pb.UpdateConsumableRequest
+	39, // 77: pb.InventoryService.DeleteConsumable:input_type -> pb.DeleteConsumableRequest
+	40, // 78: pb.InventoryService.RecordStockMovement:input_type -> pb.RecordStockMovementRequest
+	41, // 79: pb.InventoryService.GetStockLevels:input_type -> pb.GetStockLevelsRequest
+	42, // 80: pb.InventoryService.GetStockMovements:input_type -> pb.GetStockMovementsRequest
+	43, // 81: pb.InventoryService.PostItem:output_type -> pb.PostItemResponse
+	44, // 82: pb.InventoryService.GetItem:output_type -> pb.GetItemResponse
+	44, // 83: pb.InventoryService.GetItemByAssetTag:output_type -> pb.GetItemResponse
+	45, // 84: pb.InventoryService.GetItems:output_type -> pb.GetItemsResponse
+	45, // 85: pb.InventoryService.GetItemsByIDs:output_type -> pb.GetItemsResponse
+	47, // 86: pb.InventoryService.UpdateItem:output_type -> pb.UpdateItemResponse
+	48, // 87: pb.InventoryService.DeleteItem:output_type -> pb.DeleteItemResponse
+	49, // 88: pb.InventoryService.CheckItemLendable:output_type -> pb.CheckItemLendableResponse
+	50, // 89: pb.InventoryService.MoveItem:output_type -> pb.MoveItemResponse
+	51, // 90: pb.InventoryService.GetItemMoves:output_type -> pb.GetItemMovesResponse
+	45, // 91: pb.InventoryService.GetItemsInLocation:output_type -> pb.GetItemsResponse
+	45, // 92: pb.InventoryService.GetItemsByClass:output_type -> pb.GetItemsResponse
+	46, // 93: pb.InventoryService.GetItemTypeCounts:output_type -> pb.GetItemTypeCountsResponse
+	52, // 94: pb.InventoryService.PostLocation:output_type -> pb.PostLocationResponse
+	53, // 95: pb.InventoryService.GetLocation:output_type -> pb.GetLocationResponse
+	54, // 96: pb.InventoryService.GetLocations:output_type -> pb.GetLocationsResponse
+	54, // 97: pb.InventoryService.GetLocationsByIDs:output_type -> pb.GetLocationsResponse
+	55, // 98: pb.InventoryService.UpdateLocation:output_type -> pb.UpdateLocationResponse
+	56, // 99: pb.InventoryService.DeleteLocation:output_type -> pb.DeleteLocationResponse
+	57, // 100: pb.InventoryService.OpenMaintenanceTicket:output_type -> pb.OpenMaintenanceTicketResponse
+	58, // 101: pb.InventoryService.GetMaintenanceTicket:output_type -> pb.GetMaintenanceTicketResponse
+	59, // 102: pb.InventoryService.GetMaintenanceTickets:output_type -> pb.GetMaintenanceTicketsResponse
+	60, // 103: pb.InventoryService.UpdateMaintenanceTicket:output_type -> pb.UpdateMaintenanceTicketResponse
+	61, // 104: pb.InventoryService.PostMaintenanceSchedule:output_type -> pb.PostMaintenanceScheduleResponse
+	62, // 105: pb.InventoryService.GetMaintenanceSchedules:output_type -> pb.GetMaintenanceSchedulesResponse
+	63, // 106: pb.InventoryService.DeleteMaintenanceSchedule:output_type -> pb.DeleteMaintenanceScheduleResponse
+	64, // 107: pb.InventoryService.PostConsumable:output_type -> pb.PostConsumableResponse
+	65, // 108: pb.InventoryService.GetConsumable:output_type -> pb.GetConsumableResponse
+	66, // 109: pb.InventoryService.GetConsumables:output_type -> pb.GetConsumablesResponse
+	67, // 110: pb.InventoryService.UpdateConsumable:output_type -> pb.UpdateConsumableResponse
+	68, // 111: pb.InventoryService.DeleteConsumable:output_type -> pb.DeleteConsumableResponse
+	69, // 112: pb.InventoryService.RecordStockMovement:output_type -> pb.RecordStockMovementResponse
+	70, // 113: pb.InventoryService.GetStockLevels:output_type -> pb.GetStockLevelsResponse
+	71, // 114: pb.InventoryService.GetStockMovements:output_type -> pb.GetStockMovementsResponse
+	81, // [81:115] is the sub-list for method output_type
+	47, // [47:81] is the sub-list for method input_type
+	47, // [47:47] is the sub-list for extension type_name
+	47, // [47:47] is the sub-list for extension extendee
+	0,  // [0:47] is the sub-list for field type_name
+}
+
+func init() { file_inventory_proto_init() }
+func file_inventory_proto_init() {
+	if File_inventory_proto != nil {
+		return
+	}
+	file_inventory_proto_msgTypes[0].OneofWrappers = []any{}
+	file_inventory_proto_msgTypes[2].OneofWrappers = []any{}
+	file_inventory_proto_msgTypes[3].OneofWrappers = []any{}
+	file_inventory_proto_msgTypes[4].OneofWrappers = []any{}
+	file_inventory_proto_msgTypes[5].OneofWrappers = []any{}
+	file_inventory_proto_msgTypes[9].OneofWrappers = []any{}
+	file_inventory_proto_msgTypes[15].OneofWrappers = []any{}
+	file_inventory_proto_msgTypes[18].OneofWrappers = []any{}
+	file_inventory_proto_msgTypes[22].OneofWrappers = []any{}
+	file_inventory_proto_msgTypes[25].OneofWrappers = []any{}
+	file_inventory_proto_msgTypes[26].OneofWrappers = []any{}
+	file_inventory_proto_msgTypes[28].OneofWrappers = []any{}
+	file_inventory_proto_msgTypes[30].OneofWrappers = []any{}
+	file_inventory_proto_msgTypes[31].OneofWrappers = []any{}
+	file_inventory_proto_msgTypes[32].OneofWrappers = []any{}
+	file_inventory_proto_msgTypes[33].OneofWrappers = []any{}
+	file_inventory_proto_msgTypes[38].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_inventory_proto_rawDesc), len(file_inventory_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   72,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_inventory_proto_goTypes,
+		DependencyIndexes: file_inventory_proto_depIdxs,
+		MessageInfos:      file_inventory_proto_msgTypes,
+	}.Build()
+	File_inventory_proto = out.File
+	file_inventory_proto_goTypes = nil
+	file_inventory_proto_depIdxs = nil
+}
@@ -0,0 +1,1393 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: inventory.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	InventoryService_PostItem_FullMethodName                  = "/pb.InventoryService/PostItem"
+	InventoryService_GetItem_FullMethodName                   = "/pb.InventoryService/GetItem"
+	InventoryService_GetItemByAssetTag_FullMethodName         = "/pb.InventoryService/GetItemByAssetTag"
+	InventoryService_GetItems_FullMethodName                  = "/pb.InventoryService/GetItems"
+	InventoryService_GetItemsByIDs_FullMethodName             = "/pb.InventoryService/GetItemsByIDs"
+	InventoryService_UpdateItem_FullMethodName                = "/pb.InventoryService/UpdateItem"
+	InventoryService_DeleteItem_FullMethodName                = "/pb.InventoryService/DeleteItem"
+	InventoryService_CheckItemLendable_FullMethodName         = "/pb.InventoryService/CheckItemLendable"
+	InventoryService_MoveItem_FullMethodName                  = "/pb.InventoryService/MoveItem"
+	InventoryService_GetItemMoves_FullMethodName              = "/pb.InventoryService/GetItemMoves"
+	InventoryService_GetItemsInLocation_FullMethodName        = "/pb.InventoryService/GetItemsInLocation"
+	InventoryService_GetItemsByClass_FullMethodName           = "/pb.InventoryService/GetItemsByClass"
+	InventoryService_GetItemTypeCounts_FullMethodName         = "/pb.InventoryService/GetItemTypeCounts"
+	InventoryService_PostLocation_FullMethodName              = "/pb.InventoryService/PostLocation"
+	InventoryService_GetLocation_FullMethodName               = "/pb.InventoryService/GetLocation"
+	InventoryService_GetLocations_FullMethodName              = "/pb.InventoryService/GetLocations"
+	InventoryService_GetLocationsByIDs_FullMethodName         = "/pb.InventoryService/GetLocationsByIDs"
+	InventoryService_UpdateLocation_FullMethodName            = "/pb.InventoryService/UpdateLocation"
+	InventoryService_DeleteLocation_FullMethodName            = "/pb.InventoryService/DeleteLocation"
+	InventoryService_OpenMaintenanceTicket_FullMethodName     = "/pb.InventoryService/OpenMaintenanceTicket"
+	InventoryService_GetMaintenanceTicket_FullMethodName      = "/pb.InventoryService/GetMaintenanceTicket"
+	InventoryService_GetMaintenanceTickets_FullMethodName     = "/pb.InventoryService/GetMaintenanceTickets"
+	InventoryService_UpdateMaintenanceTicket_FullMethodName   = "/pb.InventoryService/UpdateMaintenanceTicket"
+	InventoryService_PostMaintenanceSchedule_FullMethodName   = "/pb.InventoryService/PostMaintenanceSchedule"
+	InventoryService_GetMaintenanceSchedules_FullMethodName   = "/pb.InventoryService/GetMaintenanceSchedules"
+	InventoryService_DeleteMaintenanceSchedule_FullMethodName = "/pb.InventoryService/DeleteMaintenanceSchedule"
+	InventoryService_PostConsumable_FullMethodName            = "/pb.InventoryService/PostConsumable"
+	InventoryService_GetConsumable_FullMethodName             = "/pb.InventoryService/GetConsumable"
+	InventoryService_GetConsumables_FullMethodName            = "/pb.InventoryService/GetConsumables"
+	InventoryService_UpdateConsumable_FullMethodName          = "/pb.InventoryService/UpdateConsumable"
+	InventoryService_DeleteConsumable_FullMethodName          = "/pb.InventoryService/DeleteConsumable"
+	InventoryService_RecordStockMovement_FullMethodName       = "/pb.InventoryService/RecordStockMovement"
+	InventoryService_GetStockLevels_FullMethodName            = "/pb.InventoryService/GetStockLevels"
+	InventoryService_GetStockMovements_FullMethodName         = "/pb.InventoryService/GetStockMovements"
+)
+
+// InventoryServiceClient is the client API for InventoryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Service
+type InventoryServiceClient interface {
+	// Item methods
+	PostItem(ctx context.Context, in *PostItemRequest, opts ...grpc.CallOption) (*PostItemResponse, error)
+	GetItem(ctx context.Context, in *GetItemRequest, opts ...grpc.CallOption) (*GetItemResponse, error)
+	GetItemByAssetTag(ctx context.Context, in *GetItemByAssetTagRequest, opts ...grpc.CallOption) (*GetItemResponse, error)
+	GetItems(ctx context.Context, in *GetItemsRequest, opts ...grpc.CallOption) (*GetItemsResponse, error)
+	GetItemsByIDs(ctx context.Context, in *GetItemsByIDsRequest, opts ...grpc.CallOption) (*GetItemsResponse, error)
+	UpdateItem(ctx context.Context, in *UpdateItemRequest, opts ...grpc.CallOption) (*UpdateItemResponse, error)
+	DeleteItem(ctx context.Context, in *DeleteItemRequest, opts ...grpc.CallOption) (*DeleteItemResponse, error)
+	// CheckItemLendable fails with FailedPrecondition for an item that can't be
+	// lent out right now, such as one under maintenance. The lend service calls
+	// it before lending an item.
+	CheckItemLendable(ctx context.Context, in *CheckItemLendableRequest, opts ...grpc.CallOption) (*CheckItemLendableResponse, error)
+	MoveItem(ctx context.Context, in *MoveItemRequest, opts ...grpc.CallOption) (*MoveItemResponse, error)
+	GetItemMoves(ctx context.Context, in *GetItemMovesRequest, opts ...grpc.CallOption) (*GetItemMovesResponse, error)
+	GetItemsInLocation(ctx context.Context, in *GetItemsInLocationRequest, opts ...grpc.CallOption) (*GetItemsResponse, error)
+	GetItemsByClass(ctx context.Context, in *GetItemsByClassRequest, opts ...grpc.CallOption) (*GetItemsResponse, error)
+	GetItemTypeCounts(ctx context.Context, in *GetItemTypeCountsRequest, opts ...grpc.CallOption) (*GetItemTypeCountsResponse, error)
+	// Location methods
+	PostLocation(ctx context.Context, in *PostLocationRequest, opts ...grpc.CallOption) (*PostLocationResponse, error)
+	GetLocation(ctx context.Context, in *GetLocationRequest, opts ...grpc.CallOption) (*GetLocationResponse, error)
+	GetLocations(ctx context.Context, in *GetLocationsRequest, opts ...grpc.CallOption) (*GetLocationsResponse, error)
+	GetLocationsByIDs(ctx context.Context, in *GetLocationsByIDsRequest, opts ...grpc.CallOption) (*GetLocationsResponse, error)
+	UpdateLocation(ctx context.Context, in *UpdateLocationRequest, opts ...grpc.CallOption) (*UpdateLocationResponse, error)
+	DeleteLocation(ctx context.Context, in *DeleteLocationRequest, opts ...grpc.CallOption) (*DeleteLocationResponse, error)
+	// Maintenance methods
+	OpenMaintenanceTicket(ctx context.Context, in *OpenMaintenanceTicketRequest, opts ...grpc.CallOption) (*OpenMaintenanceTicketResponse, error)
+	GetMaintenanceTicket(ctx context.Context, in *GetMaintenanceTicketRequest, opts ...grpc.CallOption) (*GetMaintenanceTicketResponse, error)
+	GetMaintenanceTickets(ctx context.Context, in *GetMaintenanceTicketsRequest, opts ...grpc.CallOption) (*GetMaintenanceTicketsResponse, error)
+	UpdateMaintenanceTicket(ctx context.Context, in *UpdateMaintenanceTicketRequest, opts ...grpc.CallOption) (*UpdateMaintenanceTicketResponse, error)
+	PostMaintenanceSchedule(ctx context.Context, in *PostMaintenanceScheduleRequest, opts ...grpc.CallOption) (*PostMaintenanceScheduleResponse, error)
+	GetMaintenanceSchedules(ctx context.Context, in *GetMaintenanceSchedulesRequest, opts ...grpc.CallOption) (*GetMaintenanceSchedulesResponse, error)
+	DeleteMaintenanceSchedule(ctx context.Context, in *DeleteMaintenanceScheduleRequest, opts ...grpc.CallOption) (*DeleteMaintenanceScheduleResponse, error)
+	// Consumable methods
+	PostConsumable(ctx context.Context, in *PostConsumableRequest, opts ...grpc.CallOption) (*PostConsumableResponse, error)
+	GetConsumable(ctx context.Context, in *GetConsumableRequest, opts ...grpc.CallOption) (*GetConsumableResponse, error)
+	GetConsumables(ctx context.Context, in *GetConsumablesRequest, opts ...grpc.CallOption) (*GetConsumablesResponse, error)
+	UpdateConsumable(ctx context.Context, in *UpdateConsumableRequest, opts ...grpc.CallOption) (*UpdateConsumableResponse, error)
+	DeleteConsumable(ctx context.Context, in *DeleteConsumableRequest, opts ...grpc.CallOption) (*DeleteConsumableResponse, error)
+	RecordStockMovement(ctx context.Context, in *RecordStockMovementRequest, opts ...grpc.CallOption) (*RecordStockMovementResponse, error)
+	GetStockLevels(ctx context.Context, in *GetStockLevelsRequest, opts ...grpc.CallOption) (*GetStockLevelsResponse, error)
+	GetStockMovements(ctx context.Context, in *GetStockMovementsRequest, opts ...grpc.CallOption) (*GetStockMovementsResponse, error)
+}
+
+type inventoryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInventoryServiceClient(cc grpc.ClientConnInterface) InventoryServiceClient {
+	return &inventoryServiceClient{cc}
+}
+
+func (c *inventoryServiceClient) PostItem(ctx context.Context, in *PostItemRequest, opts ...grpc.CallOption) (*PostItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PostItemResponse)
+	err := c.cc.Invoke(ctx, InventoryService_PostItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetItem(ctx context.Context, in *GetItemRequest, opts ...grpc.CallOption) (*GetItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetItemResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetItemByAssetTag(ctx context.Context, in *GetItemByAssetTagRequest, opts ...grpc.CallOption) (*GetItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetItemResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetItemByAssetTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetItems(ctx context.Context, in *GetItemsRequest, opts ...grpc.CallOption) (*GetItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetItemsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetItemsByIDs(ctx context.Context, in *GetItemsByIDsRequest, opts ...grpc.CallOption) (*GetItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetItemsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetItemsByIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) UpdateItem(ctx context.Context, in *UpdateItemRequest, opts ...grpc.CallOption) (*UpdateItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateItemResponse)
+	err := c.cc.Invoke(ctx, InventoryService_UpdateItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) DeleteItem(ctx context.Context, in *DeleteItemRequest, opts ...grpc.CallOption) (*DeleteItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteItemResponse)
+	err := c.cc.Invoke(ctx, InventoryService_DeleteItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) CheckItemLendable(ctx context.Context, in *CheckItemLendableRequest, opts ...grpc.CallOption) (*CheckItemLendableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckItemLendableResponse)
+	err := c.cc.Invoke(ctx, InventoryService_CheckItemLendable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) MoveItem(ctx context.Context, in *MoveItemRequest, opts ...grpc.CallOption) (*MoveItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveItemResponse)
+	err := c.cc.Invoke(ctx, InventoryService_MoveItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetItemMoves(ctx context.Context, in *GetItemMovesRequest, opts ...grpc.CallOption) (*GetItemMovesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetItemMovesResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetItemMoves_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetItemsInLocation(ctx context.Context, in *GetItemsInLocationRequest, opts ...grpc.CallOption) (*GetItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetItemsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetItemsInLocation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetItemsByClass(ctx context.Context, in *GetItemsByClassRequest, opts ...grpc.CallOption) (*GetItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetItemsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetItemsByClass_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetItemTypeCounts(ctx context.Context, in *GetItemTypeCountsRequest, opts ...grpc.CallOption) (*GetItemTypeCountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetItemTypeCountsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetItemTypeCounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) PostLocation(ctx context.Context, in *PostLocationRequest, opts ...grpc.CallOption) (*PostLocationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PostLocationResponse)
+	err := c.cc.Invoke(ctx, InventoryService_PostLocation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetLocation(ctx context.Context, in *GetLocationRequest, opts ...grpc.CallOption) (*GetLocationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLocationResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetLocation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetLocations(ctx context.Context, in *GetLocationsRequest, opts ...grpc.CallOption) (*GetLocationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLocationsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetLocations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetLocationsByIDs(ctx context.Context, in *GetLocationsByIDsRequest, opts ...grpc.CallOption) (*GetLocationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLocationsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetLocationsByIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) UpdateLocation(ctx context.Context, in *UpdateLocationRequest, opts ...grpc.CallOption) (*UpdateLocationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateLocationResponse)
+	err := c.cc.Invoke(ctx, InventoryService_UpdateLocation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) DeleteLocation(ctx context.Context, in *DeleteLocationRequest, opts ...grpc.CallOption) (*DeleteLocationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteLocationResponse)
+	err := c.cc.Invoke(ctx, InventoryService_DeleteLocation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) OpenMaintenanceTicket(ctx context.Context, in *OpenMaintenanceTicketRequest, opts ...grpc.CallOption) (*OpenMaintenanceTicketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OpenMaintenanceTicketResponse)
+	err := c.cc.Invoke(ctx, InventoryService_OpenMaintenanceTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetMaintenanceTicket(ctx context.Context, in *GetMaintenanceTicketRequest, opts ...grpc.CallOption) (*GetMaintenanceTicketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMaintenanceTicketResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetMaintenanceTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetMaintenanceTickets(ctx context.Context, in *GetMaintenanceTicketsRequest, opts ...grpc.CallOption) (*GetMaintenanceTicketsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMaintenanceTicketsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetMaintenanceTickets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) UpdateMaintenanceTicket(ctx context.Context, in *UpdateMaintenanceTicketRequest, opts ...grpc.CallOption) (*UpdateMaintenanceTicketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateMaintenanceTicketResponse)
+	err := c.cc.Invoke(ctx, InventoryService_UpdateMaintenanceTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) PostMaintenanceSchedule(ctx context.Context, in *PostMaintenanceScheduleRequest, opts ...grpc.CallOption) (*PostMaintenanceScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PostMaintenanceScheduleResponse)
+	err := c.cc.Invoke(ctx, InventoryService_PostMaintenanceSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetMaintenanceSchedules(ctx context.Context, in *GetMaintenanceSchedulesRequest, opts ...grpc.CallOption) (*GetMaintenanceSchedulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMaintenanceSchedulesResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetMaintenanceSchedules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) DeleteMaintenanceSchedule(ctx context.Context, in *DeleteMaintenanceScheduleRequest, opts ...grpc.CallOption) (*DeleteMaintenanceScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMaintenanceScheduleResponse)
+	err := c.cc.Invoke(ctx, InventoryService_DeleteMaintenanceSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) PostConsumable(ctx context.Context, in *PostConsumableRequest, opts ...grpc.CallOption) (*PostConsumableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PostConsumableResponse)
+	err := c.cc.Invoke(ctx, InventoryService_PostConsumable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetConsumable(ctx context.Context, in *GetConsumableRequest, opts ...grpc.CallOption) (*GetConsumableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConsumableResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetConsumable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetConsumables(ctx context.Context, in *GetConsumablesRequest, opts ...grpc.CallOption) (*GetConsumablesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConsumablesResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetConsumables_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) UpdateConsumable(ctx context.Context, in *UpdateConsumableRequest, opts ...grpc.CallOption) (*UpdateConsumableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateConsumableResponse)
+	err := c.cc.Invoke(ctx, InventoryService_UpdateConsumable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) DeleteConsumable(ctx context.Context, in *DeleteConsumableRequest, opts ...grpc.CallOption) (*DeleteConsumableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteConsumableResponse)
+	err := c.cc.Invoke(ctx, InventoryService_DeleteConsumable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) RecordStockMovement(ctx context.Context, in *RecordStockMovementRequest, opts ...grpc.CallOption) (*RecordStockMovementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordStockMovementResponse)
+	err := c.cc.Invoke(ctx, InventoryService_RecordStockMovement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetStockLevels(ctx context.Context, in *GetStockLevelsRequest, opts ...grpc.CallOption) (*GetStockLevelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStockLevelsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetStockLevels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetStockMovements(ctx context.Context, in *GetStockMovementsRequest, opts ...grpc.CallOption) (*GetStockMovementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStockMovementsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetStockMovements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InventoryServiceServer is the server API for InventoryService service.
+// All implementations must embed UnimplementedInventoryServiceServer
+// for forward compatibility.
+//
+// Service
+type InventoryServiceServer interface {
+	// Item methods
+	PostItem(context.Context, *PostItemRequest) (*PostItemResponse, error)
+	GetItem(context.Context, *GetItemRequest) (*GetItemResponse, error)
+	GetItemByAssetTag(context.Context, *GetItemByAssetTagRequest) (*GetItemResponse, error)
+	GetItems(context.Context, *GetItemsRequest) (*GetItemsResponse, error)
+	GetItemsByIDs(context.Context, *GetItemsByIDsRequest) (*GetItemsResponse, error)
+	UpdateItem(context.Context, *UpdateItemRequest) (*UpdateItemResponse, error)
+	DeleteItem(context.Context, *DeleteItemRequest) (*DeleteItemResponse, error)
+	// CheckItemLendable fails with FailedPrecondition for an item that can't be
+	// lent out right now, such as one under maintenance. The lend service calls
+	// it before lending an item.
+	CheckItemLendable(context.Context, *CheckItemLendableRequest) (*CheckItemLendableResponse, error)
+	MoveItem(context.Context, *MoveItemRequest) (*MoveItemResponse, error)
+	GetItemMoves(context.Context, *GetItemMovesRequest) (*GetItemMovesResponse, error)
+	GetItemsInLocation(context.Context, *GetItemsInLocationRequest) (*GetItemsResponse, error)
+	GetItemsByClass(context.Context, *GetItemsByClassRequest) (*GetItemsResponse, error)
+	GetItemTypeCounts(context.Context, *GetItemTypeCountsRequest) (*GetItemTypeCountsResponse, error)
+	// Location methods
+	PostLocation(context.Context, *PostLocationRequest) (*PostLocationResponse, error)
+	GetLocation(context.Context, *GetLocationRequest) (*GetLocationResponse, error)
+	GetLocations(context.Context, *GetLocationsRequest) (*GetLocationsResponse, error)
+	GetLocationsByIDs(context.Context, *GetLocationsByIDsRequest) (*GetLocationsResponse, error)
+	UpdateLocation(context.Context, *UpdateLocationRequest) (*UpdateLocationResponse, error)
+	DeleteLocation(context.Context, *DeleteLocationRequest) (*DeleteLocationResponse, error)
+	// Maintenance methods
+	OpenMaintenanceTicket(context.Context, *OpenMaintenanceTicketRequest) (*OpenMaintenanceTicketResponse, error)
+	GetMaintenanceTicket(context.Context, *GetMaintenanceTicketRequest) (*GetMaintenanceTicketResponse, error)
+	GetMaintenanceTickets(context.Context, *GetMaintenanceTicketsRequest) (*GetMaintenanceTicketsResponse, error)
+	UpdateMaintenanceTicket(context.Context, *UpdateMaintenanceTicketRequest) (*UpdateMaintenanceTicketResponse, error)
+	PostMaintenanceSchedule(context.Context, *PostMaintenanceScheduleRequest) (*PostMaintenanceScheduleResponse, error)
+	GetMaintenanceSchedules(context.Context, *GetMaintenanceSchedulesRequest) (*GetMaintenanceSchedulesResponse, error)
+	DeleteMaintenanceSchedule(context.Context, *DeleteMaintenanceScheduleRequest) (*DeleteMaintenanceScheduleResponse, error)
+	// Consumable methods
+	PostConsumable(context.Context, *PostConsumableRequest) (*PostConsumableResponse, error)
+	GetConsumable(context.Context, *GetConsumableRequest) (*GetConsumableResponse, error)
+	GetConsumables(context.Context, *GetConsumablesRequest) (*GetConsumablesResponse, error)
+	UpdateConsumable(context.Context, *UpdateConsumableRequest) (*UpdateConsumableResponse, error)
+	DeleteConsumable(context.Context, *DeleteConsumableRequest) (*DeleteConsumableResponse, error)
+	RecordStockMovement(context.Context, *RecordStockMovementRequest) (*RecordStockMovementResponse, error)
+	GetStockLevels(context.Context, *GetStockLevelsRequest) (*GetStockLevelsResponse, error)
+	GetStockMovements(context.Context, *GetStockMovementsRequest) (*GetStockMovementsResponse, error)
+	mustEmbedUnimplementedInventoryServiceServer()
+}
+
+// UnimplementedInventoryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedInventoryServiceServer struct{}
+
+func (UnimplementedInventoryServiceServer) PostItem(context.Context, *PostItemRequest) (*PostItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostItem not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetItem(context.Context, *GetItemRequest) (*GetItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItem not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetItemByAssetTag(context.Context, *GetItemByAssetTagRequest) (*GetItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItemByAssetTag not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetItems(context.Context, *GetItemsRequest) (*GetItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItems not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetItemsByIDs(context.Context, *GetItemsByIDsRequest) (*GetItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItemsByIDs not implemented")
+}
+func (UnimplementedInventoryServiceServer) UpdateItem(context.Context, *UpdateItemRequest) (*UpdateItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateItem not implemented")
+}
+func (UnimplementedInventoryServiceServer) DeleteItem(context.Context, *DeleteItemRequest) (*DeleteItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteItem not implemented")
+}
+func (UnimplementedInventoryServiceServer) CheckItemLendable(context.Context, *CheckItemLendableRequest) (*CheckItemLendableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckItemLendable not implemented")
+}
+func (UnimplementedInventoryServiceServer) MoveItem(context.Context, *MoveItemRequest) (*MoveItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveItem not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetItemMoves(context.Context, *GetItemMovesRequest) (*GetItemMovesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItemMoves not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetItemsInLocation(context.Context, *GetItemsInLocationRequest) (*GetItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItemsInLocation not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetItemsByClass(context.Context, *GetItemsByClassRequest) (*GetItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItemsByClass not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetItemTypeCounts(context.Context, *GetItemTypeCountsRequest) (*GetItemTypeCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItemTypeCounts not implemented")
+}
+func (UnimplementedInventoryServiceServer) PostLocation(context.Context, *PostLocationRequest) (*PostLocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostLocation not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetLocation(context.Context, *GetLocationRequest) (*GetLocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLocation not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetLocations(context.Context, *GetLocationsRequest) (*GetLocationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLocations not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetLocationsByIDs(context.Context, *GetLocationsByIDsRequest) (*GetLocationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLocationsByIDs not implemented")
+}
+func (UnimplementedInventoryServiceServer) UpdateLocation(context.Context, *UpdateLocationRequest) (*UpdateLocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLocation not implemented")
+}
+func (UnimplementedInventoryServiceServer) DeleteLocation(context.Context, *DeleteLocationRequest) (*DeleteLocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLocation not implemented")
+}
+func (UnimplementedInventoryServiceServer) OpenMaintenanceTicket(context.Context, *OpenMaintenanceTicketRequest) (*OpenMaintenanceTicketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenMaintenanceTicket not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetMaintenanceTicket(context.Context, *GetMaintenanceTicketRequest) (*GetMaintenanceTicketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMaintenanceTicket not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetMaintenanceTickets(context.Context, *GetMaintenanceTicketsRequest) (*GetMaintenanceTicketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMaintenanceTickets not implemented")
+}
+func (UnimplementedInventoryServiceServer) UpdateMaintenanceTicket(context.Context, *UpdateMaintenanceTicketRequest) (*UpdateMaintenanceTicketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMaintenanceTicket not implemented")
+}
+func (UnimplementedInventoryServiceServer) PostMaintenanceSchedule(context.Context, *PostMaintenanceScheduleRequest) (*PostMaintenanceScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostMaintenanceSchedule not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetMaintenanceSchedules(context.Context, *GetMaintenanceSchedulesRequest) (*GetMaintenanceSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMaintenanceSchedules not implemented")
+}
+func (UnimplementedInventoryServiceServer) DeleteMaintenanceSchedule(context.Context, *DeleteMaintenanceScheduleRequest) (*DeleteMaintenanceScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMaintenanceSchedule not implemented")
+}
+func (UnimplementedInventoryServiceServer) PostConsumable(context.Context, *PostConsumableRequest) (*PostConsumableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostConsumable not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetConsumable(context.Context, *GetConsumableRequest) (*GetConsumableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsumable not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetConsumables(context.Context, *GetConsumablesRequest) (*GetConsumablesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsumables not implemented")
+}
+func (UnimplementedInventoryServiceServer) UpdateConsumable(context.Context, *UpdateConsumableRequest) (*UpdateConsumableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateConsumable not implemented")
+}
+func (UnimplementedInventoryServiceServer) DeleteConsumable(context.Context, *DeleteConsumableRequest) (*DeleteConsumableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteConsumable not implemented")
+}
+func (UnimplementedInventoryServiceServer) RecordStockMovement(context.Context, *RecordStockMovementRequest) (*RecordStockMovementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordStockMovement not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetStockLevels(context.Context, *GetStockLevelsRequest) (*GetStockLevelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStockLevels not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetStockMovements(context.Context, *GetStockMovementsRequest) (*GetStockMovementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStockMovements not implemented")
+}
+func (UnimplementedInventoryServiceServer) mustEmbedUnimplementedInventoryServiceServer() {}
+func (UnimplementedInventoryServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeInventoryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InventoryServiceServer will
+// result in compilation errors.
+type UnsafeInventoryServiceServer interface {
+	mustEmbedUnimplementedInventoryServiceServer()
+}
+
+func RegisterInventoryServiceServer(s grpc.ServiceRegistrar, srv InventoryServiceServer) {
+	// If the following call pancis, it indicates UnimplementedInventoryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&InventoryService_ServiceDesc, srv)
+}
+
+func _InventoryService_PostItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).PostItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_PostItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).PostItem(ctx, req.(*PostItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetItem(ctx, req.(*GetItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetItemByAssetTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetItemByAssetTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetItemByAssetTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetItemByAssetTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetItemByAssetTag(ctx, req.(*GetItemByAssetTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetItems(ctx, req.(*GetItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetItemsByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetItemsByIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetItemsByIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetItemsByIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetItemsByIDs(ctx, req.(*GetItemsByIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_UpdateItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).UpdateItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_UpdateItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).UpdateItem(ctx, req.(*UpdateItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_DeleteItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).DeleteItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_DeleteItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).DeleteItem(ctx, req.(*DeleteItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_CheckItemLendable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckItemLendableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CheckItemLendable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CheckItemLendable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CheckItemLendable(ctx, req.(*CheckItemLendableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_MoveItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).MoveItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_MoveItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).MoveItem(ctx, req.(*MoveItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetItemMoves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetItemMovesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetItemMoves(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetItemMoves_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetItemMoves(ctx, req.(*GetItemMovesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetItemsInLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetItemsInLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetItemsInLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetItemsInLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetItemsInLocation(ctx, req.(*GetItemsInLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetItemsByClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetItemsByClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetItemsByClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetItemsByClass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetItemsByClass(ctx, req.(*GetItemsByClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetItemTypeCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetItemTypeCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetItemTypeCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetItemTypeCounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetItemTypeCounts(ctx, req.(*GetItemTypeCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_PostLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).PostLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_PostLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).PostLocation(ctx, req.(*PostLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetLocation(ctx, req.(*GetLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetLocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLocationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetLocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetLocations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetLocations(ctx, req.(*GetLocationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetLocationsByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLocationsByIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetLocationsByIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetLocationsByIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetLocationsByIDs(ctx, req.(*GetLocationsByIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_UpdateLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).UpdateLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_UpdateLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).UpdateLocation(ctx, req.(*UpdateLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_DeleteLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).DeleteLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_DeleteLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).DeleteLocation(ctx, req.(*DeleteLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_OpenMaintenanceTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenMaintenanceTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).OpenMaintenanceTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_OpenMaintenanceTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).OpenMaintenanceTicket(ctx, req.(*OpenMaintenanceTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetMaintenanceTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMaintenanceTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetMaintenanceTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetMaintenanceTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetMaintenanceTicket(ctx, req.(*GetMaintenanceTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetMaintenanceTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMaintenanceTicketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetMaintenanceTickets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetMaintenanceTickets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetMaintenanceTickets(ctx, req.(*GetMaintenanceTicketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_UpdateMaintenanceTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMaintenanceTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).UpdateMaintenanceTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_UpdateMaintenanceTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).UpdateMaintenanceTicket(ctx, req.(*UpdateMaintenanceTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_PostMaintenanceSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostMaintenanceScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).PostMaintenanceSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_PostMaintenanceSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).PostMaintenanceSchedule(ctx, req.(*PostMaintenanceScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetMaintenanceSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMaintenanceSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetMaintenanceSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetMaintenanceSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetMaintenanceSchedules(ctx, req.(*GetMaintenanceSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_DeleteMaintenanceSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMaintenanceScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).DeleteMaintenanceSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_DeleteMaintenanceSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).DeleteMaintenanceSchedule(ctx, req.(*DeleteMaintenanceScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_PostConsumable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostConsumableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).PostConsumable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_PostConsumable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).PostConsumable(ctx, req.(*PostConsumableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetConsumable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConsumableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetConsumable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetConsumable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetConsumable(ctx, req.(*GetConsumableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetConsumables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConsumablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetConsumables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetConsumables_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetConsumables(ctx, req.(*GetConsumablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_UpdateConsumable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateConsumableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).UpdateConsumable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_UpdateConsumable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).UpdateConsumable(ctx, req.(*UpdateConsumableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_DeleteConsumable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteConsumableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).DeleteConsumable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_DeleteConsumable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).DeleteConsumable(ctx, req.(*DeleteConsumableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_RecordStockMovement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordStockMovementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).RecordStockMovement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_RecordStockMovement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).RecordStockMovement(ctx, req.(*RecordStockMovementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetStockLevels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStockLevelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetStockLevels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetStockLevels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetStockLevels(ctx, req.(*GetStockLevelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetStockMovements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStockMovementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetStockMovements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetStockMovements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetStockMovements(ctx, req.(*GetStockMovementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// InventoryService_ServiceDesc is the grpc.ServiceDesc for InventoryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var InventoryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.InventoryService",
+	HandlerType: (*InventoryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PostItem",
+			Handler:    _InventoryService_PostItem_Handler,
+		},
+		{
+			MethodName: "GetItem",
+			Handler:    _InventoryService_GetItem_Handler,
+		},
+		{
+			MethodName: "GetItemByAssetTag",
+			Handler:    _InventoryService_GetItemByAssetTag_Handler,
+		},
+		{
+			MethodName: "GetItems",
+			Handler:    _InventoryService_GetItems_Handler,
+		},
+		{
+			MethodName: "GetItemsByIDs",
+			Handler:    _InventoryService_GetItemsByIDs_Handler,
+		},
+		{
+			MethodName: "UpdateItem",
+			Handler:    _InventoryService_UpdateItem_Handler,
+		},
+		{
+			MethodName: "DeleteItem",
+			Handler:    _InventoryService_DeleteItem_Handler,
+		},
+		{
+			MethodName: "CheckItemLendable",
+			Handler:    _InventoryService_CheckItemLendable_Handler,
+		},
+		{
+			MethodName: "MoveItem",
+			Handler:    _InventoryService_MoveItem_Handler,
+		},
+		{
+			MethodName: "GetItemMoves",
+			Handler:    _InventoryService_GetItemMoves_Handler,
+		},
+		{
+			MethodName: "GetItemsInLocation",
+			Handler:    _InventoryService_GetItemsInLocation_Handler,
+		},
+		{
+			MethodName: "GetItemsByClass",
+			Handler:    _InventoryService_GetItemsByClass_Handler,
+		},
+		{
+			MethodName: "GetItemTypeCounts",
+			Handler:    _InventoryService_GetItemTypeCounts_Handler,
+		},
+		{
+			MethodName: "PostLocation",
+			Handler:    _InventoryService_PostLocation_Handler,
+		},
+		{
+			MethodName: "GetLocation",
+			Handler:    _InventoryService_GetLocation_Handler,
+		},
+		{
+			MethodName: "GetLocations",
+			Handler:    _InventoryService_GetLocations_Handler,
+		},
+		{
+			MethodName: "GetLocationsByIDs",
+			Handler:    _InventoryService_GetLocationsByIDs_Handler,
+		},
+		{
+			MethodName: "UpdateLocation",
+			Handler:    _InventoryService_UpdateLocation_Handler,
+		},
+		{
+			MethodName: "DeleteLocation",
+			Handler:    _InventoryService_DeleteLocation_Handler,
+		},
+		{
+			MethodName: "OpenMaintenanceTicket",
+			Handler:    _InventoryService_OpenMaintenanceTicket_Handler,
+		},
+		{
+			MethodName: "GetMaintenanceTicket",
+			Handler:    _InventoryService_GetMaintenanceTicket_Handler,
+		},
+		{
+			MethodName: "GetMaintenanceTickets",
+			Handler:    _InventoryService_GetMaintenanceTickets_Handler,
+		},
+		{
+			MethodName: "UpdateMaintenanceTicket",
+			Handler:    _InventoryService_UpdateMaintenanceTicket_Handler,
+		},
+		{
+			MethodName: "PostMaintenanceSchedule",
+			Handler:    _InventoryService_PostMaintenanceSchedule_Handler,
+		},
+		{
+			MethodName: "GetMaintenanceSchedules",
+			Handler:    _InventoryService_GetMaintenanceSchedules_Handler,
+		},
+		{
+			MethodName: "DeleteMaintenanceSchedule",
+			Handler:    _InventoryService_DeleteMaintenanceSchedule_Handler,
+		},
+		{
+			MethodName: "PostConsumable",
+			Handler:    _InventoryService_PostConsumable_Handler,
+		},
+		{
+			MethodName: "GetConsumable",
+			Handler:    _InventoryService_GetConsumable_Handler,
+		},
+		{
+			MethodName: "GetConsumables",
+			Handler:    _InventoryService_GetConsumables_Handler,
+		},
+		{
+			MethodName: "UpdateConsumable",
+			Handler:    _InventoryService_UpdateConsumable_Handler,
+		},
+		{
+			MethodName: "DeleteConsumable",
+			Handler:    _InventoryService_DeleteConsumable_Handler,
+		},
+		{
+			MethodName: "RecordStockMovement",
+			Handler:    _InventoryService_RecordStockMovement_Handler,
+		},
+		{
+			MethodName: "GetStockLevels",
+			Handler:    _InventoryService_GetStockLevels_Handler,
+		},
+		{
+			MethodName: "GetStockMovements",
+			Handler:    _InventoryService_GetStockMovements_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "inventory.proto",
+}
@@ -1 +1,831 @@
 package inventory
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jochem11/inventory-system-back/internal/config"
+	"github.com/jochem11/inventory-system-back/internal/metrics"
+	"github.com/jochem11/inventory-system-back/internal/tracing"
+	"github.com/lib/pq"
+)
+
+type Repository interface {
+	Close()
+	Ping(ctx context.Context) error
+
+	PutItem(ctx context.Context, i *Item) error
+	GetItemByID(ctx context.Context, id string) (*Item, error)
+	GetItemByAssetTag(ctx context.Context, assetTag string) (*Item, error)
+	ListItems(ctx context.Context, skip uint64, take uint64) ([]*Item, error)
+	ListItemsByIDs(ctx context.Context, ids []string) ([]*Item, error)
+	UpdateItem(ctx context.Context, i *Item) (*Item, error)
+	DeleteItemByID(ctx context.Context, id string) error
+	MoveItem(ctx context.Context, m *ItemMove) error
+	ListItemMoves(ctx context.Context, skip uint64, take uint64, itemID string) ([]*ItemMove, error)
+	ListItemsInLocation(ctx context.Context, skip uint64, take uint64, locationID string, includeSubLocations bool) ([]*Item, error)
+	ListItemsByClass(ctx context.Context, skip uint64, take uint64, classID string) ([]*Item, error)
+	CountItemsByType(ctx context.Context, itemTypes []string) ([]*ItemTypeCount, error)
+
+	PutLocation(ctx context.Context, l *Location) error
+	GetLocationByID(ctx context.Context, id string) (*Location, error)
+	ListLocations(ctx context.Context, skip uint64, take uint64, parentID, code, classID *string) ([]*Location, error)
+	ListLocationsByIDs(ctx context.Context, ids []string) ([]*Location, error)
+	UpdateLocation(ctx context.Context, l *Location) (*Location, error)
+	DeleteLocationByID(ctx context.Context, id string) error
+
+	PutMaintenanceTicket(ctx context.Context, t *MaintenanceTicket) error
+	GetMaintenanceTicketByID(ctx context.Context, id string) (*MaintenanceTicket, error)
+	ListMaintenanceTickets(ctx context.Context, skip uint64, take uint64, itemID *string, openOnly bool) ([]*MaintenanceTicket, error)
+	UpdateMaintenanceTicket(ctx context.Context, t *MaintenanceTicket) (*MaintenanceTicket, error)
+
+	PutMaintenanceSchedule(ctx context.Context, m *MaintenanceSchedule) error
+	GetMaintenanceScheduleByID(ctx context.Context, id string) (*MaintenanceSchedule, error)
+	ListMaintenanceSchedules(ctx context.Context, skip uint64, take uint64, dueBefore *time.Time) ([]*MaintenanceSchedule, error)
+	UpdateMaintenanceSchedule(ctx context.Context, m *MaintenanceSchedule) error
+	DeleteMaintenanceScheduleByID(ctx context.Context, id string) error
+
+	PutConsumable(ctx context.Context, c *Consumable) error
+	GetConsumableByID(ctx context.Context, id string) (*Consumable, error)
+	ListConsumables(ctx context.Context, skip uint64, take uint64, lowStockOnly bool) ([]*Consumable, error)
+	UpdateConsumable(ctx context.Context, c *Consumable) (*Consumable, error)
+	DeleteConsumableByID(ctx context.Context, id string) error
+	PutStockMovement(ctx context.Context, m *StockMovement) (*StockLevel, error)
+	ListStockLevels(ctx context.Context, consumableID string) ([]*StockLevel, error)
+	ListStockMovements(ctx context.Context, skip uint64, take uint64, consumableID string) ([]*StockMovement, error)
+}
+
+type postgresRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresRepository(url string, pool config.DBPool) (Repository, error) {
+	db, err := tracing.OpenPostgres(url)
+	if err != nil {
+		return nil, err
+	}
+	pool.Apply(db)
+	err = db.Ping()
+	if err != nil {
+		return nil, err
+	}
+	if err := metrics.RegisterDB(db, "inventory"); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &postgresRepository{db}, nil
+}
+
+func (r postgresRepository) Close() {
+	r.db.Close()
+}
+
+func (r postgresRepository) Ping(ctx context.Context) error {
+	return r.db.PingContext(ctx)
+}
+
+// --- Items ---
+
+const itemColumns = `i.id, i.name, i.asset_tag, i.description, i.status, i.location_id, i.created_at, i.updated_at, i.item_type`
+
+func scanItem(row interface{ Scan(...any) error }) (*Item, error) {
+	i := &Item{}
+	if err := row.Scan(&i.ID, &i.Name, &i.AssetTag, &i.Description, &i.Status, &i.LocationID, &i.CreatedAt, &i.UpdatedAt, &i.ItemType); err != nil {
+		return nil, err
+	}
+	return i, nil
+}
+
+func (r *postgresRepository) queryItems(ctx context.Context, query string, args ...any) ([]*Item, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := []*Item{}
+	for rows.Next() {
+		i, err := scanItem(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (r *postgresRepository) PutItem(ctx context.Context, i *Item) error {
+	_, err := r.db.ExecContext(ctx, `
+        INSERT INTO items(id, name, asset_tag, description, status, location_id, created_at, updated_at, item_type)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		i.ID, i.Name, i.AssetTag, i.Description, i.Status, i.LocationID, i.CreatedAt, i.UpdatedAt, i.ItemType)
+	return err
+}
+
+func (r *postgresRepository) GetItemByID(ctx context.Context, id string) (*Item, error) {
+	row := r.db.QueryRowContext(ctx, "SELECT "+itemColumns+" FROM items i WHERE i.id = $1", id)
+	return scanItem(row)
+}
+
+func (r *postgresRepository) ListItemsByIDs(ctx context.Context, ids []string) ([]*Item, error) {
+	return r.queryItems(ctx, "SELECT "+itemColumns+" FROM items i WHERE i.id = ANY($1)", pq.Array(ids))
+}
+
+func (r *postgresRepository) GetItemByAssetTag(ctx context.Context, assetTag string) (*Item, error) {
+	row := r.db.QueryRowContext(ctx, "SELECT "+itemColumns+" FROM items i WHERE i.asset_tag = $1", assetTag)
+	return scanItem(row)
+}
+
+func (r *postgresRepository) ListItems(ctx context.Context, skip uint64, take uint64) ([]*Item, error) {
+	return r.queryItems(ctx, `
+        SELECT `+itemColumns+`
+        FROM items i
+        ORDER BY i.id DESC
+        OFFSET $1 LIMIT $2`, skip, take)
+}
+
+func (r *postgresRepository) ListItemsInLocation(ctx context.Context, skip uint64, take uint64, locationID string, includeSubLocations bool) ([]*Item, error) {
+	return r.queryItems(ctx, `
+        WITH RECURSIVE subtree AS (
+            SELECT id FROM locations WHERE id = $1
+            UNION ALL
+            SELECT l.id FROM locations l JOIN subtree s ON l.parent_id = s.id WHERE $2
+        )
+        SELECT `+itemColumns+`
+        FROM items i
+        JOIN subtree s ON i.location_id = s.id
+        ORDER BY i.id DESC
+        OFFSET $3 LIMIT $4`, locationID, includeSubLocations, skip, take)
+}
+
+func (r *postgresRepository) ListItemsByClass(ctx context.Context, skip uint64, take uint64, classID string) ([]*Item, error) {
+	return r.queryItems(ctx, `
+        WITH RECURSIVE subtree AS (
+            SELECT id FROM locations WHERE class_id = $1
+            UNION
+            SELECT l.id FROM locations l JOIN subtree s ON l.parent_id = s.id
+        )
+        SELECT `+itemColumns+`
+        FROM items i
+        JOIN subtree s ON i.location_id = s.id
+        ORDER BY i.id DESC
+        OFFSET $2 LIMIT $3`, classID, skip, take)
+}
+
+// CountItemsByType counts the items of each type. Types without items are
+// left out.
+func (r *postgresRepository) CountItemsByType(ctx context.Context, itemTypes []string) ([]*ItemTypeCount, error) {
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT item_type, COUNT(*), COUNT(*) FILTER (WHERE status = $2)
+        FROM items
+        WHERE item_type = ANY($1)
+        GROUP BY item_type`, pq.Array(itemTypes), ItemStatusAvailable)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := []*ItemTypeCount{}
+	for rows.Next() {
+		c := &ItemTypeCount{}
+		if err := rows.Scan(&c.ItemType, &c.Total, &c.Lendable); err != nil {
+			return nil, err
+		}
+		counts = append(counts, c)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+// MoveItem updates the item's location and records the move in a single transaction.
+// The from location is taken from the row itself so concurrent moves are recorded in order.
+func (r *postgresRepository) MoveItem(ctx context.Context, m *ItemMove) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, "SELECT location_id FROM items WHERE id = $1 FOR UPDATE", m.ItemID).Scan(&m.FromLocationID)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE items SET location_id = $1, updated_at = $2 WHERE id = $3", m.ToLocationID, m.MovedAt, m.ItemID)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+        INSERT INTO item_moves(id, item_id, from_location_id, to_location_id, note, moved_at)
+        VALUES ($1, $2, $3, $4, $5, $6)`,
+		m.ID, m.ItemID, m.FromLocationID, m.ToLocationID, m.Note, m.MovedAt)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *postgresRepository) ListItemMoves(ctx context.Context, skip uint64, take uint64, itemID string) ([]*ItemMove, error) {
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT id, item_id, from_location_id, to_location_id, note, moved_at
+        FROM item_moves
+        WHERE item_id = $1
+        ORDER BY moved_at DESC
+        OFFSET $2 LIMIT $3`, itemID, skip, take)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	moves := []*ItemMove{}
+	for rows.Next() {
+		m := &ItemMove{}
+		if err := rows.Scan(&m.ID, &m.ItemID, &m.FromLocationID, &m.ToLocationID, &m.Note, &m.MovedAt); err != nil {
+			return nil, err
+		}
+		moves = append(moves, m)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return moves, nil
+}
+
+func (r *postgresRepository) UpdateItem(ctx context.Context, i *Item) (*Item, error) {
+	res, err := r.db.ExecContext(ctx, `
+        UPDATE items
+        SET name = $1, asset_tag = $2, description = $3, updated_at = $4, item_type = $5
+        WHERE id = $6`, i.Name, i.AssetTag, i.Description, i.UpdatedAt, i.ItemType, i.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if rowsAffected == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	return r.GetItemByID(ctx, i.ID)
+}
+
+func (r *postgresRepository) DeleteItemByID(ctx context.Context, id string) error {
+	res, err := r.db.ExecContext(ctx, "DELETE FROM items WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// --- Locations ---
+
+const locationColumns = `id, parent_id, kind, name, code, class_id, created_at, updated_at`
+
+func scanLocation(row interface{ Scan(...any) error }) (*Location, error) {
+	l := &Location{}
+	if err := row.Scan(&l.ID, &l.ParentID, &l.Kind, &l.Name, &l.Code, &l.ClassID, &l.CreatedAt, &l.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (r *postgresRepository) PutLocation(ctx context.Context, l *Location) error {
+	_, err := r.db.ExecContext(ctx, `
+        INSERT INTO locations(`+locationColumns+`)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		l.ID, l.ParentID, l.Kind, l.Name, l.Code, l.ClassID, l.CreatedAt, l.UpdatedAt)
+	return err
+}
+
+func (r *postgresRepository) GetLocationByID(ctx context.Context, id string) (*Location, error) {
+	row := r.db.QueryRowContext(ctx, "SELECT "+locationColumns+" FROM locations WHERE id = $1", id)
+	return scanLocation(row)
+}
+
+func (r *postgresRepository) ListLocations(ctx context.Context, skip uint64, take uint64, parentID, code, classID *string) ([]*Location, error) {
+	where := []string{}
+	args := []any{}
+	if parentID != nil {
+		args = append(args, *parentID)
+		where = append(where, fmt.Sprintf("parent_id = $%d", len(args)))
+	}
+	if code != nil {
+		args = append(args, *code)
+		where = append(where, fmt.Sprintf("code = $%d", len(args)))
+	}
+	if classID != nil {
+		args = append(args, *classID)
+		where = append(where, fmt.Sprintf("class_id = $%d", len(args)))
+	}
+
+	query := "SELECT " + locationColumns + " FROM locations"
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	args = append(args, skip, take)
+	query += fmt.Sprintf(" ORDER BY code OFFSET $%d LIMIT $%d", len(args)-1, len(args))
+	return r.queryLocations(ctx, query, args...)
+}
+
+func (r *postgresRepository) ListLocationsByIDs(ctx context.Context, ids []string) ([]*Location, error) {
+	return r.queryLocations(ctx, "SELECT "+locationColumns+" FROM locations WHERE id = ANY($1)", pq.Array(ids))
+}
+
+func (r *postgresRepository) queryLocations(ctx context.Context, query string, args ...any) ([]*Location, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	locations := []*Location{}
+	for rows.Next() {
+		l, err := scanLocation(rows)
+		if err != nil {
+			return nil, err
+		}
+		locations = append(locations, l)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return locations, nil
+}
+
+func (r *postgresRepository) UpdateLocation(ctx context.Context, l *Location) (*Location, error) {
+	res, err := r.db.ExecContext(ctx, `
+        UPDATE locations
+        SET name = $1, code = $2, class_id = $3, updated_at = $4
+        WHERE id = $5`, l.Name, l.Code, l.ClassID, l.UpdatedAt, l.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if rowsAffected == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	return r.GetLocationByID(ctx, l.ID)
+}
+
+func (r *postgresRepository) DeleteLocationByID(ctx context.Context, id string) error {
+	// Sub-locations restrict the delete; items in the location are left without one.
+	res, err := r.db.ExecContext(ctx, "DELETE FROM locations WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// --- Maintenance tickets ---
+
+const maintenanceTicketColumns = `id, item_id, schedule_id, kind, status, description, vendor, technician,
+               expected_return_at, cost_cents, closed_at, created_at, updated_at`
+
+func scanMaintenanceTicket(row interface{ Scan(...any) error }) (*MaintenanceTicket, error) {
+	t := &MaintenanceTicket{}
+	err := row.Scan(&t.ID, &t.ItemID, &t.ScheduleID, &t.Kind, &t.Status, &t.Description, &t.Vendor, &t.Technician,
+		&t.ExpectedReturnAt, &t.CostCents, &t.ClosedAt, &t.CreatedAt, &t.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// PutMaintenanceTicket inserts the ticket and puts its item in maintenance in a single
+// transaction.
+func (r *postgresRepository) PutMaintenanceTicket(ctx context.Context, t *MaintenanceTicket) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := lockItem(ctx, tx, t.ItemID); err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+        INSERT INTO maintenance_tickets(`+maintenanceTicketColumns+`)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`,
+		t.ID, t.ItemID, t.ScheduleID, t.Kind, t.Status, t.Description, t.Vendor, t.Technician,
+		t.ExpectedReturnAt, t.CostCents, t.ClosedAt, t.CreatedAt, t.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE items SET status = $1, updated_at = $2 WHERE id = $3",
+		ItemStatusInMaintenance, t.UpdatedAt, t.ItemID)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *postgresRepository) GetMaintenanceTicketByID(ctx context.Context, id string) (*MaintenanceTicket, error) {
+	row := r.db.QueryRowContext(ctx, "SELECT "+maintenanceTicketColumns+" FROM maintenance_tickets WHERE id = $1", id)
+	return scanMaintenanceTicket(row)
+}
+
+func (r *postgresRepository) ListMaintenanceTickets(ctx context.Context, skip uint64, take uint64, itemID *string, openOnly bool) ([]*MaintenanceTicket, error) {
+	where := []string{}
+	args := []any{}
+	if itemID != nil {
+		args = append(args, *itemID)
+		where = append(where, fmt.Sprintf("item_id = $%d", len(args)))
+	}
+	if openOnly {
+		args = append(args, TicketStatusOpen, TicketStatusInProgress)
+		where = append(where, fmt.Sprintf("status IN ($%d, $%d)", len(args)-1, len(args)))
+	}
+
+	query := "SELECT " + maintenanceTicketColumns + " FROM maintenance_tickets"
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	args = append(args, skip, take)
+	query += fmt.Sprintf(" ORDER BY id DESC OFFSET $%d LIMIT $%d", len(args)-1, len(args))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tickets := []*MaintenanceTicket{}
+	for rows.Next() {
+		t, err := scanMaintenanceTicket(rows)
+		if err != nil {
+			return nil, err
+		}
+		tickets = append(tickets, t)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return tickets, nil
+}
+
+// UpdateMaintenanceTicket saves t and, when no ticket of its item is open any more, makes
+// the item available again, in a single transaction. The item row is locked first, like
+// PutMaintenanceTicket does, so a ticket opened at the same time can't be overlooked.
+func (r *postgresRepository) UpdateMaintenanceTicket(ctx context.Context, t *MaintenanceTicket) (*MaintenanceTicket, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if err := lockItem(ctx, tx, t.ItemID); err != nil {
+		return nil, err
+	}
+
+	res, err := tx.ExecContext(ctx, `
+        UPDATE maintenance_tickets
+        SET status = $1, vendor = $2, technician = $3, expected_return_at = $4, cost_cents = $5,
+            closed_at = $6, updated_at = $7
+        WHERE id = $8`,
+		t.Status, t.Vendor, t.Technician, t.ExpectedReturnAt, t.CostCents, t.ClosedAt, t.UpdatedAt, t.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if rowsAffected == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	if !t.IsOpen() {
+		_, err = tx.ExecContext(ctx, `
+            UPDATE items SET status = $1, updated_at = $2
+            WHERE id = $3 AND status <> $1 AND NOT EXISTS (
+                SELECT 1 FROM maintenance_tickets WHERE item_id = $3 AND status IN ($4, $5)
+            )`,
+			ItemStatusAvailable, t.UpdatedAt, t.ItemID, TicketStatusOpen, TicketStatusInProgress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return r.GetMaintenanceTicketByID(ctx, t.ID)
+}
+
+// lockItem locks the row of an item until tx ends, serialising the changes to its tickets.
+func lockItem(ctx context.Context, tx *sql.Tx, id string) error {
+	var locked string
+	return tx.QueryRowContext(ctx, "SELECT id FROM items WHERE id = $1 FOR UPDATE", id).Scan(&locked)
+}
+
+// --- Maintenance schedules ---
+
+const maintenanceScheduleColumns = `id, item_id, description, interval_months, next_due_at, last_performed_at, created_at, updated_at`
+
+func scanMaintenanceSchedule(row interface{ Scan(...any) error }) (*MaintenanceSchedule, error) {
+	m := &MaintenanceSchedule{}
+	err := row.Scan(&m.ID, &m.ItemID, &m.Description, &m.IntervalMonths, &m.NextDueAt, &m.LastPerformedAt,
+		&m.CreatedAt, &m.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (r *postgresRepository) PutMaintenanceSchedule(ctx context.Context, m *MaintenanceSchedule) error {
+	_, err := r.db.ExecContext(ctx, `
+        INSERT INTO maintenance_schedules(`+maintenanceScheduleColumns+`)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		m.ID, m.ItemID, m.Description, m.IntervalMonths, m.NextDueAt, m.LastPerformedAt, m.CreatedAt, m.UpdatedAt)
+	return err
+}
+
+func (r *postgresRepository) GetMaintenanceScheduleByID(ctx context.Context, id string) (*MaintenanceSchedule, error) {
+	row := r.db.QueryRowContext(ctx, "SELECT "+maintenanceScheduleColumns+" FROM maintenance_schedules WHERE id = $1", id)
+	return scanMaintenanceSchedule(row)
+}
+
+func (r *postgresRepository) ListMaintenanceSchedules(ctx context.Context, skip uint64, take uint64, dueBefore *time.Time) ([]*MaintenanceSchedule, error) {
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT `+maintenanceScheduleColumns+`
+        FROM maintenance_schedules
+        WHERE $1::timestamptz IS NULL OR next_due_at <= $1
+        ORDER BY next_due_at ASC
+        OFFSET $2 LIMIT $3`, dueBefore, skip, take)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	schedules := []*MaintenanceSchedule{}
+	for rows.Next() {
+		m, err := scanMaintenanceSchedule(rows)
+		if err != nil {
+			return nil, err
+		}
+		schedules = append(schedules, m)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return schedules, nil
+}
+
+func (r *postgresRepository) UpdateMaintenanceSchedule(ctx context.Context, m *MaintenanceSchedule) error {
+	_, err := r.db.ExecContext(ctx, `
+        UPDATE maintenance_schedules
+        SET description = $1, interval_months = $2, next_due_at = $3, last_performed_at = $4, updated_at = $5
+        WHERE id = $6`,
+		m.Description, m.IntervalMonths, m.NextDueAt, m.LastPerformedAt, m.UpdatedAt, m.ID)
+	return err
+}
+
+func (r *postgresRepository) DeleteMaintenanceScheduleByID(ctx context.Context, id string) error {
+	res, err := r.db.ExecContext(ctx, "DELETE FROM maintenance_schedules WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// --- Consumables ---
+
+// consumableSelect computes the on-hand quantity from the per-location stock levels.
+const consumableSelect = `
+        SELECT c.id, c.sku, c.name, c.unit, c.low_stock_threshold, COALESCE(SUM(l.quantity), 0), c.created_at, c.updated_at
+        FROM consumables c
+        LEFT JOIN stock_levels l ON l.consumable_id = c.id`
+
+func scanConsumable(row interface{ Scan(...any) error }) (*Consumable, error) {
+	c := &Consumable{}
+	if err := row.Scan(&c.ID, &c.SKU, &c.Name, &c.Unit, &c.LowStockThreshold, &c.OnHand, &c.CreatedAt, &c.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (r *postgresRepository) PutConsumable(ctx context.Context, c *Consumable) error {
+	_, err := r.db.ExecContext(ctx, `
+        INSERT INTO consumables(id, sku, name, unit, low_stock_threshold, created_at, updated_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		c.ID, c.SKU, c.Name, c.Unit, c.LowStockThreshold, c.CreatedAt, c.UpdatedAt)
+	return err
+}
+
+func (r *postgresRepository) GetConsumableByID(ctx context.Context, id string) (*Consumable, error) {
+	row := r.db.QueryRowContext(ctx, consumableSelect+`
+        WHERE c.id = $1
+        GROUP BY c.id`, id)
+	return scanConsumable(row)
+}
+
+func (r *postgresRepository) ListConsumables(ctx context.Context, skip uint64, take uint64, lowStockOnly bool) ([]*Consumable, error) {
+	rows, err := r.db.QueryContext(ctx, consumableSelect+`
+        GROUP BY c.id
+        HAVING NOT $1 OR COALESCE(SUM(l.quantity), 0) <= c.low_stock_threshold
+        ORDER BY c.id DESC
+        OFFSET $2 LIMIT $3`, lowStockOnly, skip, take)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	consumables := []*Consumable{}
+	for rows.Next() {
+		c, err := scanConsumable(rows)
+		if err != nil {
+			return nil, err
+		}
+		consumables = append(consumables, c)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return consumables, nil
+}
+
+func (r *postgresRepository) UpdateConsumable(ctx context.Context, c *Consumable) (*Consumable, error) {
+	res, err := r.db.ExecContext(ctx, `
+        UPDATE consumables
+        SET sku = $1, name = $2, unit = $3, low_stock_threshold = $4, updated_at = $5
+        WHERE id = $6`, c.SKU, c.Name, c.Unit, c.LowStockThreshold, c.UpdatedAt, c.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if rowsAffected == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	return r.GetConsumableByID(ctx, c.ID)
+}
+
+func (r *postgresRepository) DeleteConsumableByID(ctx context.Context, id string) error {
+	res, err := r.db.ExecContext(ctx, "DELETE FROM consumables WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// PutStockMovement appends the movement to the ledger and applies it to the stock level
+// of its location in a single transaction, so the two can never drift apart. The level is
+// changed by adding to it in the upsert itself, two movements that race for a location
+// without a level yet can't both write their own quantity over the other.
+func (r *postgresRepository) PutStockMovement(ctx context.Context, m *StockMovement) (*StockLevel, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	level := &StockLevel{ConsumableID: m.ConsumableID, LocationID: m.LocationID, UpdatedAt: m.CreatedAt}
+	err = tx.QueryRowContext(ctx, `
+        INSERT INTO stock_levels(consumable_id, location_id, quantity, updated_at)
+        VALUES ($1, $2, $3, $4)
+        ON CONFLICT (consumable_id, location_id)
+        DO UPDATE SET quantity = stock_levels.quantity + EXCLUDED.quantity, updated_at = EXCLUDED.updated_at
+        RETURNING quantity`,
+		m.ConsumableID, m.LocationID, m.Quantity, m.CreatedAt).Scan(&level.Quantity)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23514" { // check_violation, quantity >= 0
+		return nil, ErrInsufficientStock
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+        INSERT INTO stock_movements(id, consumable_id, location_id, kind, quantity, note, created_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		m.ID, m.ConsumableID, m.LocationID, m.Kind, m.Quantity, m.Note, m.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return level, nil
+}
+
+func (r *postgresRepository) ListStockLevels(ctx context.Context, consumableID string) ([]*StockLevel, error) {
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT consumable_id, location_id, quantity, updated_at
+        FROM stock_levels
+        WHERE consumable_id = $1
+        ORDER BY location_id`, consumableID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	levels := []*StockLevel{}
+	for rows.Next() {
+		l := &StockLevel{}
+		if err := rows.Scan(&l.ConsumableID, &l.LocationID, &l.Quantity, &l.UpdatedAt); err != nil {
+			return nil, err
+		}
+		levels = append(levels, l)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return levels, nil
+}
+
+func (r *postgresRepository) ListStockMovements(ctx context.Context, skip uint64, take uint64, consumableID string) ([]*StockMovement, error) {
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT id, consumable_id, location_id, kind, quantity, note, created_at
+        FROM stock_movements
+        WHERE consumable_id = $1
+        ORDER BY id DESC
+        OFFSET $2 LIMIT $3`, consumableID, skip, take)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	movements := []*StockMovement{}
+	for rows.Next() {
+		m := &StockMovement{}
+		if err := rows.Scan(&m.ID, &m.ConsumableID, &m.LocationID, &m.Kind, &m.Quantity, &m.Note, &m.CreatedAt); err != nil {
+			return nil, err
+		}
+		movements = append(movements, m)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return movements, nil
+}
@@ -1 +1,554 @@
 package inventory
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/jochem11/inventory-system-back/internal/logging"
+	"github.com/jochem11/inventory-system-back/internal/metrics"
+	"github.com/jochem11/inventory-system-back/internal/serve"
+	"github.com/jochem11/inventory-system-back/internal/tracing"
+	"github.com/jochem11/inventory-system-back/inventory/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type grpcServer struct {
+	pb.UnimplementedInventoryServiceServer
+	service Service
+}
+
+// ListenGRPC serves s until ctx is cancelled and then drains in-flight RPCs.
+// The grpc.health.v1 status follows the database connection.
+func ListenGRPC(ctx context.Context, s Service, r Repository, opts serve.GRPCOptions) error {
+	serv := grpc.NewServer(
+		grpc.Creds(opts.Creds),
+		tracing.ServerOption(),
+		grpc.ChainUnaryInterceptor(
+			logging.UnaryServerInterceptor(),
+			metrics.GRPCServer.UnaryServerInterceptor(),
+			serve.TimeoutInterceptor(opts.Timeout),
+		),
+		grpc.ChainStreamInterceptor(logging.StreamServerInterceptor(), metrics.GRPCServer.StreamServerInterceptor()),
+	)
+	pb.RegisterInventoryServiceServer(serv, &grpcServer{
+		service: s,
+	})
+	metrics.InitGRPCServer(serv)
+	serve.RegisterHealth(ctx, serv, pb.InventoryService_ServiceDesc.ServiceName, r.Ping)
+	return serve.GRPC(ctx, serv, opts.Port, opts.Drain)
+}
+
+// --- Item Methods ---
+
+func (s *grpcServer) PostItem(ctx context.Context, req *pb.PostItemRequest) (*pb.PostItemResponse, error) {
+	i, err := s.service.PostItem(ctx, req.Name, req.AssetTag, req.Description, req.ItemType, req.LocationId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PostItemResponse{Item: itemToProto(i)}, nil
+}
+
+func (s *grpcServer) GetItem(ctx context.Context, req *pb.GetItemRequest) (*pb.GetItemResponse, error) {
+	i, err := s.service.GetItem(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetItemResponse{Item: itemToProto(i)}, nil
+}
+
+func (s *grpcServer) GetItemByAssetTag(ctx context.Context, req *pb.GetItemByAssetTagRequest) (*pb.GetItemResponse, error) {
+	i, err := s.service.GetItemByAssetTag(ctx, req.AssetTag)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "item not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetItemResponse{Item: itemToProto(i)}, nil
+}
+
+func (s *grpcServer) GetItems(ctx context.Context, req *pb.GetItemsRequest) (*pb.GetItemsResponse, error) {
+	res, err := s.service.GetItems(ctx, &req.Skip, &req.Take)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []*pb.Item{}
+	for _, i := range res {
+		items = append(items, itemToProto(i))
+	}
+
+	return &pb.GetItemsResponse{Items: items}, nil
+}
+
+func (s *grpcServer) GetItemsByIDs(ctx context.Context, req *pb.GetItemsByIDsRequest) (*pb.GetItemsResponse, error) {
+	res, err := s.service.GetItemsByIDs(ctx, req.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []*pb.Item{}
+	for _, i := range res {
+		items = append(items, itemToProto(i))
+	}
+
+	return &pb.GetItemsResponse{Items: items}, nil
+}
+
+func (s *grpcServer) UpdateItem(ctx context.Context, req *pb.UpdateItemRequest) (*pb.UpdateItemResponse, error) {
+	i, err := s.service.UpdateItem(ctx, req.Id, req.Name, req.AssetTag, req.Description, req.ItemType)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateItemResponse{Item: itemToProto(i)}, nil
+}
+
+func (s *grpcServer) GetItemTypeCounts(ctx context.Context, req *pb.GetItemTypeCountsRequest) (*pb.GetItemTypeCountsResponse, error) {
+	res, err := s.service.GetItemTypeCounts(ctx, req.ItemTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := []*pb.ItemTypeCount{}
+	for _, c := range res {
+		counts = append(counts, &pb.ItemTypeCount{
+			ItemType: c.ItemType,
+			Total:    uint32(c.Total),
+			Lendable: uint32(c.Lendable),
+		})
+	}
+	return &pb.GetItemTypeCountsResponse{Counts: counts}, nil
+}
+
+func (s *grpcServer) DeleteItem(ctx context.Context, req *pb.DeleteItemRequest) (*pb.DeleteItemResponse, error) {
+	err := s.service.DeleteItemByID(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeleteItemResponse{}, nil
+}
+
+func (s *grpcServer) CheckItemLendable(ctx context.Context, req *pb.CheckItemLendableRequest) (*pb.CheckItemLendableResponse, error) {
+	err := s.service.CheckItemLendable(ctx, req.Id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "item not found")
+	}
+	if errors.Is(err, ErrItemUnderMaintenance) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CheckItemLendableResponse{}, nil
+}
+
+func (s *grpcServer) MoveItem(ctx context.Context, req *pb.MoveItemRequest) (*pb.MoveItemResponse, error) {
+	i, m, err := s.service.MoveItem(ctx, req.ItemId, req.LocationId, req.Note)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.MoveItemResponse{Item: itemToProto(i), Move: itemMoveToProto(m)}, nil
+}
+
+func (s *grpcServer) GetItemMoves(ctx context.Context, req *pb.GetItemMovesRequest) (*pb.GetItemMovesResponse, error) {
+	res, err := s.service.GetItemMoves(ctx, &req.Skip, &req.Take, req.ItemId)
+	if err != nil {
+		return nil, err
+	}
+
+	moves := []*pb.ItemMove{}
+	for _, m := range res {
+		moves = append(moves, itemMoveToProto(m))
+	}
+
+	return &pb.GetItemMovesResponse{Moves: moves}, nil
+}
+
+func (s *grpcServer) GetItemsInLocation(ctx context.Context, req *pb.GetItemsInLocationRequest) (*pb.GetItemsResponse, error) {
+	res, err := s.service.GetItemsInLocation(ctx, &req.Skip, &req.Take, req.LocationId, req.IncludeSubLocations)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []*pb.Item{}
+	for _, i := range res {
+		items = append(items, itemToProto(i))
+	}
+
+	return &pb.GetItemsResponse{Items: items}, nil
+}
+
+func (s *grpcServer) GetItemsByClass(ctx context.Context, req *pb.GetItemsByClassRequest) (*pb.GetItemsResponse, error) {
+	res, err := s.service.GetItemsByClass(ctx, &req.Skip, &req.Take, req.ClassId)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []*pb.Item{}
+	for _, i := range res {
+		items = append(items, itemToProto(i))
+	}
+
+	return &pb.GetItemsResponse{Items: items}, nil
+}
+
+// --- Location Methods ---
+
+func (s *grpcServer) PostLocation(ctx context.Context, req *pb.PostLocationRequest) (*pb.PostLocationResponse, error) {
+	l, err := s.service.PostLocation(ctx, req.ParentId, req.Kind, req.Name, req.Code, req.ClassId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PostLocationResponse{Location: locationToProto(l)}, nil
+}
+
+func (s *grpcServer) GetLocation(ctx context.Context, req *pb.GetLocationRequest) (*pb.GetLocationResponse, error) {
+	l, err := s.service.GetLocation(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetLocationResponse{Location: locationToProto(l)}, nil
+}
+
+func (s *grpcServer) GetLocations(ctx context.Context, req *pb.GetLocationsRequest) (*pb.GetLocationsResponse, error) {
+	res, err := s.service.GetLocations(ctx, &req.Skip, &req.Take, req.ParentId, req.Code, req.ClassId)
+	if err != nil {
+		return nil, err
+	}
+
+	locations := []*pb.Location{}
+	for _, l := range res {
+		locations = append(locations, locationToProto(l))
+	}
+
+	return &pb.GetLocationsResponse{Locations: locations}, nil
+}
+
+func (s *grpcServer) GetLocationsByIDs(ctx context.Context, req *pb.GetLocationsByIDsRequest) (*pb.GetLocationsResponse, error) {
+	res, err := s.service.GetLocationsByIDs(ctx, req.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	locations := []*pb.Location{}
+	for _, l := range res {
+		locations = append(locations, locationToProto(l))
+	}
+
+	return &pb.GetLocationsResponse{Locations: locations}, nil
+}
+
+func (s *grpcServer) UpdateLocation(ctx context.Context, req *pb.UpdateLocationRequest) (*pb.UpdateLocationResponse, error) {
+	l, err := s.service.UpdateLocation(ctx, req.Id, req.Name, req.Code, req.ClassId, req.ClearClass)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateLocationResponse{Location: locationToProto(l)}, nil
+}
+
+func (s *grpcServer) DeleteLocation(ctx context.Context, req *pb.DeleteLocationRequest) (*pb.DeleteLocationResponse, error) {
+	err := s.service.DeleteLocationByID(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeleteLocationResponse{}, nil
+}
+
+// --- Maintenance Methods ---
+
+func (s *grpcServer) OpenMaintenanceTicket(ctx context.Context, req *pb.OpenMaintenanceTicketRequest) (*pb.OpenMaintenanceTicketResponse, error) {
+	t, err := s.service.OpenMaintenanceTicket(ctx, &MaintenanceTicket{
+		ItemID:           req.ItemId,
+		ScheduleID:       req.ScheduleId,
+		Kind:             req.Kind,
+		Description:      req.Description,
+		Vendor:           req.Vendor,
+		Technician:       req.Technician,
+		ExpectedReturnAt: timeFromProto(req.ExpectedReturnAt),
+		CostCents:        req.CostCents,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.OpenMaintenanceTicketResponse{Ticket: maintenanceTicketToProto(t)}, nil
+}
+
+func (s *grpcServer) GetMaintenanceTicket(ctx context.Context, req *pb.GetMaintenanceTicketRequest) (*pb.GetMaintenanceTicketResponse, error) {
+	t, err := s.service.GetMaintenanceTicket(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetMaintenanceTicketResponse{Ticket: maintenanceTicketToProto(t)}, nil
+}
+
+func (s *grpcServer) GetMaintenanceTickets(ctx context.Context, req *pb.GetMaintenanceTicketsRequest) (*pb.GetMaintenanceTicketsResponse, error) {
+	res, err := s.service.GetMaintenanceTickets(ctx, &req.Skip, &req.Take, req.ItemId, req.OpenOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	tickets := []*pb.MaintenanceTicket{}
+	for _, t := range res {
+		tickets = append(tickets, maintenanceTicketToProto(t))
+	}
+
+	return &pb.GetMaintenanceTicketsResponse{Tickets: tickets}, nil
+}
+
+func (s *grpcServer) UpdateMaintenanceTicket(ctx context.Context, req *pb.UpdateMaintenanceTicketRequest) (*pb.UpdateMaintenanceTicketResponse, error) {
+	t, err := s.service.UpdateMaintenanceTicket(
+		ctx,
+		req.Id,
+		req.Status,
+		req.Vendor,
+		req.Technician,
+		timeFromProto(req.ExpectedReturnAt),
+		req.CostCents)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateMaintenanceTicketResponse{Ticket: maintenanceTicketToProto(t)}, nil
+}
+
+func (s *grpcServer) PostMaintenanceSchedule(ctx context.Context, req *pb.PostMaintenanceScheduleRequest) (*pb.PostMaintenanceScheduleResponse, error) {
+	m, err := s.service.PostMaintenanceSchedule(ctx, req.ItemId, req.Description, req.IntervalMonths, timeFromProto(req.FirstDueAt))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PostMaintenanceScheduleResponse{Schedule: maintenanceScheduleToProto(m)}, nil
+}
+
+func (s *grpcServer) GetMaintenanceSchedules(ctx context.Context, req *pb.GetMaintenanceSchedulesRequest) (*pb.GetMaintenanceSchedulesResponse, error) {
+	res, err := s.service.GetMaintenanceSchedules(ctx, &req.Skip, &req.Take, timeFromProto(req.DueBefore))
+	if err != nil {
+		return nil, err
+	}
+
+	schedules := []*pb.MaintenanceSchedule{}
+	for _, m := range res {
+		schedules = append(schedules, maintenanceScheduleToProto(m))
+	}
+
+	return &pb.GetMaintenanceSchedulesResponse{Schedules: schedules}, nil
+}
+
+func (s *grpcServer) DeleteMaintenanceSchedule(ctx context.Context, req *pb.DeleteMaintenanceScheduleRequest) (*pb.DeleteMaintenanceScheduleResponse, error) {
+	err := s.service.DeleteMaintenanceScheduleByID(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeleteMaintenanceScheduleResponse{}, nil
+}
+
+// --- Consumable Methods ---
+
+func (s *grpcServer) PostConsumable(ctx context.Context, req *pb.PostConsumableRequest) (*pb.PostConsumableResponse, error) {
+	c, err := s.service.PostConsumable(ctx, req.Sku, req.Name, req.Unit, req.LowStockThreshold)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PostConsumableResponse{Consumable: consumableToProto(c)}, nil
+}
+
+func (s *grpcServer) GetConsumable(ctx context.Context, req *pb.GetConsumableRequest) (*pb.GetConsumableResponse, error) {
+	c, err := s.service.GetConsumable(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetConsumableResponse{Consumable: consumableToProto(c)}, nil
+}
+
+func (s *grpcServer) GetConsumables(ctx context.Context, req *pb.GetConsumablesRequest) (*pb.GetConsumablesResponse, error) {
+	res, err := s.service.GetConsumables(ctx, &req.Skip, &req.Take, req.LowStockOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	consumables := []*pb.Consumable{}
+	for _, c := range res {
+		consumables = append(consumables, consumableToProto(c))
+	}
+
+	return &pb.GetConsumablesResponse{Consumables: consumables}, nil
+}
+
+func (s *grpcServer) UpdateConsumable(ctx context.Context, req *pb.UpdateConsumableRequest) (*pb.UpdateConsumableResponse, error) {
+	c, err := s.service.UpdateConsumable(ctx, req.Id, req.Sku, req.Name, req.Unit, req.LowStockThreshold)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateConsumableResponse{Consumable: consumableToProto(c)}, nil
+}
+
+func (s *grpcServer) DeleteConsumable(ctx context.Context, req *pb.DeleteConsumableRequest) (*pb.DeleteConsumableResponse, error) {
+	err := s.service.DeleteConsumableByID(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeleteConsumableResponse{}, nil
+}
+
+func (s *grpcServer) RecordStockMovement(ctx context.Context, req *pb.RecordStockMovementRequest) (*pb.RecordStockMovementResponse, error) {
+	m, l, err := s.service.RecordStockMovement(ctx, req.ConsumableId, req.LocationId, req.Kind, req.Quantity, req.Note)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RecordStockMovementResponse{
+		Movement: stockMovementToProto(m),
+		Level:    stockLevelToProto(l),
+	}, nil
+}
+
+func (s *grpcServer) GetStockLevels(ctx context.Context, req *pb.GetStockLevelsRequest) (*pb.GetStockLevelsResponse, error) {
+	res, err := s.service.GetStockLevels(ctx, req.ConsumableId)
+	if err != nil {
+		return nil, err
+	}
+
+	levels := []*pb.StockLevel{}
+	for _, l := range res {
+		levels = append(levels, stockLevelToProto(l))
+	}
+
+	return &pb.GetStockLevelsResponse{Levels: levels}, nil
+}
+
+func (s *grpcServer) GetStockMovements(ctx context.Context, req *pb.GetStockMovementsRequest) (*pb.GetStockMovementsResponse, error) {
+	res, err := s.service.GetStockMovements(ctx, &req.Skip, &req.Take, req.ConsumableId)
+	if err != nil {
+		return nil, err
+	}
+
+	movements := []*pb.StockMovement{}
+	for _, m := range res {
+		movements = append(movements, stockMovementToProto(m))
+	}
+
+	return &pb.GetStockMovementsResponse{Movements: movements}, nil
+}
+
+// --- Conversions ---
+
+func itemToProto(i *Item) *pb.Item {
+	return &pb.Item{
+		Id:          i.ID,
+		Name:        i.Name,
+		AssetTag:    i.AssetTag,
+		Description: i.Description,
+		Status:      i.Status,
+		Lendable:    i.Lendable(),
+		LocationId:  i.LocationID,
+		CreatedAt:   timestamppb.New(i.CreatedAt),
+		UpdatedAt:   timestamppb.New(i.UpdatedAt),
+		ItemType:    i.ItemType,
+	}
+}
+
+func itemMoveToProto(m *ItemMove) *pb.ItemMove {
+	return &pb.ItemMove{
+		Id:             m.ID,
+		ItemId:         m.ItemID,
+		FromLocationId: m.FromLocationID,
+		ToLocationId:   m.ToLocationID,
+		Note:           m.Note,
+		MovedAt:        timestamppb.New(m.MovedAt),
+	}
+}
+
+func locationToProto(l *Location) *pb.Location {
+	return &pb.Location{
+		Id:        l.ID,
+		ParentId:  l.ParentID,
+		Kind:      l.Kind,
+		Name:      l.Name,
+		Code:      l.Code,
+		ClassId:   l.ClassID,
+		CreatedAt: timestamppb.New(l.CreatedAt),
+		UpdatedAt: timestamppb.New(l.UpdatedAt),
+	}
+}
+
+func maintenanceTicketToProto(t *MaintenanceTicket) *pb.MaintenanceTicket {
+	return &pb.MaintenanceTicket{
+		Id:               t.ID,
+		ItemId:           t.ItemID,
+		ScheduleId:       t.ScheduleID,
+		Kind:             t.Kind,
+		Status:           t.Status,
+		Description:      t.Description,
+		Vendor:           t.Vendor,
+		Technician:       t.Technician,
+		ExpectedReturnAt: timeToProto(t.ExpectedReturnAt),
+		CostCents:        t.CostCents,
+		ClosedAt:         timeToProto(t.ClosedAt),
+		CreatedAt:        timestamppb.New(t.CreatedAt),
+		UpdatedAt:        timestamppb.New(t.UpdatedAt),
+	}
+}
+
+func maintenanceScheduleToProto(m *MaintenanceSchedule) *pb.MaintenanceSchedule {
+	return &pb.MaintenanceSchedule{
+		Id:              m.ID,
+		ItemId:          m.ItemID,
+		Description:     m.Description,
+		IntervalMonths:  m.IntervalMonths,
+		NextDueAt:       timestamppb.New(m.NextDueAt),
+		LastPerformedAt: timeToProto(m.LastPerformedAt),
+		CreatedAt:       timestamppb.New(m.CreatedAt),
+		UpdatedAt:       timestamppb.New(m.UpdatedAt),
+	}
+}
+
+func consumableToProto(c *Consumable) *pb.Consumable {
+	return &pb.Consumable{
+		Id:                c.ID,
+		Sku:               c.SKU,
+		Name:              c.Name,
+		Unit:              c.Unit,
+		LowStockThreshold: c.LowStockThreshold,
+		OnHand:            c.OnHand,
+		CreatedAt:         timestamppb.New(c.CreatedAt),
+		UpdatedAt:         timestamppb.New(c.UpdatedAt),
+	}
+}
+
+func stockLevelToProto(l *StockLevel) *pb.StockLevel {
+	return &pb.StockLevel{
+		ConsumableId: l.ConsumableID,
+		LocationId:   l.LocationID,
+		Quantity:     l.Quantity,
+		UpdatedAt:    timestamppb.New(l.UpdatedAt),
+	}
+}
+
+func stockMovementToProto(m *StockMovement) *pb.StockMovement {
+	return &pb.StockMovement{
+		Id:           m.ID,
+		ConsumableId: m.ConsumableID,
+		LocationId:   m.LocationID,
+		Kind:         m.Kind,
+		Quantity:     m.Quantity,
+		Note:         m.Note,
+		CreatedAt:    timestamppb.New(m.CreatedAt),
+	}
+}
+
+func timeToProto(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func timeFromProto(t *timestamppb.Timestamp) *time.Time {
+	if t == nil {
+		return nil
+	}
+	v := t.AsTime()
+	return &v
+}
@@ -1 +1,728 @@
 package inventory
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/jochem11/inventory-system-back/internal/config"
+	"github.com/segmentio/ksuid"
+)
+
+const (
+	ItemStatusAvailable     = "AVAILABLE"
+	ItemStatusInMaintenance = "IN_MAINTENANCE"
+)
+
+const (
+	MaintenanceKindRepair     = "REPAIR"
+	MaintenanceKindPreventive = "PREVENTIVE"
+)
+
+const (
+	TicketStatusOpen       = "OPEN"
+	TicketStatusInProgress = "IN_PROGRESS"
+	TicketStatusCompleted  = "COMPLETED"
+	TicketStatusCancelled  = "CANCELLED"
+)
+
+const (
+	LocationKindBuilding = "BUILDING"
+	LocationKindRoom     = "ROOM"
+	LocationKindCabinet  = "CABINET"
+	LocationKindShelf    = "SHELF"
+)
+
+// locationDepth orders the location kinds from the top of the tree down; a location
+// may only be placed under a parent of a shallower kind.
+var locationDepth = map[string]int{
+	LocationKindBuilding: 0,
+	LocationKindRoom:     1,
+	LocationKindCabinet:  2,
+	LocationKindShelf:    3,
+}
+
+const (
+	StockMovementReceive  = "RECEIVE"
+	StockMovementIssue    = "ISSUE"
+	StockMovementAdjust   = "ADJUST"
+	StockMovementWriteOff = "WRITE_OFF"
+)
+
+var (
+	ErrItemUnderMaintenance    = errors.New("item is under maintenance")
+	ErrInvalidMaintenanceKind  = errors.New("invalid maintenance kind")
+	ErrInvalidStatusTransition = errors.New("invalid maintenance ticket status transition")
+	ErrInvalidInterval         = errors.New("maintenance interval must be at least one month")
+	ErrInvalidMovementKind     = errors.New("invalid stock movement kind")
+	ErrInvalidQuantity         = errors.New("invalid stock movement quantity")
+	ErrInsufficientStock       = errors.New("insufficient stock at location")
+	ErrInvalidLocationKind     = errors.New("invalid location kind")
+	ErrInvalidLocationParent   = errors.New("location kind does not fit under its parent")
+)
+
+// ticketTransitions lists the statuses a ticket may move to from its current status.
+var ticketTransitions = map[string][]string{
+	TicketStatusOpen:       {TicketStatusInProgress, TicketStatusCompleted, TicketStatusCancelled},
+	TicketStatusInProgress: {TicketStatusCompleted, TicketStatusCancelled},
+}
+
+type Service interface {
+	PostItem(ctx context.Context, name, assetTag, description, itemType string, locationID *string) (*Item, error)
+	GetItem(ctx context.Context, id string) (*Item, error)
+	GetItemByAssetTag(ctx context.Context, assetTag string) (*Item, error)
+	GetItems(ctx context.Context, skip *uint64, take *uint64) ([]*Item, error)
+	GetItemsByIDs(ctx context.Context, ids []string) ([]*Item, error)
+	UpdateItem(ctx context.Context, id string, name, assetTag, description, itemType *string) (*Item, error)
+	GetItemTypeCounts(ctx context.Context, itemTypes []string) ([]*ItemTypeCount, error)
+	DeleteItemByID(ctx context.Context, id string) error
+	CheckItemLendable(ctx context.Context, id string) error
+	MoveItem(ctx context.Context, id string, locationID *string, note string) (*Item, *ItemMove, error)
+	GetItemMoves(ctx context.Context, skip *uint64, take *uint64, itemID string) ([]*ItemMove, error)
+	GetItemsInLocation(ctx context.Context, skip *uint64, take *uint64, locationID string, includeSubLocations bool) ([]*Item, error)
+	GetItemsByClass(ctx context.Context, skip *uint64, take *uint64, classID string) ([]*Item, error)
+
+	PostLocation(ctx context.Context, parentID *string, kind, name, code string, classID *string) (*Location, error)
+	GetLocation(ctx context.Context, id string) (*Location, error)
+	GetLocations(ctx context.Context, skip *uint64, take *uint64, parentID, code, classID *string) ([]*Location, error)
+	GetLocationsByIDs(ctx context.Context, ids []string) ([]*Location, error)
+	UpdateLocation(ctx context.Context, id string, name, code, classID *string, clearClass bool) (*Location, error)
+	DeleteLocationByID(ctx context.Context, id string) error
+
+	OpenMaintenanceTicket(ctx context.Context, t *MaintenanceTicket) (*MaintenanceTicket, error)
+	GetMaintenanceTicket(ctx context.Context, id string) (*MaintenanceTicket, error)
+	GetMaintenanceTickets(ctx context.Context, skip *uint64, take *uint64, itemID *string, openOnly bool) ([]*MaintenanceTicket, error)
+	UpdateMaintenanceTicket(ctx context.Context, id string, status, vendor, technician *string, expectedReturnAt *time.Time, costCents *int64) (*MaintenanceTicket, error)
+
+	PostMaintenanceSchedule(ctx context.Context, itemID, description string, intervalMonths uint32, firstDueAt *time.Time) (*MaintenanceSchedule, error)
+	GetMaintenanceSchedules(ctx context.Context, skip *uint64, take *uint64, dueBefore *time.Time) ([]*MaintenanceSchedule, error)
+	DeleteMaintenanceScheduleByID(ctx context.Context, id string) error
+
+	PostConsumable(ctx context.Context, sku, name, unit string, lowStockThreshold int64) (*Consumable, error)
+	GetConsumable(ctx context.Context, id string) (*Consumable, error)
+	GetConsumables(ctx context.Context, skip *uint64, take *uint64, lowStockOnly bool) ([]*Consumable, error)
+	UpdateConsumable(ctx context.Context, id string, sku, name, unit *string, lowStockThreshold *int64) (*Consumable, error)
+	DeleteConsumableByID(ctx context.Context, id string) error
+	RecordStockMovement(ctx context.Context, consumableID, locationID, kind string, quantity int64, note string) (*StockMovement, *StockLevel, error)
+	GetStockLevels(ctx context.Context, consumableID string) ([]*StockLevel, error)
+	GetStockMovements(ctx context.Context, skip *uint64, take *uint64, consumableID string) ([]*StockMovement, error)
+}
+
+func NewInventoryService(r Repository, paging config.Paging) Service {
+	return &inventoryService{r, paging}
+}
+
+type Item struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	AssetTag    string    `json:"asset_tag"`
+	Description string    `json:"description"`
+	Status      string    `json:"status"`
+	LocationID  *string   `json:"location_id,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	ItemType    string    `json:"item_type"` // empty for items that aren't interchangeable
+}
+
+// ItemTypeCount counts the items of a type, Lendable those available now.
+type ItemTypeCount struct {
+	ItemType string
+	Total    int
+	Lendable int
+}
+
+// Lendable reports whether the item may currently be lent out.
+func (i *Item) Lendable() bool {
+	return i.Status == ItemStatusAvailable
+}
+
+type ItemMove struct {
+	ID             string    `json:"id"`
+	ItemID         string    `json:"item_id"`
+	FromLocationID *string   `json:"from_location_id,omitempty"`
+	ToLocationID   *string   `json:"to_location_id,omitempty"`
+	Note           string    `json:"note"`
+	MovedAt        time.Time `json:"moved_at"`
+}
+
+type Location struct {
+	ID        string    `json:"id"`
+	ParentID  *string   `json:"parent_id,omitempty"`
+	Kind      string    `json:"kind"`
+	Name      string    `json:"name"`
+	Code      string    `json:"code"`
+	ClassID   *string   `json:"class_id,omitempty"` // optional: education.Class whose homeroom this is
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type MaintenanceTicket struct {
+	ID               string     `json:"id"`
+	ItemID           string     `json:"item_id"`
+	ScheduleID       *string    `json:"schedule_id,omitempty"`
+	Kind             string     `json:"kind"`
+	Status           string     `json:"status"`
+	Description      string     `json:"description"`
+	Vendor           string     `json:"vendor"`
+	Technician       string     `json:"technician"`
+	ExpectedReturnAt *time.Time `json:"expected_return_at,omitempty"`
+	CostCents        int64      `json:"cost_cents"`
+	ClosedAt         *time.Time `json:"closed_at,omitempty"`
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+}
+
+// IsOpen reports whether the ticket still blocks the item from being lent.
+func (t *MaintenanceTicket) IsOpen() bool {
+	return t.Status == TicketStatusOpen || t.Status == TicketStatusInProgress
+}
+
+type MaintenanceSchedule struct {
+	ID              string     `json:"id"`
+	ItemID          string     `json:"item_id"`
+	Description     string     `json:"description"`
+	IntervalMonths  uint32     `json:"interval_months"`
+	NextDueAt       time.Time  `json:"next_due_at"`
+	LastPerformedAt *time.Time `json:"last_performed_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+type Consumable struct {
+	ID                string    `json:"id"`
+	SKU               string    `json:"sku"`
+	Name              string    `json:"name"`
+	Unit              string    `json:"unit"`
+	LowStockThreshold int64     `json:"low_stock_threshold"`
+	OnHand            int64     `json:"on_hand"` // sum of the stock levels over all locations
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+// LowStock reports whether the on-hand quantity has dropped to or below the threshold.
+func (c *Consumable) LowStock() bool {
+	return c.OnHand <= c.LowStockThreshold
+}
+
+type StockLevel struct {
+	ConsumableID string    `json:"consumable_id"`
+	LocationID   string    `json:"location_id"`
+	Quantity     int64     `json:"quantity"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// StockMovement is a single ledger entry. Quantity is the signed change it made to the
+// stock level, so issues and write-offs are stored as negative numbers.
+type StockMovement struct {
+	ID           string    `json:"id"`
+	ConsumableID string    `json:"consumable_id"`
+	LocationID   string    `json:"location_id"`
+	Kind         string    `json:"kind"`
+	Quantity     int64     `json:"quantity"`
+	Note         string    `json:"note"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type inventoryService struct {
+	repository Repository
+	paging     config.Paging
+}
+
+func (s *inventoryService) defaultSkipTake(skip *uint64, take *uint64) (*uint64, *uint64, error) {
+	if skip == nil {
+		skip = new(uint64)
+	}
+	t, err := s.paging.Take(take)
+	if err != nil {
+		return nil, nil, err
+	}
+	return skip, &t, nil
+}
+
+func (s *inventoryService) PostItem(ctx context.Context, name, assetTag, description, itemType string, locationID *string) (*Item, error) {
+	i := &Item{
+		ID:          ksuid.New().String(),
+		Name:        name,
+		AssetTag:    assetTag,
+		Description: description,
+		ItemType:    itemType,
+		Status:      ItemStatusAvailable,
+		LocationID:  locationID,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+
+	if err := s.repository.PutItem(ctx, i); err != nil {
+		return nil, err
+	}
+	return i, nil
+}
+
+func (s *inventoryService) GetItem(ctx context.Context, id string) (*Item, error) {
+	return s.repository.GetItemByID(ctx, id)
+}
+
+func (s *inventoryService) GetItemByAssetTag(ctx context.Context, assetTag string) (*Item, error) {
+	return s.repository.GetItemByAssetTag(ctx, assetTag)
+}
+
+func (s *inventoryService) GetItems(ctx context.Context, skip *uint64, take *uint64) ([]*Item, error) {
+	skip, take, err := s.defaultSkipTake(skip, take)
+	if err != nil {
+		return nil, err
+	}
+	return s.repository.ListItems(ctx, *skip, *take)
+}
+
+func (s *inventoryService) GetItemsByIDs(ctx context.Context, ids []string) ([]*Item, error) {
+	return s.repository.ListItemsByIDs(ctx, ids)
+}
+
+func (s *inventoryService) UpdateItem(ctx context.Context, id string, name, assetTag, description, itemType *string) (*Item, error) {
+	existing, err := s.repository.GetItemByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if name != nil {
+		existing.Name = *name
+	}
+	if assetTag != nil {
+		existing.AssetTag = *assetTag
+	}
+	if description != nil {
+		existing.Description = *description
+	}
+	if itemType != nil {
+		existing.ItemType = *itemType
+	}
+
+	existing.UpdatedAt = time.Now()
+
+	return s.repository.UpdateItem(ctx, existing)
+}
+
+// GetItemTypeCounts counts the items of each of itemTypes, types without
+// items count zero.
+func (s *inventoryService) GetItemTypeCounts(ctx context.Context, itemTypes []string) ([]*ItemTypeCount, error) {
+	found, err := s.repository.CountItemsByType(ctx, itemTypes)
+	if err != nil {
+		return nil, err
+	}
+	byType := map[string]*ItemTypeCount{}
+	for _, c := range found {
+		byType[c.ItemType] = c
+	}
+
+	counts := []*ItemTypeCount{}
+	for _, t := range itemTypes {
+		c, ok := byType[t]
+		if !ok {
+			c = &ItemTypeCount{ItemType: t}
+		}
+		counts = append(counts, c)
+	}
+	return counts, nil
+}
+
+func (s *inventoryService) DeleteItemByID(ctx context.Context, id string) error {
+	return s.repository.DeleteItemByID(ctx, id)
+}
+
+func (s *inventoryService) CheckItemLendable(ctx context.Context, id string) error {
+	i, err := s.repository.GetItemByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !i.Lendable() {
+		return ErrItemUnderMaintenance
+	}
+	return nil
+}
+
+func (s *inventoryService) MoveItem(ctx context.Context, id string, locationID *string, note string) (*Item, *ItemMove, error) {
+	m := &ItemMove{
+		ID:           ksuid.New().String(),
+		ItemID:       id,
+		ToLocationID: locationID,
+		Note:         note,
+		MovedAt:      time.Now(),
+	}
+
+	if err := s.repository.MoveItem(ctx, m); err != nil {
+		return nil, nil, err
+	}
+
+	i, err := s.repository.GetItemByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return i, m, nil
+}
+
+func (s *inventoryService) GetItemMoves(ctx context.Context, skip *uint64, take *uint64, itemID string) ([]*ItemMove, error) {
+	skip, take, err := s.defaultSkipTake(skip, take)
+	if err != nil {
+		return nil, err
+	}
+	return s.repository.ListItemMoves(ctx, *skip, *take, itemID)
+}
+
+func (s *inventoryService) GetItemsInLocation(ctx context.Context, skip *uint64, take *uint64, locationID string, includeSubLocations bool) ([]*Item, error) {
+	skip, take, err := s.defaultSkipTake(skip, take)
+	if err != nil {
+		return nil, err
+	}
+	return s.repository.ListItemsInLocation(ctx, *skip, *take, locationID, includeSubLocations)
+}
+
+func (s *inventoryService) GetItemsByClass(ctx context.Context, skip *uint64, take *uint64, classID string) ([]*Item, error) {
+	skip, take, err := s.defaultSkipTake(skip, take)
+	if err != nil {
+		return nil, err
+	}
+	return s.repository.ListItemsByClass(ctx, *skip, *take, classID)
+}
+
+func (s *inventoryService) PostLocation(ctx context.Context, parentID *string, kind, name, code string, classID *string) (*Location, error) {
+	depth, ok := locationDepth[kind]
+	if !ok {
+		return nil, ErrInvalidLocationKind
+	}
+
+	if parentID != nil {
+		parent, err := s.repository.GetLocationByID(ctx, *parentID)
+		if err != nil {
+			return nil, err
+		}
+		if locationDepth[parent.Kind] >= depth {
+			return nil, ErrInvalidLocationParent
+		}
+	}
+
+	l := &Location{
+		ID:        ksuid.New().String(),
+		ParentID:  parentID,
+		Kind:      kind,
+		Name:      name,
+		Code:      code,
+		ClassID:   classID,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	if err := s.repository.PutLocation(ctx, l); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (s *inventoryService) GetLocation(ctx context.Context, id string) (*Location, error) {
+	return s.repository.GetLocationByID(ctx, id)
+}
+
+func (s *inventoryService) GetLocations(ctx context.Context, skip *uint64, take *uint64, parentID, code, classID *string) ([]*Location, error) {
+	skip, take, err := s.defaultSkipTake(skip, take)
+	if err != nil {
+		return nil, err
+	}
+	return s.repository.ListLocations(ctx, *skip, *take, parentID, code, classID)
+}
+
+func (s *inventoryService) GetLocationsByIDs(ctx context.Context, ids []string) ([]*Location, error) {
+	return s.repository.ListLocationsByIDs(ctx, ids)
+}
+
+func (s *inventoryService) UpdateLocation(ctx context.Context, id string, name, code, classID *string, clearClass bool) (*Location, error) {
+	existing, err := s.repository.GetLocationByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if name != nil {
+		existing.Name = *name
+	}
+	if code != nil {
+		existing.Code = *code
+	}
+	if classID != nil {
+		existing.ClassID = classID
+	}
+	if clearClass {
+		existing.ClassID = nil
+	}
+
+	existing.UpdatedAt = time.Now()
+
+	return s.repository.UpdateLocation(ctx, existing)
+}
+
+func (s *inventoryService) DeleteLocationByID(ctx context.Context, id string) error {
+	return s.repository.DeleteLocationByID(ctx, id)
+}
+
+func (s *inventoryService) OpenMaintenanceTicket(ctx context.Context, t *MaintenanceTicket) (*MaintenanceTicket, error) {
+	if t.Kind != MaintenanceKindRepair && t.Kind != MaintenanceKindPreventive {
+		return nil, ErrInvalidMaintenanceKind
+	}
+
+	t.ID = ksuid.New().String()
+	t.Status = TicketStatusOpen
+	t.CreatedAt = time.Now()
+	t.UpdatedAt = time.Now()
+
+	if err := s.repository.PutMaintenanceTicket(ctx, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (s *inventoryService) GetMaintenanceTicket(ctx context.Context, id string) (*MaintenanceTicket, error) {
+	return s.repository.GetMaintenanceTicketByID(ctx, id)
+}
+
+func (s *inventoryService) GetMaintenanceTickets(ctx context.Context, skip *uint64, take *uint64, itemID *string, openOnly bool) ([]*MaintenanceTicket, error) {
+	skip, take, err := s.defaultSkipTake(skip, take)
+	if err != nil {
+		return nil, err
+	}
+	return s.repository.ListMaintenanceTickets(ctx, *skip, *take, itemID, openOnly)
+}
+
+func (s *inventoryService) UpdateMaintenanceTicket(ctx context.Context, id string, status, vendor, technician *string, expectedReturnAt *time.Time, costCents *int64) (*MaintenanceTicket, error) {
+	existing, err := s.repository.GetMaintenanceTicketByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	wasOpen := existing.IsOpen()
+
+	if status != nil && *status != existing.Status {
+		if !canTransition(existing.Status, *status) {
+			return nil, ErrInvalidStatusTransition
+		}
+		existing.Status = *status
+		if !existing.IsOpen() {
+			existing.ClosedAt = &now
+		}
+	}
+	if vendor != nil {
+		existing.Vendor = *vendor
+	}
+	if technician != nil {
+		existing.Technician = *technician
+	}
+	if expectedReturnAt != nil {
+		existing.ExpectedReturnAt = expectedReturnAt
+	}
+	if costCents != nil {
+		existing.CostCents = *costCents
+	}
+
+	existing.UpdatedAt = now
+
+	updated, err := s.repository.UpdateMaintenanceTicket(ctx, existing)
+	if err != nil {
+		return nil, err
+	}
+
+	// The repository released the item along with the update if this closed its last
+	// open ticket.
+	if wasOpen && !updated.IsOpen() {
+		if err := s.closeTicket(ctx, updated); err != nil {
+			return nil, err
+		}
+	}
+
+	return updated, nil
+}
+
+// closeTicket advances the preventive maintenance schedule a completed ticket was
+// opened for.
+func (s *inventoryService) closeTicket(ctx context.Context, t *MaintenanceTicket) error {
+	if t.ScheduleID == nil || t.Status != TicketStatusCompleted {
+		return nil
+	}
+
+	schedule, err := s.repository.GetMaintenanceScheduleByID(ctx, *t.ScheduleID)
+	if err != nil {
+		return err
+	}
+	performedAt := *t.ClosedAt
+	schedule.LastPerformedAt = &performedAt
+	schedule.NextDueAt = performedAt.AddDate(0, int(schedule.IntervalMonths), 0)
+	schedule.UpdatedAt = t.UpdatedAt
+
+	return s.repository.UpdateMaintenanceSchedule(ctx, schedule)
+}
+
+func canTransition(from, to string) bool {
+	for _, s := range ticketTransitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *inventoryService) PostMaintenanceSchedule(ctx context.Context, itemID, description string, intervalMonths uint32, firstDueAt *time.Time) (*MaintenanceSchedule, error) {
+	if intervalMonths == 0 {
+		return nil, ErrInvalidInterval
+	}
+
+	now := time.Now()
+	nextDueAt := now.AddDate(0, int(intervalMonths), 0)
+	if firstDueAt != nil {
+		nextDueAt = *firstDueAt
+	}
+
+	m := &MaintenanceSchedule{
+		ID:             ksuid.New().String(),
+		ItemID:         itemID,
+		Description:    description,
+		IntervalMonths: intervalMonths,
+		NextDueAt:      nextDueAt,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	if err := s.repository.PutMaintenanceSchedule(ctx, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (s *inventoryService) GetMaintenanceSchedules(ctx context.Context, skip *uint64, take *uint64, dueBefore *time.Time) ([]*MaintenanceSchedule, error) {
+	skip, take, err := s.defaultSkipTake(skip, take)
+	if err != nil {
+		return nil, err
+	}
+	return s.repository.ListMaintenanceSchedules(ctx, *skip, *take, dueBefore)
+}
+
+func (s *inventoryService) DeleteMaintenanceScheduleByID(ctx context.Context, id string) error {
+	return s.repository.DeleteMaintenanceScheduleByID(ctx, id)
+}
+
+func (s *inventoryService) PostConsumable(ctx context.Context, sku, name, unit string, lowStockThreshold int64) (*Consumable, error) {
+	c := &Consumable{
+		ID:                ksuid.New().String(),
+		SKU:               sku,
+		Name:              name,
+		Unit:              unit,
+		LowStockThreshold: lowStockThreshold,
+		CreatedAt:         time.Now(),
+		UpdatedAt:         time.Now(),
+	}
+
+	if err := s.repository.PutConsumable(ctx, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (s *inventoryService) GetConsumable(ctx context.Context, id string) (*Consumable, error) {
+	return s.repository.GetConsumableByID(ctx, id)
+}
+
+func (s *inventoryService) GetConsumables(ctx context.Context, skip *uint64, take *uint64, lowStockOnly bool) ([]*Consumable, error) {
+	skip, take, err := s.defaultSkipTake(skip, take)
+	if err != nil {
+		return nil, err
+	}
+	return s.repository.ListConsumables(ctx, *skip, *take, lowStockOnly)
+}
+
+func (s *inventoryService) UpdateConsumable(ctx context.Context, id string, sku, name, unit *string, lowStockThreshold *int64) (*Consumable, error) {
+	existing, err := s.repository.GetConsumableByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if sku != nil {
+		existing.SKU = *sku
+	}
+	if name != nil {
+		existing.Name = *name
+	}
+	if unit != nil {
+		existing.Unit = *unit
+	}
+	if lowStockThreshold != nil {
+		existing.LowStockThreshold = *lowStockThreshold
+	}
+
+	existing.UpdatedAt = time.Now()
+
+	return s.repository.UpdateConsumable(ctx, existing)
+}
+
+func (s *inventoryService) DeleteConsumableByID(ctx context.Context, id string) error {
+	return s.repository.DeleteConsumableByID(ctx, id)
+}
+
+func (s *inventoryService) RecordStockMovement(ctx context.Context, consumableID, locationID, kind string, quantity int64, note string) (*StockMovement, *StockLevel, error) {
+	switch kind {
+	case StockMovementReceive:
+		if quantity <= 0 {
+			return nil, nil, ErrInvalidQuantity
+		}
+	case StockMovementIssue, StockMovementWriteOff:
+		if quantity <= 0 {
+			return nil, nil, ErrInvalidQuantity
+		}
+		quantity = -quantity
+	case StockMovementAdjust:
+		// Adjustments carry their own sign: positive after a recount found more, negative otherwise.
+		if quantity == 0 {
+			return nil, nil, ErrInvalidQuantity
+		}
+	default:
+		return nil, nil, ErrInvalidMovementKind
+	}
+
+	m := &StockMovement{
+		ID:           ksuid.New().String(),
+		ConsumableID: consumableID,
+		LocationID:   locationID,
+		Kind:         kind,
+		Quantity:     quantity,
+		Note:         note,
+		CreatedAt:    time.Now(),
+	}
+
+	level, err := s.repository.PutStockMovement(ctx, m)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c, err := s.repository.GetConsumableByID(ctx, consumableID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if c.LowStock() {
+		slog.WarnContext(ctx, "Low stock",
+			"consumable_id", c.ID,
+			"sku", c.SKU,
+			"on_hand", c.OnHand,
+			"unit", c.Unit,
+			"threshold", c.LowStockThreshold,
+		)
+	}
+
+	return m, level, nil
+}
+
+func (s *inventoryService) GetStockLevels(ctx context.Context, consumableID string) ([]*StockLevel, error) {
+	return s.repository.ListStockLevels(ctx, consumableID)
+}
+
+func (s *inventoryService) GetStockMovements(ctx context.Context, skip *uint64, take *uint64, consumableID string) ([]*StockMovement, error) {
+	skip, take, err := s.defaultSkipTake(skip, take)
+	if err != nil {
+		return nil, err
+	}
+	return s.repository.ListStockMovements(ctx, *skip, *take, consumableID)
+}
@@ -0,0 +1,100 @@
+CREATE TABLE IF NOT EXISTS locations (
+    id CHAR(27) PRIMARY KEY,
+    parent_id CHAR(27) REFERENCES locations(id) ON DELETE RESTRICT,
+    kind VARCHAR(20) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    code VARCHAR(50) NOT NULL UNIQUE,
+    class_id CHAR(27),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS locations_parent_idx ON locations (parent_id);
+CREATE INDEX IF NOT EXISTS locations_class_idx ON locations (class_id);
+
+CREATE TABLE IF NOT EXISTS items (
+    id CHAR(27) PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    asset_tag VARCHAR(50) NOT NULL UNIQUE,
+    description TEXT NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL,
+    location_id CHAR(27) REFERENCES locations(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS items_location_idx ON items (location_id);
+
+CREATE TABLE IF NOT EXISTS item_moves (
+    id CHAR(27) PRIMARY KEY,
+    item_id CHAR(27) NOT NULL REFERENCES items(id) ON DELETE CASCADE,
+    from_location_id CHAR(27) REFERENCES locations(id) ON DELETE SET NULL,
+    to_location_id CHAR(27) REFERENCES locations(id) ON DELETE SET NULL,
+    note TEXT NOT NULL DEFAULT '',
+    moved_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS item_moves_item_idx ON item_moves (item_id, moved_at);
+
+CREATE TABLE IF NOT EXISTS maintenance_schedules (
+    id CHAR(27) PRIMARY KEY,
+    item_id CHAR(27) NOT NULL REFERENCES items(id) ON DELETE CASCADE,
+    description TEXT NOT NULL,
+    interval_months INTEGER NOT NULL CHECK (interval_months > 0),
+    next_due_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_performed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS maintenance_tickets (
+    id CHAR(27) PRIMARY KEY,
+    item_id CHAR(27) NOT NULL REFERENCES items(id) ON DELETE CASCADE,
+    schedule_id CHAR(27) REFERENCES maintenance_schedules(id) ON DELETE SET NULL,
+    kind VARCHAR(20) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    description TEXT NOT NULL,
+    vendor VARCHAR(100) NOT NULL DEFAULT '',
+    technician VARCHAR(100) NOT NULL DEFAULT '',
+    expected_return_at TIMESTAMP WITH TIME ZONE,
+    cost_cents BIGINT NOT NULL DEFAULT 0,
+    closed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS maintenance_tickets_item_status_idx ON maintenance_tickets (item_id, status);
+
+CREATE TABLE IF NOT EXISTS consumables (
+    id CHAR(27) PRIMARY KEY,
+    sku VARCHAR(50) NOT NULL UNIQUE,
+    name VARCHAR(100) NOT NULL,
+    unit VARCHAR(20) NOT NULL,
+    low_stock_threshold BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS stock_levels (
+    consumable_id CHAR(27) NOT NULL REFERENCES consumables(id) ON DELETE CASCADE,
+    location_id CHAR(27) NOT NULL REFERENCES locations(id) ON DELETE RESTRICT,
+    quantity BIGINT NOT NULL CHECK (quantity >= 0),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (consumable_id, location_id)
+);
+
+CREATE TABLE IF NOT EXISTS stock_movements (
+    id CHAR(27) PRIMARY KEY,
+    consumable_id CHAR(27) NOT NULL REFERENCES consumables(id) ON DELETE CASCADE,
+    location_id CHAR(27) NOT NULL REFERENCES locations(id) ON DELETE RESTRICT,
+    kind VARCHAR(20) NOT NULL,
+    quantity BIGINT NOT NULL,
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS stock_movements_consumable_idx ON stock_movements (consumable_id);
+
+ALTER TABLE items ADD COLUMN IF NOT EXISTS item_type VARCHAR(50) NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS items_type_idx ON items (item_type) WHERE item_type <> '';
@@ -0,0 +1,50 @@
+// Command oidcstub runs the oidctest provider, for logging in with single
+// sign-on when the school's provider can't be reached. Point the gateway's
+// OIDC_ISSUER at ISSUER and OIDC_CLIENT_ID at CLIENT_ID.
+package main
+
+import (
+	"fmt"
+	"github.com/jochem11/inventory-system-back/internal/config"
+	"github.com/jochem11/inventory-system-back/internal/oidc/oidctest"
+	"log"
+	"log/slog"
+	"net/http"
+)
+
+type Config struct {
+	Port     int    `envconfig:"PORT" default:"9000"`
+	Issuer   string `envconfig:"ISSUER" default:"http://localhost:9000"`
+	ClientID string `envconfig:"CLIENT_ID" default:"inventory"`
+
+	// Everyone logs in as this user.
+	Email      string   `envconfig:"EMAIL" default:"student@example.com"`
+	GivenName  string   `envconfig:"GIVEN_NAME" default:"Sam"`
+	FamilyName string   `envconfig:"FAMILY_NAME" default:"Student"`
+	Roles      []string `envconfig:"ROLES"`
+}
+
+func (c Config) Validate() error {
+	return config.Port("PORT", c.Port)
+}
+
+func main() {
+	var cfg Config
+	if _, err := config.Load(&cfg); err != nil {
+		log.Fatal(err)
+	}
+
+	p, err := oidctest.NewProvider(cfg.Issuer, cfg.ClientID, map[string]any{
+		"email":          cfg.Email,
+		"email_verified": true,
+		"given_name":     cfg.GivenName,
+		"family_name":    cfg.FamilyName,
+		"roles":          cfg.Roles,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	slog.Info("Listening", "port", cfg.Port, "issuer", cfg.Issuer, "email", cfg.Email)
+	log.Fatal(http.ListenAndServe(fmt.Sprintf(":%d", cfg.Port), p))
+}